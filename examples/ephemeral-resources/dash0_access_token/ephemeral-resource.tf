@@ -0,0 +1,43 @@
+# Hands the provider's Dash0 token to an OpenTelemetry Collector without
+# duplicating it into variables or persisting it in plan or state.
+# The postcondition fails early if the provider runs on OAuth credentials,
+# which the Dash0 OTLP endpoint does not accept.
+ephemeral "dash0_access_token" "collector" {
+  lifecycle {
+    postcondition {
+      condition     = !self.oauth
+      error_message = "The Dash0 OTLP endpoint does not accept OAuth access tokens; configure the provider with an auth_ token."
+    }
+  }
+}
+
+# Write-only arguments keep the token out of state (Terraform 1.11 or later).
+resource "kubernetes_secret_v1" "dash0" {
+  metadata {
+    name      = "dash0-auth"
+    namespace = "opentelemetry"
+  }
+
+  data_wo = {
+    token = ephemeral.dash0_access_token.collector.token
+  }
+  data_wo_revision = 1
+}
+
+resource "helm_release" "collector" {
+  name       = "otel-collector"
+  namespace  = "opentelemetry"
+  repository = "https://open-telemetry.github.io/opentelemetry-helm-charts"
+  chart      = "opentelemetry-collector"
+
+  set = [
+    {
+      name  = "config.exporters.otlphttp/dash0.endpoint"
+      value = ephemeral.dash0_access_token.collector.otlp_url
+    },
+    {
+      name  = "config.exporters.otlphttp/dash0.headers.Dash0-Dataset"
+      value = ephemeral.dash0_access_token.collector.dataset
+    },
+  ]
+}
@@ -0,0 +1,23 @@
+# Migrate a directory of exported Grafana dashboards.
+locals {
+  grafana_dashboards = {
+    for file in fileset("${path.module}/grafana", "*.json") :
+    trimsuffix(file, ".json") => provider::dash0::grafana_to_dashboard(file("${path.module}/grafana/${file}"))
+  }
+}
+
+resource "dash0_dashboard" "migrated" {
+  for_each = local.grafana_dashboards
+
+  dataset        = "default"
+  dashboard_yaml = each.value.yaml
+}
+
+# Report everything that could not be converted. Failed check assertions are
+# shown as warnings and do not block the apply.
+check "grafana_conversion" {
+  assert {
+    condition     = alltrue([for conversion in local.grafana_dashboards : length(conversion.warnings) == 0])
+    error_message = join("\n", flatten([for name, conversion in local.grafana_dashboards : [for warning in conversion.warnings : "${name}: ${warning}"]]))
+  }
+}
@@ -0,0 +1,16 @@
+# A revision of the dashboard that only changes when the provider would plan an
+# update, not when the file is merely reformatted or reordered.
+resource "terraform_data" "overview_revision" {
+  input = sha256(provider::dash0::normalize_yaml("dashboard", file("${path.module}/dashboards/overview.yaml")))
+}
+
+# Announce dashboard changes, but only real ones.
+resource "terraform_data" "announce_overview_change" {
+  provisioner "local-exec" {
+    command = "./scripts/announce-dashboard-change.sh overview"
+  }
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.overview_revision]
+  }
+}
@@ -0,0 +1,19 @@
+# Manage every rule of an existing PrometheusRule file as its own resource:
+# alerting rules become check rules, recording rules become recording rules.
+locals {
+  payments_rules = provider::dash0::split_prometheus_rules(file("${path.module}/rules/payments.yaml"))
+}
+
+resource "dash0_check_rule" "payments" {
+  for_each = { for key, rule in local.payments_rules : key => rule if !rule.is_recording }
+
+  dataset         = "production"
+  check_rule_yaml = each.value.yaml
+}
+
+resource "dash0_recording_rule" "payments" {
+  for_each = { for key, rule in local.payments_rules : key => rule if rule.is_recording }
+
+  dataset             = "production"
+  recording_rule_yaml = each.value.yaml
+}
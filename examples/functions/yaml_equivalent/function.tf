@@ -0,0 +1,12 @@
+# Assert that the dashboard rendered from a template still matches the
+# reviewed golden file, using the same comparison as drift detection.
+check "overview_dashboard_matches_golden_file" {
+  assert {
+    condition = provider::dash0::yaml_equivalent(
+      "dashboard",
+      templatefile("${path.module}/dashboards/overview.yaml.tftpl", { service = var.service }),
+      file("${path.module}/testdata/overview.golden.yaml"),
+    )
+    error_message = "The rendered overview dashboard differs from testdata/overview.golden.yaml."
+  }
+}
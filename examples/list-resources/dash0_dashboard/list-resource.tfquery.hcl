@@ -0,0 +1,11 @@
+# Lists the dashboards of the production dataset whose display name contains
+# "checkout". `terraform query -generate-config-out=generated.tf` writes an
+# import block and a dash0_dashboard resource for each of them.
+list "dash0_dashboard" "checkout" {
+  provider = dash0
+
+  config {
+    dataset = "production"
+    name    = "checkout"
+  }
+}
@@ -0,0 +1,6 @@
+# Lists every notification channel of the organization.
+# `terraform query -generate-config-out=generated.tf` writes an import block
+# and a dash0_notification_channel resource for each of them.
+list "dash0_notification_channel" "all" {
+  provider = dash0
+}
@@ -0,0 +1,11 @@
+# Lists the spam filters of the production dataset whose display name contains
+# "checkout". `terraform query -generate-config-out=generated.tf` writes an
+# import block and a dash0_spam_filter resource for each of them.
+list "dash0_spam_filter" "checkout" {
+  provider = dash0
+
+  config {
+    dataset = "production"
+    name    = "checkout"
+  }
+}
@@ -0,0 +1,6 @@
+# Lists every team of the organization.
+# `terraform query -generate-config-out=generated.tf` writes an import block
+# and a dash0_team resource for each of them.
+list "dash0_team" "all" {
+  provider = dash0
+}
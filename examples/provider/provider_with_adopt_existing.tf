@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    dash0 = {
+      source  = "dash0hq/dash0"
+      version = "~> 1.6.0"
+    }
+  }
+}
+
+# With `adopt_existing = true`, creating a resource takes over an existing
+# asset with the same name, such as a dashboard created in the Dash0 UI,
+# instead of creating a duplicate next to it.
+provider "dash0" {
+  adopt_existing = true
+}
@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    dash0 = {
+      source  = "dash0hq/dash0"
+      version = "~> 1.6.0"
+    }
+  }
+}
+
+# With `conflict_detection = true`, `terraform apply` fails instead of
+# overwriting a dashboard that was edited in the Dash0 UI after the plan.
+provider "dash0" {
+  conflict_detection = true
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    dash0 = {
+      source  = "dash0hq/dash0"
+      version = "~> 1.6.0"
+    }
+  }
+}
+
+# With `deletion_protection = true`, Terraform refuses to delete any asset
+# that does not set `deletion_protection` itself.
+provider "dash0" {
+  deletion_protection = true
+}
+
+# A scratch view opts out of the provider-level default.
+resource "dash0_view" "scratch" {
+  dataset             = "default"
+  deletion_protection = false
+  view_yaml           = file("${path.module}/scratch_view.yaml")
+}
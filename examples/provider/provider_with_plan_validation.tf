@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    dash0 = {
+      source  = "dash0hq/dash0"
+      version = "~> 1.6.0"
+    }
+  }
+}
+
+# With `plan_validation = "error"`, a check rule routing its alerts to a
+# notification channel id that does not exist fails `terraform plan` instead
+# of the apply.
+provider "dash0" {
+  plan_validation = "error"
+}
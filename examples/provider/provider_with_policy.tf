@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    dash0 = {
+      source  = "dash0hq/dash0"
+      version = "~> 1.6.0"
+    }
+  }
+}
+
+# The `policy` block enforces conventions on every managed asset at plan time.
+# Violations of an `error` rule fail the plan; `warn` rules only show a warning.
+provider "dash0" {
+  policy {
+    rule {
+      resource_type                = "dash0_check_rule"
+      required_annotations         = ["runbook_url"]
+      required_labels              = ["severity"]
+      require_notification_channel = true
+    }
+
+    rule {
+      resource_type                = "dash0_dashboard"
+      allowed_folder_path_prefixes = ["/teams/"]
+    }
+
+    rule {
+      resource_type   = "dash0_view"
+      required_labels = ["team"]
+      enforcement     = "warn"
+    }
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = dash0_check_rule.adservice_error_rate
+  identity = {
+    dataset = "production"
+    origin  = "tf_existing-check-rule-origin"
+  }
+}
@@ -1,2 +1,8 @@
 #!/bin/bash
-terraform import dash0_check_rule.adservice_error_rate production,tf_existing-check-rule-origin
\ No newline at end of file
+terraform import dash0_check_rule.adservice_error_rate production,tf_existing-check-rule-origin
+
+# The display name or the server-assigned id work as well; the provider
+# resolves them through the list endpoint and reports the candidates when
+# several assets share the display name.
+terraform import dash0_check_rule.adservice_error_rate "production,name=Adservice error rate"
+terraform import dash0_check_rule.adservice_error_rate production,id=00000000-0000-0000-0000-000000000001
@@ -0,0 +1,7 @@
+import {
+  to = dash0_dashboard.checkout_overview
+  identity = {
+    dataset = "production"
+    origin  = "tf_existing-dashboard-origin"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = dash0_notification_channel.slack_alerts
+  identity = {
+    origin = "tf_existing-notification-channel-origin"
+  }
+}
@@ -0,0 +1,9 @@
+resource "dash0_prometheus_rule" "shop" {
+  dataset              = "production"
+  prometheus_rule_yaml = file("${path.module}/prometheus_rule.yaml")
+}
+
+# Wire the id of a single rule into another resource.
+output "service_down_check_rule_id" {
+  value = dash0_prometheus_rule.shop.rule_ids["Availability/ServiceDown"]
+}
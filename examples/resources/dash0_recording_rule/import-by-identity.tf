@@ -0,0 +1,7 @@
+import {
+  to = dash0_recording_rule.span_duration_p95
+  identity = {
+    dataset = "production"
+    origin  = "tf_existing-recording-rule-origin"
+  }
+}
@@ -1,2 +1,8 @@
 #!/bin/bash
-terraform import dash0_recording_rule.span_duration_p95 production,tf_existing-recording-rule-origin
\ No newline at end of file
+terraform import dash0_recording_rule.span_duration_p95 production,tf_existing-recording-rule-origin
+
+# The display name or the server-assigned id work as well; the provider
+# resolves them through the list endpoint and reports the candidates when
+# several assets share the display name.
+terraform import dash0_recording_rule.span_duration_p95 "production,name=span_duration_p95"
+terraform import dash0_recording_rule.span_duration_p95 production,id=00000000-0000-0000-0000-000000000001
@@ -0,0 +1,7 @@
+import {
+  to = dash0_spam_filter.drop_health_checks
+  identity = {
+    dataset = "production"
+    origin  = "tf_existing-spam-filter-origin"
+  }
+}
@@ -1,2 +1,8 @@
 #!/bin/bash
 terraform import dash0_spam_filter.drop_health_checks default,tf_existing-spam-filter-origin
+
+# The display name or the server-assigned id work as well; the provider
+# resolves them through the list endpoint and reports the candidates when
+# several assets share the display name.
+terraform import dash0_spam_filter.drop_health_checks "default,name=Drop health checks"
+terraform import dash0_spam_filter.drop_health_checks default,id=00000000-0000-0000-0000-000000000001
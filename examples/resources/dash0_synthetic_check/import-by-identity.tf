@@ -0,0 +1,7 @@
+import {
+  to = dash0_synthetic_check.checkout_api
+  identity = {
+    dataset = "production"
+    origin  = "tf_existing-synthetic-check-origin"
+  }
+}
@@ -77,3 +77,50 @@ spec:
     strategy: all_locations
 YAML
 }
+
+# The same kind of check configured through the typed blocks instead of YAML.
+# URLs, header names, intervals, locations and assertions are validated at
+# plan time.
+resource "dash0_synthetic_check" "payments_api" {
+  dataset = "default"
+  name    = "payments-api"
+
+  request {
+    method = "get"
+    url    = "https://api.example.com/payments/health"
+    headers = {
+      "Accept" = "application/json"
+    }
+  }
+
+  assertion {
+    kind     = "status_code"
+    operator = "is"
+    value    = "200"
+  }
+
+  assertion {
+    kind      = "json_body"
+    json_path = "$.status"
+    operator  = "is"
+    value     = "ok"
+  }
+
+  assertion {
+    severity = "degraded"
+    kind     = "timing"
+    type     = "response"
+    operator = "lte"
+    value    = "2000ms"
+  }
+
+  schedule {
+    interval  = "1m"
+    locations = ["de-frankfurt", "us-oregon"]
+    retries   = 3
+  }
+
+  notifications {
+    channels = [dash0_notification_channel.team_oncall["sre"].id]
+  }
+}
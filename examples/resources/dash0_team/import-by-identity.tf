@@ -0,0 +1,6 @@
+import {
+  to = dash0_team.backend
+  identity = {
+    origin = "tf_existing-team-origin"
+  }
+}
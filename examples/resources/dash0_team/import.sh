@@ -3,3 +3,9 @@
 # dataset prefix. Both the provider-generated origin (tf_-prefixed) and the
 # raw team id (server-assigned UUID) are accepted; the example uses an origin.
 terraform import dash0_team.backend tf_existing-team-origin
+
+# The display name or the server-assigned id work as well; the provider
+# resolves them through the list endpoint and reports the candidates when
+# several assets share the display name.
+terraform import dash0_team.backend "name=Backend Team"
+terraform import dash0_team.backend id=00000000-0000-0000-0000-000000000001
@@ -25,6 +25,22 @@ spec:
 YAML
 }
 
+# Alternatively, configure the team through typed attributes. Membership is a
+# set, so plans list exactly which members are added or removed, and members
+# that match no organization member fail the plan.
+resource "dash0_team" "frontend" {
+  name         = "frontend-team"
+  display_name = "Frontend Team"
+  color = {
+    from = "#F59E0B"
+    to   = "#EF4444"
+  }
+  members = [
+    "carol@example.com",
+    "dave@example.com",
+  ]
+}
+
 # Expose the server-assigned team id when other resources need to reference
 # the team by its raw UUID.
 output "backend_team_id" {
@@ -0,0 +1,4 @@
+#!/bin/bash
+# The import ID is the team (its origin or server-assigned id) and the member
+# (email address or id), separated by a comma.
+terraform import dash0_team_membership.contractor tf_existing-team-origin,contractor@example.com
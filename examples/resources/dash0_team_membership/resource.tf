@@ -0,0 +1,18 @@
+# Add a single member to a team without taking ownership of the team's whole
+# membership. Members added by other workspaces, the Dash0 web app or other
+# tools are left alone.
+resource "dash0_team" "platform" {
+  name         = "platform-team"
+  display_name = "Platform Team"
+  color = {
+    from = "#10B981"
+    to   = "#3B82F6"
+  }
+  # members is left unset so that membership is managed through
+  # dash0_team_membership below and elsewhere.
+}
+
+resource "dash0_team_membership" "contractor" {
+  team   = dash0_team.platform.origin
+  member = "contractor@example.com"
+}
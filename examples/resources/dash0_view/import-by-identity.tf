@@ -0,0 +1,7 @@
+import {
+  to = dash0_view.checkout_errors
+  identity = {
+    dataset = "production"
+    origin  = "tf_existing-view-origin"
+  }
+}
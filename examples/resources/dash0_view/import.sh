@@ -1,2 +1,8 @@
 #!/bin/bash
-terraform import dash0_view.name "{{ dataset }},{{ id_or_origin }}"
\ No newline at end of file
+terraform import dash0_view.name "{{ dataset }},{{ id_or_origin }}"
+
+# The display name or the server-assigned id work as well; the provider
+# resolves them through the list endpoint and reports the candidates when
+# several assets share the display name.
+terraform import dash0_view.name "{{ dataset }},name={{ display_name }}"
+terraform import dash0_view.name "{{ dataset }},id={{ id }}"
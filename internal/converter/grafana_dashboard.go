@@ -0,0 +1,781 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Grafana dashboards are laid out on a 24-column grid, the same width as a
+// Perses grid, so grid positions carry over unchanged. The constants below
+// only matter for legacy (schemaVersion < 16) dashboards, whose rows size
+// panels in 12ths of the width and in pixels.
+const (
+	grafanaGridColumns        = 24
+	grafanaGridRowHeightPx    = 30
+	grafanaDefaultPanelWidth  = 12
+	grafanaDefaultPanelHeight = 8
+)
+
+// GrafanaDashboardConversion is the result of ConvertGrafanaDashboard.
+type GrafanaDashboardConversion struct {
+	// YAML is the PersesDashboard document.
+	YAML string
+	// Warnings describes everything that could not be converted: panels that
+	// were replaced by a Markdown placeholder, and queries, variables and
+	// settings that were dropped.
+	Warnings []string
+}
+
+var (
+	// grafanaLegacyVariablePattern matches the [[name]] variable syntax of old
+	// Grafana dashboards, which Perses does not understand.
+	grafanaLegacyVariablePattern = regexp.MustCompile(`\[\[([A-Za-z0-9_]+)(?::[A-Za-z0-9_]+)?\]\]`)
+	// grafanaLabelValuesPattern matches a label_values(metric, label) or
+	// label_values(label) variable query.
+	grafanaLabelValuesPattern = regexp.MustCompile(`^label_values\(\s*(?:(.*?)\s*,\s*)?([A-Za-z_][A-Za-z0-9_]*)\s*\)$`)
+	// grafanaLabelNamesPattern matches a label_names() variable query.
+	grafanaLabelNamesPattern = regexp.MustCompile(`^label_names\(\s*(.*?)\s*\)$`)
+	// grafanaRelativeTimePattern matches the "now-<duration>" form of a
+	// dashboard's default time range.
+	grafanaRelativeTimePattern = regexp.MustCompile(`^now-((?:\d+[ywdhms])+)$`)
+	// persesDurationPattern matches a duration Perses accepts.
+	persesDurationPattern = regexp.MustCompile(`^(\d+y)?(\d+w)?(\d+d)?(\d+h)?(\d+m)?(\d+s)?(\d+ms)?$`)
+	// persesVariableNamePattern matches a variable name Perses accepts.
+	persesVariableNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	// persesNameInvalidChars matches the characters replaced when a dashboard
+	// name is derived from a Grafana uid or title.
+	persesNameInvalidChars = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// grafanaCalculations maps Grafana reducers (and the valueName of legacy
+// singlestat panels) to Perses calculations.
+var grafanaCalculations = map[string]string{
+	"lastNotNull":  "last-number",
+	"last":         "last",
+	"firstNotNull": "first-number",
+	"first":        "first",
+	"mean":         "mean",
+	"max":          "max",
+	"min":          "min",
+	"sum":          "sum",
+	"current":      "last-number",
+	"avg":          "mean",
+	"total":        "sum",
+}
+
+// grafanaUnits maps Grafana units to Perses units. Units without a Perses
+// counterpart are dropped with a warning.
+var grafanaUnits = map[string]string{
+	"short":       "decimal",
+	"none":        "decimal",
+	"percent":     "percent",
+	"percentunit": "percent-decimal",
+	"ns":          "nanoseconds",
+	"µs":          "microseconds",
+	"us":          "microseconds",
+	"ms":          "milliseconds",
+	"s":           "seconds",
+	"m":           "minutes",
+	"h":           "hours",
+	"d":           "days",
+	"bytes":       "bytes",
+	"decbytes":    "decbytes",
+	"bps":         "bits/sec",
+	"Bps":         "bytes/sec",
+	"reqps":       "requests/sec",
+	"ops":         "ops/sec",
+	"rps":         "reads/sec",
+	"wps":         "writes/sec",
+}
+
+// grafanaVariableSorts maps the numeric sort of a Grafana query variable to
+// the Perses sort.
+var grafanaVariableSorts = map[int]string{
+	1: "alphabetical-asc",
+	2: "alphabetical-desc",
+	3: "numerical-asc",
+	4: "numerical-desc",
+	5: "alphabetical-ci-asc",
+	6: "alphabetical-ci-desc",
+}
+
+// ConvertGrafanaDashboard converts a Grafana dashboard JSON model, either the
+// dashboard itself or the {"dashboard": ...} envelope the Grafana HTTP API and
+// export return, into a PersesDashboard document in the shape dashboard_yaml
+// accepts:
+//   - time series (and legacy graph), stat (and singlestat), gauge, table and
+//     text panels are converted, along with their PromQL queries,
+//   - rows become separate grid layouts, collapsed rows collapsed ones,
+//   - grid positions carry over unchanged,
+//   - query, custom, interval, textbox and constant variables are converted.
+//
+// Anything that cannot be converted is reported in Warnings instead of being
+// dropped silently; a panel of an unsupported type is replaced by a Markdown
+// panel at the same position saying so.
+//
+// Returns an error only if the JSON cannot be parsed or is not a Grafana
+// dashboard.
+func ConvertGrafanaDashboard(grafanaJSON string) (GrafanaDashboardConversion, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(grafanaJSON), &doc); err != nil {
+		return GrafanaDashboardConversion{}, fmt.Errorf("error parsing Grafana dashboard JSON: %w", err)
+	}
+	if dashboard, ok := doc["dashboard"].(map[string]interface{}); ok {
+		doc = dashboard
+	}
+	_, hasPanels := doc["panels"].([]interface{})
+	_, hasRows := doc["rows"].([]interface{})
+	if !hasPanels && !hasRows {
+		return GrafanaDashboardConversion{}, fmt.Errorf("the document is not a Grafana dashboard: it has neither panels nor rows")
+	}
+
+	c := &grafanaConverter{panels: map[string]interface{}{}, layouts: []interface{}{}}
+
+	display := map[string]interface{}{}
+	if title := grafanaString(doc, "title"); title != "" {
+		display["name"] = title
+	}
+	if description := grafanaString(doc, "description"); description != "" {
+		display["description"] = description
+	}
+	spec := map[string]interface{}{
+		"display":   display,
+		"variables": c.convertVariables(doc),
+	}
+	c.convertDuration(doc, spec)
+
+	if hasPanels {
+		c.convertPanels(doc["panels"].([]interface{}))
+	} else {
+		c.convertLegacyRows(doc["rows"].([]interface{}))
+	}
+	spec["panels"] = c.panels
+	spec["layouts"] = c.layouts
+
+	out := map[string]interface{}{
+		"apiVersion": persesAPIVersion,
+		"kind":       "PersesDashboard",
+		"spec":       spec,
+	}
+	if name := grafanaDashboardName(doc); name != "" {
+		out["metadata"] = map[string]interface{}{"name": name}
+	}
+
+	var buf strings.Builder
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(out); err != nil {
+		return GrafanaDashboardConversion{}, fmt.Errorf("error encoding PersesDashboard YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return GrafanaDashboardConversion{}, fmt.Errorf("error encoding PersesDashboard YAML: %w", err)
+	}
+	return GrafanaDashboardConversion{YAML: buf.String(), Warnings: c.warnings}, nil
+}
+
+// grafanaConverter accumulates the panels, layouts and warnings of one
+// conversion.
+type grafanaConverter struct {
+	panels   map[string]interface{}
+	layouts  []interface{}
+	warnings []string
+}
+
+func (c *grafanaConverter) warnf(format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf(format, args...))
+}
+
+// grafanaGrid is a Perses grid layout under construction.
+type grafanaGrid struct {
+	title     string
+	collapsed bool
+	items     []interface{}
+}
+
+func (c *grafanaConverter) flush(grid *grafanaGrid) {
+	if grid.title == "" && len(grid.items) == 0 {
+		return
+	}
+	items := grid.items
+	if items == nil {
+		items = []interface{}{}
+	}
+	spec := map[string]interface{}{"items": items}
+	if grid.title != "" {
+		spec["display"] = map[string]interface{}{
+			"title":    grid.title,
+			"collapse": map[string]interface{}{"open": !grid.collapsed},
+		}
+	}
+	c.layouts = append(c.layouts, map[string]interface{}{"kind": "Grid", "spec": spec})
+}
+
+// convertDuration sets the dashboard's default time range and refresh
+// interval.
+func (c *grafanaConverter) convertDuration(doc, spec map[string]interface{}) {
+	timeRange, _ := doc["time"].(map[string]interface{})
+	if from := grafanaString(timeRange, "from"); from != "" {
+		if m := grafanaRelativeTimePattern.FindStringSubmatch(from); m != nil && persesDurationPattern.MatchString(m[1]) {
+			spec["duration"] = m[1]
+		} else {
+			c.warnf("The default time range %q cannot be converted; the Dash0 default is used instead.", from)
+		}
+	}
+	if refresh := grafanaString(doc, "refresh"); refresh != "" {
+		if persesDurationPattern.MatchString(refresh) {
+			spec["refreshInterval"] = refresh
+		} else {
+			c.warnf("The refresh interval %q cannot be converted and was dropped.", refresh)
+		}
+	}
+}
+
+// convertPanels lays out the panels of a dashboard with schemaVersion 16 or
+// later, where rows are panels of type "row" and every panel has a gridPos.
+func (c *grafanaConverter) convertPanels(panels []interface{}) {
+	sorted := grafanaPanels(panels)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		xi, yi, _, _ := grafanaGridPos(sorted[i])
+		xj, yj, _, _ := grafanaGridPos(sorted[j])
+		if yi != yj {
+			return yi < yj
+		}
+		return xi < xj
+	})
+
+	grid := &grafanaGrid{}
+	rowTop := 0
+	for _, panel := range sorted {
+		if grafanaString(panel, "type") != "row" {
+			c.addPanel(grid, panel, rowTop)
+			continue
+		}
+
+		c.flush(grid)
+		collapsed, _ := panel["collapsed"].(bool)
+		grid = &grafanaGrid{title: grafanaString(panel, "title"), collapsed: collapsed}
+		if grid.title == "" {
+			grid.title = "Row"
+		}
+		_, y, _, _ := grafanaGridPos(panel)
+		rowTop = y + 1
+		// A collapsed row holds its panels itself, positioned as they would
+		// be if the row were expanded.
+		if nested, ok := panel["panels"].([]interface{}); ok && collapsed {
+			for _, nestedPanel := range grafanaPanels(nested) {
+				c.addPanel(grid, nestedPanel, rowTop)
+			}
+		}
+	}
+	c.flush(grid)
+}
+
+// convertLegacyRows lays out the rows of a dashboard with a schemaVersion
+// before 16, whose panels are sized with a span in 12ths of the width and
+// flow left to right within a row of a fixed pixel height.
+func (c *grafanaConverter) convertLegacyRows(rows []interface{}) {
+	for _, r := range rows {
+		row, _ := r.(map[string]interface{})
+		grid := &grafanaGrid{}
+		if showTitle, _ := row["showTitle"].(bool); showTitle {
+			grid.title = grafanaString(row, "title")
+			grid.collapsed, _ = row["collapse"].(bool)
+		}
+
+		height := grafanaDefaultPanelHeight
+		if px := grafanaPixels(row["height"]); px > 0 {
+			height = max(1, int(math.Round(px/grafanaGridRowHeightPx)))
+		}
+
+		x, y := 0, 0
+		panels, _ := row["panels"].([]interface{})
+		for _, panel := range grafanaPanels(panels) {
+			width := grafanaGridColumns
+			if span, ok := panel["span"].(float64); ok && span > 0 {
+				width = min(grafanaGridColumns, max(1, int(math.Round(span*2))))
+			}
+			if x+width > grafanaGridColumns {
+				x, y = 0, y+height
+			}
+			panel["gridPos"] = map[string]interface{}{"x": float64(x), "y": float64(y), "w": float64(width), "h": float64(height)}
+			c.addPanel(grid, panel, 0)
+			x += width
+		}
+		c.flush(grid)
+	}
+}
+
+// addPanel converts panel and places it on grid, with its y position made
+// relative to the top of the row it belongs to.
+func (c *grafanaConverter) addPanel(grid *grafanaGrid, panel map[string]interface{}, rowTop int) {
+	key := c.panelKey(panel)
+	c.panels[key] = c.convertPanel(panel)
+
+	x, y, width, height := grafanaGridPos(panel)
+	grid.items = append(grid.items, map[string]interface{}{
+		"x":       x,
+		"y":       max(0, y-rowTop),
+		"width":   width,
+		"height":  height,
+		"content": map[string]interface{}{"$ref": "#/spec/panels/" + key},
+	})
+}
+
+// panelKey returns a key for panel that is unique within the dashboard,
+// derived from the Grafana panel id when it has one.
+func (c *grafanaConverter) panelKey(panel map[string]interface{}) string {
+	base := fmt.Sprintf("panel-%d", len(c.panels)+1)
+	if id, ok := panel["id"].(float64); ok {
+		base = fmt.Sprintf("panel-%d", int(id))
+	}
+	key := base
+	for n := 2; c.panels[key] != nil; n++ {
+		key = fmt.Sprintf("%s-%d", base, n)
+	}
+	return key
+}
+
+// convertPanel converts a single Grafana panel into a Perses panel.
+func (c *grafanaConverter) convertPanel(panel map[string]interface{}) map[string]interface{} {
+	title := grafanaString(panel, "title")
+	panelType := grafanaString(panel, "type")
+	label := grafanaPanelLabel(panel)
+
+	display := map[string]interface{}{"name": title}
+	if description := grafanaString(panel, "description"); description != "" {
+		display["description"] = description
+	}
+	spec := map[string]interface{}{"display": display}
+
+	var plugin map[string]interface{}
+	switch panelType {
+	case "timeseries", "graph":
+		plugin = map[string]interface{}{"kind": "TimeSeriesChart", "spec": c.timeSeriesChartSpec(panel, label)}
+	case "stat", "singlestat":
+		plugin = map[string]interface{}{"kind": "StatChart", "spec": c.statChartSpec(panel, label)}
+	case "gauge":
+		plugin = map[string]interface{}{"kind": "GaugeChart", "spec": c.gaugeChartSpec(panel, label)}
+	case "table":
+		plugin = map[string]interface{}{"kind": "Table", "spec": map[string]interface{}{}}
+	case "text":
+		options, _ := panel["options"].(map[string]interface{})
+		content := grafanaString(options, "content")
+		mode := grafanaString(options, "mode")
+		if content == "" {
+			// Legacy text panels keep their content and mode on the panel.
+			content = grafanaString(panel, "content")
+			mode = grafanaString(panel, "mode")
+		}
+		if mode == "html" {
+			c.warnf("The %s is an HTML text panel; its content is rendered as Markdown.", label)
+		}
+		return map[string]interface{}{
+			"kind": "Panel",
+			"spec": map[string]interface{}{
+				"display": display,
+				"plugin":  map[string]interface{}{"kind": "Markdown", "spec": map[string]interface{}{"text": content}},
+			},
+		}
+	default:
+		c.warnf("The %s has type %q, which cannot be converted; it was replaced by a Markdown panel.", label, panelType)
+		return map[string]interface{}{
+			"kind": "Panel",
+			"spec": map[string]interface{}{
+				"display": display,
+				"plugin": map[string]interface{}{"kind": "Markdown", "spec": map[string]interface{}{
+					"text": fmt.Sprintf("This Grafana %s panel could not be converted to a Dash0 panel.", panelType),
+				}},
+			},
+		}
+	}
+
+	spec["plugin"] = plugin
+	if queries := c.convertQueries(panel, label); len(queries) > 0 {
+		spec["queries"] = queries
+	}
+	return map[string]interface{}{"kind": "Panel", "spec": spec}
+}
+
+// convertQueries converts the PromQL targets of panel into Perses queries.
+func (c *grafanaConverter) convertQueries(panel map[string]interface{}, label string) []interface{} {
+	targets, _ := panel["targets"].([]interface{})
+	var queries []interface{}
+	for _, t := range targets {
+		target, _ := t.(map[string]interface{})
+		if hidden, _ := target["hide"].(bool); hidden {
+			continue
+		}
+		expr := grafanaString(target, "expr")
+		if expr == "" {
+			c.warnf("Query %s of %s is not a PromQL query and was dropped.", grafanaString(target, "refId"), label)
+			continue
+		}
+		querySpec := map[string]interface{}{"query": grafanaVariables(expr)}
+		if legend := grafanaString(target, "legendFormat"); legend != "" && legend != "__auto" {
+			querySpec["seriesNameFormat"] = grafanaVariables(legend)
+		}
+		queries = append(queries, map[string]interface{}{
+			"kind": "TimeSeriesQuery",
+			"spec": map[string]interface{}{
+				"plugin": map[string]interface{}{"kind": "PrometheusTimeSeriesQuery", "spec": querySpec},
+			},
+		})
+	}
+	return queries
+}
+
+func (c *grafanaConverter) timeSeriesChartSpec(panel map[string]interface{}, label string) map[string]interface{} {
+	spec := map[string]interface{}{}
+
+	options, _ := panel["options"].(map[string]interface{})
+	legend, _ := options["legend"].(map[string]interface{})
+	showLegend, hasShowLegend := legend["showLegend"].(bool)
+	if !hasShowLegend {
+		// Legacy graph panels.
+		legacyLegend, _ := panel["legend"].(map[string]interface{})
+		showLegend, hasShowLegend = legacyLegend["show"].(bool)
+	}
+	if showLegend || !hasShowLegend {
+		position := "bottom"
+		if grafanaString(legend, "placement") == "right" {
+			position = "right"
+		}
+		persesLegend := map[string]interface{}{"position": position}
+		if grafanaString(legend, "displayMode") == "table" {
+			persesLegend["mode"] = "table"
+		}
+		spec["legend"] = persesLegend
+	}
+
+	yAxis := map[string]interface{}{}
+	if format := c.format(panel, label); format != nil {
+		yAxis["format"] = format
+	}
+	defaults := grafanaFieldDefaults(panel)
+	for _, bound := range []string{"min", "max"} {
+		if v, ok := defaults[bound].(float64); ok {
+			yAxis[bound] = v
+		}
+	}
+	if len(yAxis) > 0 {
+		spec["yAxis"] = yAxis
+	}
+	return spec
+}
+
+func (c *grafanaConverter) statChartSpec(panel map[string]interface{}, label string) map[string]interface{} {
+	spec := map[string]interface{}{"calculation": c.calculation(panel, label)}
+	if format := c.format(panel, label); format != nil {
+		spec["format"] = format
+	}
+	if thresholds := grafanaThresholds(panel); thresholds != nil {
+		spec["thresholds"] = thresholds
+	}
+	return spec
+}
+
+func (c *grafanaConverter) gaugeChartSpec(panel map[string]interface{}, label string) map[string]interface{} {
+	spec := c.statChartSpec(panel, label)
+	if v, ok := grafanaFieldDefaults(panel)["max"].(float64); ok {
+		spec["max"] = v
+	}
+	return spec
+}
+
+// calculation returns the Perses calculation for the reducer of a stat or
+// gauge panel.
+func (c *grafanaConverter) calculation(panel map[string]interface{}, label string) string {
+	options, _ := panel["options"].(map[string]interface{})
+	reduceOptions, _ := options["reduceOptions"].(map[string]interface{})
+	calcs, _ := reduceOptions["calcs"].([]interface{})
+	reducer := grafanaString(panel, "valueName")
+	if len(calcs) > 0 {
+		reducer, _ = calcs[0].(string)
+	}
+	if reducer == "" {
+		return "last-number"
+	}
+	if calculation, ok := grafanaCalculations[reducer]; ok {
+		return calculation
+	}
+	c.warnf("The %s reduces its values with %q, which cannot be converted; the last value is shown instead.", label, reducer)
+	return "last-number"
+}
+
+// format returns the Perses format for the unit and decimals of panel, or nil
+// when it sets neither.
+func (c *grafanaConverter) format(panel map[string]interface{}, label string) map[string]interface{} {
+	defaults := grafanaFieldDefaults(panel)
+	unit := grafanaString(defaults, "unit")
+	if unit == "" {
+		// Legacy singlestat panels keep the unit on the panel, legacy graph
+		// panels on their left y-axis.
+		unit = grafanaString(panel, "format")
+		if yAxes, ok := panel["yaxes"].([]interface{}); ok && unit == "" && len(yAxes) > 0 {
+			leftAxis, _ := yAxes[0].(map[string]interface{})
+			unit = grafanaString(leftAxis, "format")
+		}
+	}
+
+	format := map[string]interface{}{}
+	if unit != "" {
+		if persesUnit, ok := grafanaUnits[unit]; ok {
+			format["unit"] = persesUnit
+		} else {
+			c.warnf("The %s uses the unit %q, which cannot be converted; values are shown without a unit.", label, unit)
+		}
+	}
+	if decimals, ok := defaults["decimals"].(float64); ok {
+		format["decimalPlaces"] = int(decimals)
+	}
+	if len(format) == 0 {
+		return nil
+	}
+	return format
+}
+
+// convertVariables converts the templating variables of doc.
+func (c *grafanaConverter) convertVariables(doc map[string]interface{}) []interface{} {
+	templating, _ := doc["templating"].(map[string]interface{})
+	list, _ := templating["list"].([]interface{})
+
+	variables := []interface{}{}
+	for _, v := range list {
+		variable, _ := v.(map[string]interface{})
+		name := grafanaString(variable, "name")
+		variableType := grafanaString(variable, "type")
+		if !persesVariableNamePattern.MatchString(name) {
+			c.warnf("Variable %q has a name Dash0 does not accept and was dropped.", name)
+			continue
+		}
+
+		display := map[string]interface{}{}
+		if label := grafanaString(variable, "label"); label != "" {
+			display["name"] = label
+		}
+		if description := grafanaString(variable, "description"); description != "" {
+			display["description"] = description
+		}
+		if hide, _ := variable["hide"].(float64); hide == 2 {
+			display["hidden"] = true
+		}
+		spec := map[string]interface{}{"name": name}
+		if len(display) > 0 {
+			spec["display"] = display
+		}
+
+		query := grafanaVariableQuery(variable)
+		switch variableType {
+		case "textbox", "constant":
+			spec["value"] = query
+			if variableType == "constant" {
+				spec["constant"] = true
+				spec["display"] = map[string]interface{}{"hidden": true}
+			}
+			variables = append(variables, map[string]interface{}{"kind": "TextVariable", "spec": spec})
+			continue
+		case "query":
+			plugin := c.queryVariablePlugin(name, query)
+			if plugin == nil {
+				continue
+			}
+			spec["plugin"] = plugin
+			if sortOrder, ok := variable["sort"].(float64); ok {
+				if persesSort, ok := grafanaVariableSorts[int(sortOrder)]; ok {
+					spec["sort"] = persesSort
+				}
+			}
+			if regex := strings.TrimSuffix(strings.TrimPrefix(grafanaString(variable, "regex"), "/"), "/"); regex != "" {
+				spec["capturingRegexp"] = regex
+			}
+		case "custom", "interval":
+			spec["plugin"] = map[string]interface{}{
+				"kind": "StaticListVariable",
+				"spec": map[string]interface{}{"values": grafanaStaticValues(query)},
+			}
+		default:
+			c.warnf("Variable %q has type %q, which cannot be converted, and was dropped.", name, variableType)
+			continue
+		}
+
+		if multi, _ := variable["multi"].(bool); multi {
+			spec["allowMultiple"] = true
+		}
+		if includeAll, _ := variable["includeAll"].(bool); includeAll {
+			spec["allowAllValue"] = true
+			if allValue := grafanaString(variable, "allValue"); allValue != "" {
+				spec["customAllValue"] = allValue
+			}
+		}
+		variables = append(variables, map[string]interface{}{"kind": "ListVariable", "spec": spec})
+	}
+	return variables
+}
+
+// queryVariablePlugin returns the Perses plugin for the query of a Grafana
+// query variable, or nil with a warning when it cannot be converted.
+func (c *grafanaConverter) queryVariablePlugin(name, query string) map[string]interface{} {
+	query = strings.TrimSpace(grafanaVariables(query))
+	if m := grafanaLabelValuesPattern.FindStringSubmatch(query); m != nil {
+		spec := map[string]interface{}{"labelName": m[2]}
+		if m[1] != "" {
+			spec["matchers"] = []interface{}{m[1]}
+		}
+		return map[string]interface{}{"kind": "PrometheusLabelValuesVariable", "spec": spec}
+	}
+	if m := grafanaLabelNamesPattern.FindStringSubmatch(query); m != nil {
+		spec := map[string]interface{}{}
+		if m[1] != "" {
+			spec["matchers"] = []interface{}{m[1]}
+		}
+		return map[string]interface{}{"kind": "PrometheusLabelNamesVariable", "spec": spec}
+	}
+	c.warnf("Variable %q uses the query %q, which cannot be converted; only label_values() and label_names() are supported. The variable was dropped.", name, query)
+	return nil
+}
+
+// grafanaVariableQuery returns the query of a variable, which newer Grafana
+// versions wrap in an object.
+func grafanaVariableQuery(variable map[string]interface{}) string {
+	switch query := variable["query"].(type) {
+	case string:
+		return query
+	case map[string]interface{}:
+		return grafanaString(query, "query")
+	}
+	return grafanaString(variable, "definition")
+}
+
+// grafanaStaticValues splits the comma-separated values of a custom or
+// interval variable. A "label : value" entry keeps its label.
+func grafanaStaticValues(query string) []interface{} {
+	values := []interface{}{}
+	for _, entry := range strings.Split(query, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if label, value, ok := strings.Cut(entry, " : "); ok {
+			values = append(values, map[string]interface{}{"label": strings.TrimSpace(label), "value": strings.TrimSpace(value)})
+			continue
+		}
+		values = append(values, entry)
+	}
+	return values
+}
+
+// grafanaThresholds converts the absolute thresholds of a panel, or returns
+// nil when it has none beyond the base color.
+func grafanaThresholds(panel map[string]interface{}) map[string]interface{} {
+	thresholds, _ := grafanaFieldDefaults(panel)["thresholds"].(map[string]interface{})
+	if grafanaString(thresholds, "mode") == "percentage" {
+		return nil
+	}
+	steps, _ := thresholds["steps"].([]interface{})
+
+	out := map[string]interface{}{}
+	var persesSteps []interface{}
+	for _, s := range steps {
+		step, _ := s.(map[string]interface{})
+		value, ok := step["value"].(float64)
+		if !ok {
+			// The base step has a null value and sets the default color.
+			if color := grafanaString(step, "color"); color != "" {
+				out["defaultColor"] = color
+			}
+			continue
+		}
+		persesSteps = append(persesSteps, map[string]interface{}{"value": value, "color": grafanaString(step, "color")})
+	}
+	if len(persesSteps) == 0 {
+		return nil
+	}
+	out["steps"] = persesSteps
+	return out
+}
+
+// grafanaVariables rewrites the legacy [[name]] variable syntax into ${name}.
+func grafanaVariables(s string) string {
+	return grafanaLegacyVariablePattern.ReplaceAllString(s, "$${$1}")
+}
+
+// grafanaDashboardName derives metadata.name from the dashboard's uid, or
+// its title when it has none.
+func grafanaDashboardName(doc map[string]interface{}) string {
+	name := grafanaString(doc, "uid")
+	if name == "" {
+		name = grafanaString(doc, "title")
+	}
+	return strings.Trim(persesNameInvalidChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// grafanaPanelLabel describes a panel in warnings.
+func grafanaPanelLabel(panel map[string]interface{}) string {
+	label := fmt.Sprintf("panel %q", grafanaString(panel, "title"))
+	if id, ok := panel["id"].(float64); ok {
+		label += " (id " + strconv.Itoa(int(id)) + ")"
+	}
+	return label
+}
+
+// grafanaPanels returns the panel objects in panels, skipping anything else.
+func grafanaPanels(panels []interface{}) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(panels))
+	for _, p := range panels {
+		if panel, ok := p.(map[string]interface{}); ok {
+			out = append(out, panel)
+		}
+	}
+	return out
+}
+
+// grafanaGridPos returns the position and size of a panel, defaulting a
+// missing or invalid size.
+func grafanaGridPos(panel map[string]interface{}) (x, y, width, height int) {
+	gridPos, _ := panel["gridPos"].(map[string]interface{})
+	number := func(key string) int {
+		v, _ := gridPos[key].(float64)
+		return int(math.Round(v))
+	}
+	x, y, width, height = max(0, number("x")), max(0, number("y")), number("w"), number("h")
+	if width < 1 {
+		width = grafanaDefaultPanelWidth
+	}
+	if height < 1 {
+		height = grafanaDefaultPanelHeight
+	}
+	return x, y, width, height
+}
+
+// grafanaPixels parses a legacy row height, given as a number or as a string
+// such as "250px".
+func grafanaPixels(v interface{}) float64 {
+	switch height := v.(type) {
+	case float64:
+		return height
+	case string:
+		px, _ := strconv.ParseFloat(strings.TrimSuffix(height, "px"), 64)
+		return px
+	}
+	return 0
+}
+
+// grafanaFieldDefaults returns fieldConfig.defaults of a panel.
+func grafanaFieldDefaults(panel map[string]interface{}) map[string]interface{} {
+	fieldConfig, _ := panel["fieldConfig"].(map[string]interface{})
+	defaults, _ := fieldConfig["defaults"].(map[string]interface{})
+	return defaults
+}
+
+// grafanaString returns m[key] if it is a string, and "" otherwise.
+func grafanaString(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
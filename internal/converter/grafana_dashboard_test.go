@@ -0,0 +1,230 @@
+package converter
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// convertGrafanaTestDashboard converts grafanaJSON and returns the spec of
+// the resulting document along with the warnings.
+func convertGrafanaTestDashboard(t *testing.T, grafanaJSON string) (map[string]interface{}, []string) {
+	t.Helper()
+	conversion, err := ConvertGrafanaDashboard(grafanaJSON)
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(conversion.YAML), &doc))
+	assert.Equal(t, "perses.dev/v1alpha1", doc["apiVersion"])
+	assert.Equal(t, "PersesDashboard", doc["kind"])
+	return doc["spec"].(map[string]interface{}), conversion.Warnings
+}
+
+// gridItems returns the items of the layout at index.
+func gridItems(t *testing.T, spec map[string]interface{}, index int) []interface{} {
+	t.Helper()
+	layouts := spec["layouts"].([]interface{})
+	require.Greater(t, len(layouts), index)
+	return layouts[index].(map[string]interface{})["spec"].(map[string]interface{})["items"].([]interface{})
+}
+
+func TestConvertGrafanaDashboard(t *testing.T) {
+	grafanaJSON, err := os.ReadFile("testdata/grafana_dashboard.json")
+	require.NoError(t, err)
+
+	conversion, err := ConvertGrafanaDashboard(string(grafanaJSON))
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(conversion.YAML), &doc))
+	spec := doc["spec"].(map[string]interface{})
+
+	assert.Equal(t, map[string]interface{}{"name": "checkout-service"}, doc["metadata"])
+	assert.Equal(t, map[string]interface{}{"name": "Checkout Service", "description": "Golden signals of the checkout service"}, spec["display"])
+	assert.Equal(t, "6h", spec["duration"])
+	assert.Equal(t, "30s", spec["refreshInterval"])
+
+	t.Run("variables", func(t *testing.T) {
+		variables := spec["variables"].([]interface{})
+		require.Len(t, variables, 2)
+		assert.Equal(t, map[string]interface{}{
+			"kind": "ListVariable",
+			"spec": map[string]interface{}{
+				"name":           "namespace",
+				"display":        map[string]interface{}{"name": "Namespace"},
+				"allowMultiple":  true,
+				"allowAllValue":  true,
+				"customAllValue": ".*",
+				"sort":           "alphabetical-asc",
+				"plugin": map[string]interface{}{
+					"kind": "PrometheusLabelValuesVariable",
+					"spec": map[string]interface{}{"labelName": "namespace", "matchers": []interface{}{`up{job="checkout"}`}},
+				},
+			},
+		}, variables[0])
+		assert.Equal(t, map[string]interface{}{
+			"kind": "StaticListVariable",
+			"spec": map[string]interface{}{"values": []interface{}{"0.5", "0.9", "0.99"}},
+		}, variables[1].(map[string]interface{})["spec"].(map[string]interface{})["plugin"])
+	})
+
+	t.Run("layouts", func(t *testing.T) {
+		layouts := spec["layouts"].([]interface{})
+		require.Len(t, layouts, 3)
+
+		assert.NotContains(t, layouts[0].(map[string]interface{})["spec"], "display")
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"x": 0, "y": 0, "width": 6, "height": 4, "content": map[string]interface{}{"$ref": "#/spec/panels/panel-1"}},
+			map[string]interface{}{"x": 6, "y": 0, "width": 18, "height": 8, "content": map[string]interface{}{"$ref": "#/spec/panels/panel-2"}},
+		}, gridItems(t, spec, 0))
+
+		assert.Equal(t, map[string]interface{}{"title": "Details", "collapse": map[string]interface{}{"open": true}},
+			layouts[1].(map[string]interface{})["spec"].(map[string]interface{})["display"])
+		// Positions within a row are relative to the row.
+		assert.Equal(t, 0, gridItems(t, spec, 1)[0].(map[string]interface{})["y"])
+
+		assert.Equal(t, map[string]interface{}{"title": "Runbook", "collapse": map[string]interface{}{"open": false}},
+			layouts[2].(map[string]interface{})["spec"].(map[string]interface{})["display"])
+		runbook := gridItems(t, spec, 2)
+		require.Len(t, runbook, 2)
+		assert.Equal(t, 3, runbook[1].(map[string]interface{})["y"])
+	})
+
+	t.Run("panels", func(t *testing.T) {
+		panels := spec["panels"].(map[string]interface{})
+		require.Len(t, panels, 6)
+		plugin := func(key string) map[string]interface{} {
+			return panels[key].(map[string]interface{})["spec"].(map[string]interface{})["plugin"].(map[string]interface{})
+		}
+		queries := func(key string) []interface{} {
+			q, _ := panels[key].(map[string]interface{})["spec"].(map[string]interface{})["queries"].([]interface{})
+			return q
+		}
+
+		assert.Equal(t, map[string]interface{}{
+			"kind": "StatChart",
+			"spec": map[string]interface{}{
+				"calculation": "mean",
+				"format":      map[string]interface{}{"unit": "requests/sec", "decimalPlaces": 1},
+				"thresholds": map[string]interface{}{
+					"defaultColor": "green",
+					"steps":        []interface{}{map[string]interface{}{"value": 100, "color": "red"}},
+				},
+			},
+		}, plugin("panel-1"))
+
+		assert.Equal(t, "TimeSeriesChart", plugin("panel-2")["kind"])
+		assert.Equal(t, map[string]interface{}{"position": "right", "mode": "table"}, plugin("panel-2")["spec"].(map[string]interface{})["legend"])
+		// The hidden query and the non-PromQL query are dropped, and the
+		// legacy [[quantile]] syntax is rewritten.
+		require.Len(t, queries("panel-2"), 1)
+		assert.Equal(t, map[string]interface{}{
+			"kind": "TimeSeriesQuery",
+			"spec": map[string]interface{}{"plugin": map[string]interface{}{
+				"kind": "PrometheusTimeSeriesQuery",
+				"spec": map[string]interface{}{
+					"query":            "histogram_quantile(${quantile}, sum by (le) (rate(http_request_duration_seconds_bucket[5m])))",
+					"seriesNameFormat": "p{{quantile}}",
+				},
+			}},
+		}, queries("panel-2")[0])
+
+		assert.Equal(t, map[string]interface{}{
+			"kind": "GaugeChart",
+			"spec": map[string]interface{}{
+				"calculation": "last-number",
+				"format":      map[string]interface{}{"unit": "percent-decimal"},
+				"max":         1,
+			},
+		}, plugin("panel-4"))
+
+		assert.Equal(t, "Markdown", plugin("panel-5")["kind"])
+		assert.Empty(t, queries("panel-5"))
+
+		assert.Equal(t, map[string]interface{}{"kind": "Markdown", "spec": map[string]interface{}{"text": "# Runbook\nPage the checkout team."}}, plugin("panel-7"))
+
+		assert.Equal(t, "Table", plugin("panel-8")["kind"])
+		assert.NotContains(t, queries("panel-8")[0].(map[string]interface{})["spec"].(map[string]interface{})["plugin"].(map[string]interface{})["spec"], "seriesNameFormat")
+	})
+
+	assert.Equal(t, []string{
+		`Variable "ds" has type "datasource", which cannot be converted, and was dropped.`,
+		`Query C of panel "Latency" (id 2) is not a PromQL query and was dropped.`,
+		`The panel "Latency distribution" (id 5) has type "heatmap", which cannot be converted; it was replaced by a Markdown panel.`,
+	}, conversion.Warnings)
+}
+
+func TestConvertGrafanaDashboard_LegacyRows(t *testing.T) {
+	spec, warnings := convertGrafanaTestDashboard(t, `{
+  "title": "Legacy",
+  "time": {"from": "now-1M", "to": "now"},
+  "templating": {"list": [
+    {"name": "env", "type": "constant", "query": "prod"},
+    {"name": "query", "type": "query", "query": "query_result(up)"}
+  ]},
+  "rows": [
+    {
+      "title": "Overview",
+      "showTitle": true,
+      "height": "300px",
+      "panels": [
+        {"id": 1, "type": "singlestat", "title": "Up", "span": 4, "valueName": "avg", "format": "percent",
+         "targets": [{"refId": "A", "expr": "avg(up{env=\"[[env]]\"})"}]},
+        {"id": 2, "type": "graph", "title": "Load", "span": 8, "yaxes": [{"format": "Kbits"}],
+         "targets": [{"refId": "A", "expr": "node_load1"}]},
+        {"id": 3, "type": "graph", "title": "Memory", "span": 6,
+         "targets": [{"refId": "A", "expr": "node_memory_MemFree_bytes"}]}
+      ]
+    }
+  ]
+}`)
+
+	assert.NotContains(t, spec, "duration")
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"kind": "TextVariable",
+		"spec": map[string]interface{}{"name": "env", "value": "prod", "constant": true, "display": map[string]interface{}{"hidden": true}},
+	}}, spec["variables"])
+
+	items := gridItems(t, spec, 0)
+	require.Len(t, items, 3)
+	assert.Equal(t, map[string]interface{}{"x": 0, "y": 0, "width": 8, "height": 10, "content": map[string]interface{}{"$ref": "#/spec/panels/panel-1"}}, items[0])
+	assert.Equal(t, map[string]interface{}{"x": 8, "y": 0, "width": 16, "height": 10, "content": map[string]interface{}{"$ref": "#/spec/panels/panel-2"}}, items[1])
+	// The third panel does not fit next to the others and wraps.
+	assert.Equal(t, map[string]interface{}{"x": 0, "y": 10, "width": 12, "height": 10, "content": map[string]interface{}{"$ref": "#/spec/panels/panel-3"}}, items[2])
+
+	up := spec["panels"].(map[string]interface{})["panel-1"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"kind": "StatChart", "spec": map[string]interface{}{
+		"calculation": "mean",
+		"format":      map[string]interface{}{"unit": "percent"},
+	}}, up["plugin"])
+	assert.Contains(t, up["queries"].([]interface{})[0].(map[string]interface{})["spec"].(map[string]interface{})["plugin"].(map[string]interface{})["spec"], "query")
+
+	assert.Equal(t, []string{
+		`Variable "query" uses the query "query_result(up)", which cannot be converted; only label_values() and label_names() are supported. The variable was dropped.`,
+		`The default time range "now-1M" cannot be converted; the Dash0 default is used instead.`,
+		`The panel "Load" (id 2) uses the unit "Kbits", which cannot be converted; values are shown without a unit.`,
+	}, warnings)
+}
+
+func TestConvertGrafanaDashboard_Empty(t *testing.T) {
+	spec, warnings := convertGrafanaTestDashboard(t, `{"title": "Empty", "panels": []}`)
+
+	assert.Equal(t, []interface{}{}, spec["layouts"])
+	assert.Equal(t, map[string]interface{}{}, spec["panels"])
+	assert.Empty(t, warnings)
+}
+
+func TestConvertGrafanaDashboard_Invalid(t *testing.T) {
+	for name, grafanaJSON := range map[string]string{
+		"not JSON":            "title: Overview",
+		"not a dashboard":     `{"title": "Overview"}`,
+		"envelope without it": `{"meta": {}, "dashboard": {"title": "Overview"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConvertGrafanaDashboard(grafanaJSON)
+			assert.Error(t, err)
+		})
+	}
+}
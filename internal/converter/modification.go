@@ -0,0 +1,89 @@
+package converter
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// labelVersion is the label some asset kinds report their server version in,
+// instead of metadata.version.
+const labelVersion = "dash0.com/version"
+
+// Modification is the server's record of the last change to an asset: when
+// it happened, who made it, and the version it produced. Drift detection
+// ignores these fields, so they are read from the API response separately.
+// Fields the response does not carry are empty.
+type Modification struct {
+	At      string
+	By      string
+	Version string
+}
+
+// LastModification extracts the record of the last change from an asset as
+// returned by the API, in JSON or YAML. The editor is taken from
+// metadata.dash0Extensions.updatedBy, or else metadata.updatedBy; the version
+// from metadata.version, or else the dash0.com/version label. A document that
+// cannot be parsed yields an empty Modification.
+func LastModification(doc string) Modification {
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(doc), &parsed); err != nil {
+		return Modification{}
+	}
+	metadata, _ := parsed["metadata"].(map[string]interface{})
+	extensions, _ := metadata["dash0Extensions"].(map[string]interface{})
+	labels, _ := metadata["labels"].(map[string]interface{})
+
+	modification := Modification{
+		At:      scalarString(metadata["updatedAt"]),
+		By:      scalarString(extensions["updatedBy"]),
+		Version: scalarString(metadata["version"]),
+	}
+	if modification.By == "" {
+		modification.By = scalarString(metadata["updatedBy"])
+	}
+	if modification.Version == "" {
+		modification.Version = scalarString(labels[labelVersion])
+	}
+	return modification
+}
+
+// Describe renders the modification for a diagnostic, e.g. "by
+// alice@example.com at 2024-05-02 14:02 UTC (server version 7)". Timestamps
+// in RFC 3339 format are shown in UTC to the minute, others as returned.
+func (m Modification) Describe() string {
+	var description string
+	if m.By != "" {
+		description = " by " + m.By
+	}
+	if m.At != "" {
+		at := m.At
+		if t, err := time.Parse(time.RFC3339Nano, at); err == nil {
+			at = t.UTC().Format("2006-01-02 15:04 UTC")
+		}
+		description += " at " + at
+	}
+	if m.Version != "" {
+		description += fmt.Sprintf(" (server version %s)", m.Version)
+	}
+	if description == "" {
+		return ""
+	}
+	return description[1:]
+}
+
+// scalarString renders a decoded YAML scalar as a string; anything else,
+// including a missing value, is empty.
+func scalarString(v interface{}) string {
+	switch v := v.(type) {
+	case nil, map[string]interface{}, []interface{}:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,64 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastModification(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		expected Modification
+	}{
+		{
+			name: "dash0 extensions",
+			doc:  `{"kind":"PersesDashboard","metadata":{"name":"checkout","updatedAt":"2024-05-02T14:02:31Z","version":7,"dash0Extensions":{"updatedBy":"alice@example.com"}}}`,
+			expected: Modification{
+				At:      "2024-05-02T14:02:31Z",
+				By:      "alice@example.com",
+				Version: "7",
+			},
+		},
+		{
+			name: "metadata fallbacks",
+			doc: `kind: Dash0SyntheticCheck
+metadata:
+  name: checkout
+  updatedAt: 2024-05-02T14:02:31Z
+  updatedBy: bob@example.com
+  labels:
+    dash0.com/version: "3"
+`,
+			expected: Modification{
+				At:      "2024-05-02T14:02:31Z",
+				By:      "bob@example.com",
+				Version: "3",
+			},
+		},
+		{
+			name:     "no metadata",
+			doc:      `{"kind":"Dash0View","spec":{}}`,
+			expected: Modification{},
+		},
+		{
+			name:     "invalid document",
+			doc:      `{`,
+			expected: Modification{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, LastModification(tt.doc))
+		})
+	}
+}
+
+func TestModification_Describe(t *testing.T) {
+	assert.Equal(t, "by alice@example.com at 2024-05-02 14:02 UTC (server version 7)",
+		Modification{At: "2024-05-02T16:02:31+02:00", By: "alice@example.com", Version: "7"}.Describe())
+	assert.Equal(t, "at yesterday", Modification{At: "yesterday"}.Describe())
+	assert.Equal(t, "", Modification{}.Describe())
+}
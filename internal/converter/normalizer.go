@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -472,3 +473,49 @@ func hasFieldPath(data map[string]interface{}, path string) bool {
 	}
 	return hasFieldPath(nested, parts[1])
 }
+
+// RemoveFieldPaths removes the given dot-separated field paths from a YAML or
+// JSON document and returns it as JSON. Used to redact values, such as
+// credentials, that must not reach Terraform state. The document is returned
+// unchanged when none of the paths is present.
+func RemoveFieldPaths(doc string, fields []string) (string, error) {
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(doc), &parsed); err != nil {
+		return "", fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+
+	removed := false
+	for _, field := range fields {
+		if removeFieldPath(parsed, field) {
+			removed = true
+		}
+	}
+	if !removed {
+		return doc, nil
+	}
+
+	jsonBytes, err := json.Marshal(parsed)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling to JSON: %w", err)
+	}
+	return string(jsonBytes), nil
+}
+
+// removeFieldPath deletes a dot-separated path from a nested map and reports
+// whether it was present.
+func removeFieldPath(data map[string]interface{}, path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	val, exists := data[parts[0]]
+	if !exists {
+		return false
+	}
+	if len(parts) == 1 {
+		delete(data, parts[0])
+		return true
+	}
+	nested, ok := val.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return removeFieldPath(nested, parts[1])
+}
@@ -1553,3 +1553,44 @@ spec:
 		})
 	}
 }
+
+func TestRemoveFieldPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		fields   []string
+		expected string
+	}{
+		{
+			name:     "nested fields removed",
+			doc:      `{"kind":"Dash0NotificationChannel","spec":{"type":"pagerduty","config":{"key":"secret","url":"https://events.pagerduty.com/v2/enqueue"}}}`,
+			fields:   []string{"spec.config.key"},
+			expected: `{"kind":"Dash0NotificationChannel","spec":{"config":{"url":"https://events.pagerduty.com/v2/enqueue"},"type":"pagerduty"}}`,
+		},
+		{
+			name:     "YAML input is returned as JSON",
+			doc:      "spec:\n  config:\n    auth:\n      password: hunter2\n      username: dash0\n",
+			fields:   []string{"spec.config.auth.password"},
+			expected: `{"spec":{"config":{"auth":{"username":"dash0"}}}}`,
+		},
+		{
+			name:     "absent fields leave the document untouched",
+			doc:      "spec:\n  config:\n    url: https://example.com\n",
+			fields:   []string{"spec.config.key", "spec.config.url.nested"},
+			expected: "spec:\n  config:\n    url: https://example.com\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := RemoveFieldPaths(tt.doc, tt.fields)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestRemoveFieldPaths_InvalidYAML(t *testing.T) {
+	_, err := RemoveFieldPaths("not valid yaml {", []string{"spec"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// notificationChannelSecretFields are the fields that carry credentials, per
+// notification channel type (spec.type). They are redacted from every channel
+// read back from the API, so that imported channels and channels created
+// without the write-only `secrets` attribute do not leak them into state.
+var notificationChannelSecretFields = map[string][]string{
+	"slack":               {"spec.config.webhookURL"},
+	"teams_webhook":       {"spec.config.url"},
+	"google_chat_webhook": {"spec.config.url"},
+	"discord_webhook":     {"spec.config.url"},
+	"pagerduty":           {"spec.config.key"},
+	"opsgenie":            {"spec.config.apiKey"},
+	"webhook":             {"spec.config.auth.password", "spec.config.headers"},
+}
+
+// NotificationChannelSecretFields returns the dot-separated paths of the
+// fields that carry credentials in a notification channel document, given as
+// YAML or JSON, according to its spec.type. It returns nil for channel types
+// without known credentials and for documents that cannot be parsed.
+func NotificationChannelSecretFields(doc string) []string {
+	var parsed struct {
+		Spec struct {
+			Type string `yaml:"type"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(doc), &parsed); err != nil {
+		return nil
+	}
+	return slices.Clone(notificationChannelSecretFields[parsed.Spec.Type])
+}
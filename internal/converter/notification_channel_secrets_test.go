@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationChannelSecretFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		expected []string
+	}{
+		{
+			name:     "slack webhook URL",
+			doc:      `{"kind":"Dash0NotificationChannel","spec":{"type":"slack","config":{"webhookURL":"https://hooks.slack.com/services/T0/B0/X"}}}`,
+			expected: []string{"spec.config.webhookURL"},
+		},
+		{
+			name:     "pagerduty routing key",
+			doc:      "spec:\n  type: pagerduty\n  config:\n    key: secret\n",
+			expected: []string{"spec.config.key"},
+		},
+		{
+			name:     "webhook password and headers",
+			doc:      `{"spec":{"type":"webhook","config":{"url":"https://example.com"}}}`,
+			expected: []string{"spec.config.auth.password", "spec.config.headers"},
+		},
+		{
+			name: "email has no credentials",
+			doc:  `{"spec":{"type":"email_v2","config":{"recipients":["ops@example.com"]}}}`,
+		},
+		{
+			name: "unknown type",
+			doc:  `{"spec":{"type":"carrier_pigeon"}}`,
+		},
+		{
+			name: "invalid document",
+			doc:  "not valid yaml {",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NotificationChannelSecretFields(tt.doc))
+		})
+	}
+}
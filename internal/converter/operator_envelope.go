@@ -0,0 +1,189 @@
+package converter
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kubernetes custom resources managed by the Dash0 Operator wrap the same
+// asset definitions the API accepts in a Kubernetes object envelope. The
+// functions in this file translate such an envelope into the API shape, the
+// same way the Operator does before it syncs the resource to Dash0, so the
+// Terraform provider can accept a manifest copied verbatim from a GitOps
+// repository or `kubectl get -o yaml`.
+const (
+	operatorAPIGroupPrefix = "operator.dash0.com/"
+	persesAPIGroupPrefix   = "perses.dev/"
+
+	// persesAPIVersion is the PersesDashboard version the Dash0 API accepts.
+	// Later versions nest the dashboard spec under spec.config.
+	persesAPIVersion = "perses.dev/v1alpha1"
+
+	// dash0MetadataPrefix is the label and annotation key prefix the Operator
+	// forwards to Dash0. Everything else on a Kubernetes object (kubectl's
+	// last-applied-configuration, Argo CD tracking ids, app.kubernetes.io
+	// labels) only has meaning inside the cluster.
+	dash0MetadataPrefix = "dash0.com/"
+)
+
+// kubernetesObjectMetadataFields are metadata fields populated by the
+// Kubernetes API server. Their presence marks a document as a Kubernetes
+// object rather than an API-shaped asset definition.
+var kubernetesObjectMetadataFields = []string{
+	"namespace",
+	"uid",
+	"resourceVersion",
+	"generation",
+	"creationTimestamp",
+	"managedFields",
+}
+
+// UnwrapOperatorDashboard translates a PersesDashboard custom resource into
+// the dashboard definition the Dash0 API accepts:
+//   - a perses.dev/v1alpha2 spec.config is lifted into spec and the
+//     apiVersion set to perses.dev/v1alpha1,
+//   - metadata is reduced to metadata.name plus the dash0.com/ labels and
+//     annotations,
+//   - spec.display.name defaults to metadata.name when unset.
+//
+// A perses.dev/v1alpha1 document that carries no Kubernetes object metadata is
+// already in the API shape and is returned unchanged, as is anything that
+// cannot be parsed or is not a PersesDashboard.
+func UnwrapOperatorDashboard(yamlStr string) string {
+	doc, ok := parseEnvelope(yamlStr, "PersesDashboard", persesAPIGroupPrefix)
+	if !ok {
+		return yamlStr
+	}
+	spec, _ := doc["spec"].(map[string]interface{})
+	config, hasConfig := spec["config"].(map[string]interface{})
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	if !hasConfig && !isKubernetesObject(metadata) && doc["apiVersion"] == persesAPIVersion {
+		return yamlStr
+	}
+
+	if hasConfig {
+		spec = config
+		doc["spec"] = spec
+	}
+	doc["apiVersion"] = persesAPIVersion
+	return unwrapEnvelope(yamlStr, doc, spec)
+}
+
+// UnwrapOperatorView translates a Dash0View custom resource
+// (operator.dash0.com) into the view definition the Dash0 API accepts. The
+// apiVersion is dropped, metadata is reduced to metadata.name plus the
+// dash0.com/ labels and annotations, and spec.display.name defaults to
+// metadata.name when unset. Anything that is not an operator.dash0.com
+// Dash0View is returned unchanged.
+func UnwrapOperatorView(yamlStr string) string {
+	doc, ok := parseEnvelope(yamlStr, "Dash0View", operatorAPIGroupPrefix)
+	if !ok {
+		return yamlStr
+	}
+	delete(doc, "apiVersion")
+	spec, _ := doc["spec"].(map[string]interface{})
+	return unwrapEnvelope(yamlStr, doc, spec)
+}
+
+// UnwrapOperatorSyntheticCheck translates a Dash0SyntheticCheck custom
+// resource (operator.dash0.com) into the synthetic check definition the Dash0
+// API accepts. The apiVersion is dropped, metadata is reduced to
+// metadata.name plus the dash0.com/ labels and annotations, and
+// spec.plugin.display.name defaults to metadata.name when unset. Anything
+// that is not an operator.dash0.com Dash0SyntheticCheck is returned unchanged.
+func UnwrapOperatorSyntheticCheck(yamlStr string) string {
+	doc, ok := parseEnvelope(yamlStr, "Dash0SyntheticCheck", operatorAPIGroupPrefix)
+	if !ok {
+		return yamlStr
+	}
+	delete(doc, "apiVersion")
+	spec, _ := doc["spec"].(map[string]interface{})
+	plugin, _ := spec["plugin"].(map[string]interface{})
+	return unwrapEnvelope(yamlStr, doc, plugin)
+}
+
+// parseEnvelope parses yamlStr and reports whether it is a custom resource of
+// the given kind in an API group starting with apiGroupPrefix.
+func parseEnvelope(yamlStr, kind, apiGroupPrefix string) (map[string]interface{}, bool) {
+	var doc map[string]interface{}
+	if yaml.Unmarshal([]byte(yamlStr), &doc) != nil || doc == nil {
+		return nil, false
+	}
+	apiVersion, _ := doc["apiVersion"].(string)
+	if doc["kind"] != kind || !strings.HasPrefix(apiVersion, apiGroupPrefix) {
+		return nil, false
+	}
+	return doc, true
+}
+
+// unwrapEnvelope rewrites doc's metadata the way the Operator does and
+// defaults displayParent's display.name to metadata.name, then renders the
+// result. displayParent may be nil when the document has no such section, in
+// which case no default is applied. Returns yamlStr, the document doc was
+// parsed from, if rendering fails.
+func unwrapEnvelope(yamlStr string, doc, displayParent map[string]interface{}) string {
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+
+	translated := map[string]interface{}{}
+	if name != "" {
+		translated["name"] = name
+	}
+	if labels := dash0Keys(metadata["labels"]); len(labels) > 0 {
+		translated["labels"] = labels
+	}
+	if annotations := dash0Keys(metadata["annotations"]); len(annotations) > 0 {
+		translated["annotations"] = annotations
+	}
+	if len(translated) > 0 {
+		doc["metadata"] = translated
+	} else {
+		delete(doc, "metadata")
+	}
+
+	if displayParent != nil && name != "" {
+		display, _ := displayParent["display"].(map[string]interface{})
+		if display == nil {
+			display = map[string]interface{}{}
+			displayParent["display"] = display
+		}
+		if current, _ := display["name"].(string); current == "" {
+			display["name"] = name
+		}
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return yamlStr
+	}
+	return string(out)
+}
+
+// isKubernetesObject reports whether metadata carries any field populated by
+// the Kubernetes API server.
+func isKubernetesObject(metadata map[string]interface{}) bool {
+	for _, field := range kubernetesObjectMetadataFields {
+		if _, ok := metadata[field]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// dash0Keys returns the entries of a label or annotation map whose keys carry
+// the dash0.com/ prefix, or nil when m is not a map.
+func dash0Keys(m interface{}) map[string]interface{} {
+	entries, _ := m.(map[string]interface{})
+	var out map[string]interface{}
+	for key, value := range entries {
+		if !strings.HasPrefix(key, dash0MetadataPrefix) {
+			continue
+		}
+		if out == nil {
+			out = map[string]interface{}{}
+		}
+		out[key] = value
+	}
+	return out
+}
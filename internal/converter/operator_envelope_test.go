@@ -0,0 +1,229 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnwrapOperatorDashboard(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		// want is compared structurally against the result; empty means the
+		// input must be returned unchanged.
+		want string
+	}{
+		{
+			name: "v1alpha2 spec.config is lifted into spec",
+			in: `apiVersion: perses.dev/v1alpha2
+kind: PersesDashboard
+metadata:
+  name: hello-world
+  namespace: monitoring
+spec:
+  config:
+    duration: 30m
+    display:
+      name: Hello World
+  instanceSelector:
+    matchLabels:
+      app: perses
+`,
+			want: `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: hello-world
+spec:
+  duration: 30m
+  display:
+    name: Hello World
+`,
+		},
+		{
+			name: "Kubernetes metadata is reduced to the name and dash0.com keys",
+			in: `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: hello-world
+  namespace: monitoring
+  uid: 0b9c1a54-2d7e-4f0a-9d4f-0b6f0e0a1b2c
+  resourceVersion: "4711"
+  labels:
+    app.kubernetes.io/name: shop
+    dash0.com/dataset: default
+  annotations:
+    dash0.com/folder-path: /shop
+    kubectl.kubernetes.io/last-applied-configuration: "{}"
+spec:
+  duration: 30m
+`,
+			want: `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: hello-world
+  labels:
+    dash0.com/dataset: default
+  annotations:
+    dash0.com/folder-path: /shop
+spec:
+  duration: 30m
+  display:
+    name: hello-world
+`,
+		},
+		{
+			name: "v1alpha1 without Kubernetes metadata is already API-shaped",
+			in: `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: hello-world
+  annotations:
+    custom: kept
+spec:
+  duration: 30m
+`,
+		},
+		{
+			name: "other kinds are returned unchanged",
+			in:   "kind: Dashboard\nmetadata:\n  name: x\n  namespace: y\n",
+		},
+		{
+			name: "unparseable YAML is returned unchanged",
+			in:   "{{ not yaml",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertUnwrapped(t, UnwrapOperatorDashboard(tc.in), tc.in, tc.want)
+		})
+	}
+}
+
+func TestUnwrapOperatorView(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "envelope is unwrapped and display name defaulted",
+			in: `apiVersion: operator.dash0.com/v1alpha1
+kind: Dash0View
+metadata:
+  name: sync-jobs
+  namespace: observability
+  annotations:
+    dash0.com/sharing: team:platform
+    argocd.argoproj.io/tracking-id: shop:operator.dash0.com/Dash0View:observability/sync-jobs
+spec:
+  type: spans
+`,
+			want: `kind: Dash0View
+metadata:
+  name: sync-jobs
+  annotations:
+    dash0.com/sharing: team:platform
+spec:
+  type: spans
+  display:
+    name: sync-jobs
+`,
+		},
+		{
+			name: "explicit display name is kept",
+			in: `apiVersion: operator.dash0.com/v1alpha1
+kind: Dash0View
+metadata:
+  name: sync-jobs
+spec:
+  display:
+    name: Sync Jobs
+`,
+			want: `kind: Dash0View
+metadata:
+  name: sync-jobs
+spec:
+  display:
+    name: Sync Jobs
+`,
+		},
+		{
+			name: "API-shaped view is returned unchanged",
+			in:   "kind: Dash0View\nmetadata:\n  name: sync-jobs\nspec:\n  type: spans\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertUnwrapped(t, UnwrapOperatorView(tc.in), tc.in, tc.want)
+		})
+	}
+}
+
+func TestUnwrapOperatorSyntheticCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "envelope is unwrapped and plugin display name defaulted",
+			in: `apiVersion: operator.dash0.com/v1alpha1
+kind: Dash0SyntheticCheck
+metadata:
+  name: examplecom
+  namespace: default
+  labels:
+    team: web
+spec:
+  enabled: true
+  plugin:
+    kind: http
+    spec:
+      request:
+        url: https://www.example.com
+`,
+			want: `kind: Dash0SyntheticCheck
+metadata:
+  name: examplecom
+spec:
+  enabled: true
+  plugin:
+    kind: http
+    display:
+      name: examplecom
+    spec:
+      request:
+        url: https://www.example.com
+`,
+		},
+		{
+			name: "a view envelope is not a synthetic check",
+			in:   "apiVersion: operator.dash0.com/v1alpha1\nkind: Dash0View\nmetadata:\n  name: x\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertUnwrapped(t, UnwrapOperatorSyntheticCheck(tc.in), tc.in, tc.want)
+		})
+	}
+}
+
+// assertUnwrapped checks got structurally against want, or, when want is
+// empty, that the input was returned verbatim.
+func assertUnwrapped(t *testing.T, got, in, want string) {
+	t.Helper()
+	if want == "" {
+		assert.Equal(t, in, got)
+		return
+	}
+	var gotDoc, wantDoc interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(got), &gotDoc))
+	require.NoError(t, yaml.Unmarshal([]byte(want), &wantDoc))
+	assert.Equal(t, wantDoc, gotDoc)
+}
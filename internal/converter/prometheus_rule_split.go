@@ -0,0 +1,183 @@
+package converter
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	dash0yaml "github.com/dash0hq/dash0-api-client-go/yaml"
+)
+
+// PrometheusRuleMember is a single rule split out of a multi-group,
+// multi-rule PrometheusRule document by SplitPrometheusRules.
+type PrometheusRuleMember struct {
+	// Key identifies the rule within the document as "<group>/<alert>" or
+	// "<group>/<record>". When the same name appears more than once in a
+	// group, later occurrences are suffixed with "#2", "#3", and so on.
+	Key string
+	// Group is the name of the group the rule belongs to.
+	Group string
+	// Name is the rule's alert name, or its record name for recording rules.
+	Name string
+	// IsRecording reports whether the rule is a recording rule rather than
+	// an alerting rule.
+	IsRecording bool
+	// YAML is a PrometheusRule document holding only this rule, inside a
+	// copy of its group (including the group's interval). For alerting
+	// rules, the source document's top-level metadata.annotations are merged
+	// into the rule's own annotations, rule-level annotations winning on key
+	// conflict, the same way MoveTopLevelAnnotationsIntoRules does.
+	YAML string
+}
+
+// SplitPrometheusRules splits a PrometheusRule document into one single-rule
+// document per alerting or recording rule, in document order. Every member
+// document keeps the source document's apiVersion, kind and metadata.name.
+//
+// Returns an error if the document cannot be parsed, has no spec.groups, has
+// a group without a name, has a rule that is neither (or both) an alerting
+// and a recording rule, or contains no rules at all.
+func SplitPrometheusRules(yamlStr string) ([]PrometheusRuleMember, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing PrometheusRule YAML: %w", err)
+	}
+	spec, _ := doc["spec"].(map[string]interface{})
+	groups, ok := spec["groups"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("PrometheusRule document has no spec.groups")
+	}
+
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	topLevelAnnotations, _ := metadata["annotations"].(map[string]interface{})
+
+	var members []PrometheusRuleMember
+	seen := map[string]int{}
+	for gi, g := range groups {
+		group, _ := g.(map[string]interface{})
+		groupName, _ := group["name"].(string)
+		if groupName == "" {
+			return nil, fmt.Errorf("spec.groups[%d] has no name", gi)
+		}
+		rules, _ := group["rules"].([]interface{})
+		for ri, r := range rules {
+			rule, _ := r.(map[string]interface{})
+			alert, _ := rule["alert"].(string)
+			record, _ := rule["record"].(string)
+			if (alert == "") == (record == "") {
+				return nil, fmt.Errorf("spec.groups[%d].rules[%d] must set exactly one of alert or record", gi, ri)
+			}
+
+			member := PrometheusRuleMember{Group: groupName, Name: alert}
+			if record != "" {
+				member.Name = record
+				member.IsRecording = true
+			} else if len(topLevelAnnotations) > 0 {
+				ruleAnnotations, _ := rule["annotations"].(map[string]interface{})
+				rule["annotations"] = dash0yaml.MergeAnnotations(
+					toStringMap(topLevelAnnotations),
+					toStringMap(ruleAnnotations),
+				)
+			}
+
+			member.Key = groupName + "/" + member.Name
+			seen[member.Key]++
+			if n := seen[member.Key]; n > 1 {
+				member.Key = fmt.Sprintf("%s#%d", member.Key, n)
+			}
+
+			memberYAML, err := yaml.Marshal(singleRuleDocument(doc, group, rule))
+			if err != nil {
+				return nil, fmt.Errorf("error encoding rule %s: %w", member.Key, err)
+			}
+			member.YAML = string(memberYAML)
+			members = append(members, member)
+		}
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("PrometheusRule document contains no rules")
+	}
+	return members, nil
+}
+
+// JoinPrometheusRules reassembles single-rule PrometheusRule documents into
+// one document named name. Each member's rule is taken from the first rule of
+// the first group in its YAML and placed into the group named by its Group
+// field; groups appear in the order their first member does, and every group
+// keeps the fields (such as interval) of its first member's group.
+//
+// This is the inverse of SplitPrometheusRules, used to show a document
+// reflecting the server-side state of its members when they have drifted.
+func JoinPrometheusRules(name string, members []PrometheusRuleMember) (string, error) {
+	var groups []interface{}
+	byName := map[string]map[string]interface{}{}
+	for _, member := range members {
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal([]byte(member.YAML), &doc); err != nil {
+			return "", fmt.Errorf("error parsing rule %s: %w", member.Key, err)
+		}
+		spec, _ := doc["spec"].(map[string]interface{})
+		memberGroups, _ := spec["groups"].([]interface{})
+		if len(memberGroups) == 0 {
+			return "", fmt.Errorf("rule %s has no group", member.Key)
+		}
+		memberGroup, _ := memberGroups[0].(map[string]interface{})
+		rules, _ := memberGroup["rules"].([]interface{})
+		if len(rules) == 0 {
+			return "", fmt.Errorf("rule %s has no rule in its group", member.Key)
+		}
+
+		group, ok := byName[member.Group]
+		if !ok {
+			group = map[string]interface{}{}
+			for k, v := range memberGroup {
+				if k != "rules" {
+					group[k] = v
+				}
+			}
+			group["name"] = member.Group
+			group["rules"] = []interface{}{}
+			byName[member.Group] = group
+			groups = append(groups, group)
+		}
+		group["rules"] = append(group["rules"].([]interface{}), rules[0])
+	}
+
+	doc := map[string]interface{}{
+		"apiVersion": "monitoring.coreos.com/v1",
+		"kind":       "PrometheusRule",
+		"spec":       map[string]interface{}{"groups": groups},
+	}
+	if name != "" {
+		doc["metadata"] = map[string]interface{}{"name": name}
+	}
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("error encoding PrometheusRule YAML: %w", err)
+	}
+	return string(out), nil
+}
+
+// singleRuleDocument returns a PrometheusRule document holding only rule,
+// inside a copy of group, with doc's apiVersion, kind and metadata.name.
+func singleRuleDocument(doc, group, rule map[string]interface{}) map[string]interface{} {
+	memberGroup := make(map[string]interface{}, len(group))
+	for k, v := range group {
+		memberGroup[k] = v
+	}
+	memberGroup["rules"] = []interface{}{rule}
+
+	out := map[string]interface{}{
+		"spec": map[string]interface{}{"groups": []interface{}{memberGroup}},
+	}
+	for _, k := range []string{"apiVersion", "kind"} {
+		if v, ok := doc[k]; ok {
+			out[k] = v
+		}
+	}
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	if name, ok := metadata["name"]; ok {
+		out["metadata"] = map[string]interface{}{"name": name}
+	}
+	return out
+}
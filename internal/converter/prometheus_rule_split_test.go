@@ -0,0 +1,111 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSplitPrometheusRules(t *testing.T) {
+	in := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: shop
+  annotations:
+    dash0.com/sharing: all-users
+spec:
+  groups:
+    - name: Availability
+      interval: 1m
+      rules:
+        - alert: ServiceDown
+          expr: up == 0
+        - alert: ServiceDown
+          expr: up{critical="true"} == 0
+          annotations:
+            dash0.com/sharing: private
+        - record: job:up:sum
+          expr: sum by (job) (up)
+`
+	members, err := SplitPrometheusRules(in)
+	require.NoError(t, err)
+	require.Len(t, members, 3)
+
+	assert.Equal(t, "Availability/ServiceDown", members[0].Key)
+	assert.Equal(t, "Availability/ServiceDown#2", members[1].Key)
+	assert.Equal(t, "Availability/job:up:sum", members[2].Key)
+	assert.True(t, members[2].IsRecording)
+	assert.Equal(t, "job:up:sum", members[2].Name)
+
+	var first struct {
+		Metadata struct {
+			Name string `yaml:"name"`
+		} `yaml:"metadata"`
+		Spec struct {
+			Groups []struct {
+				Name     string                   `yaml:"name"`
+				Interval string                   `yaml:"interval"`
+				Rules    []map[string]interface{} `yaml:"rules"`
+			} `yaml:"groups"`
+		} `yaml:"spec"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(members[0].YAML), &first))
+	assert.Equal(t, "shop", first.Metadata.Name)
+	require.Len(t, first.Spec.Groups, 1)
+	assert.Equal(t, "1m", first.Spec.Groups[0].Interval)
+	require.Len(t, first.Spec.Groups[0].Rules, 1)
+	assert.Equal(t, map[string]interface{}{"dash0.com/sharing": "all-users"}, first.Spec.Groups[0].Rules[0]["annotations"],
+		"top-level annotations are merged into alerting rules")
+
+	assert.Contains(t, members[1].YAML, "dash0.com/sharing: private", "rule-level annotations win")
+	assert.NotContains(t, members[2].YAML, "annotations", "recording rules do not receive annotations")
+}
+
+func TestSplitPrometheusRules_Errors(t *testing.T) {
+	tests := map[string]string{
+		"invalid YAML":    "{{ not yaml",
+		"no groups":       "spec: {}\n",
+		"unnamed group":   "spec:\n  groups:\n    - rules:\n        - alert: A\n          expr: up\n",
+		"neither":         "spec:\n  groups:\n    - name: g\n      rules:\n        - expr: up\n",
+		"both":            "spec:\n  groups:\n    - name: g\n      rules:\n        - alert: A\n          record: b\n          expr: up\n",
+		"no rules at all": "spec:\n  groups:\n    - name: g\n",
+	}
+	for name, in := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := SplitPrometheusRules(in)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJoinPrometheusRules_RoundTrip(t *testing.T) {
+	in := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: shop
+spec:
+  groups:
+    - name: A
+      interval: 1m
+      rules:
+        - alert: One
+          expr: up == 0
+        - record: two
+          expr: sum(up)
+    - name: B
+      rules:
+        - alert: Three
+          expr: up == 1
+`
+	members, err := SplitPrometheusRules(in)
+	require.NoError(t, err)
+
+	joined, err := JoinPrometheusRules("shop", members)
+	require.NoError(t, err)
+
+	equivalent, err := ResourceYAMLEquivalent(in, joined, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, equivalent, "joined document:\n%s", joined)
+}
@@ -3,6 +3,7 @@ package converter
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -24,3 +25,31 @@ func ConvertYAMLToJSON(yamlString string) (string, error) {
 
 	return string(jsonBytes), nil
 }
+
+// ConvertToConfigYAML converts an asset as returned by the API into the YAML
+// document a resource configuration carries. The metadata the server manages
+// (labels, timestamps, version and extensions), which drift detection ignores
+// anyway, is removed; everything else is kept as returned.
+func ConvertToConfigYAML(doc string) (string, error) {
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(doc), &parsed); err != nil {
+		return "", fmt.Errorf("error parsing resource YAML: %w", err)
+	}
+
+	for _, field := range ignoredFields {
+		if strings.HasPrefix(field, "metadata.") {
+			removeFieldPath(parsed, field)
+		}
+	}
+
+	var buf strings.Builder
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(parsed); err != nil {
+		return "", fmt.Errorf("error encoding YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("error closing YAML encoder: %w", err)
+	}
+	return buf.String(), nil
+}
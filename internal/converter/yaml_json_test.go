@@ -127,3 +127,23 @@ spec:
 		assert.Error(t, err)
 	})
 }
+
+func TestConvertToConfigYAML(t *testing.T) {
+	configYAML, err := ConvertToConfigYAML(`{"kind":"PersesDashboard","metadata":{"name":"overview","createdAt":"2024-01-01T00:00:00Z","version":3,"labels":{"dash0.com/origin":"tf_1"},"annotations":{"dash0.com/sharing":"team"}},"spec":{"display":{"name":"Overview"}}}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, `kind: PersesDashboard
+metadata:
+  annotations:
+    dash0.com/sharing: team
+  name: overview
+spec:
+  display:
+    name: Overview
+`, configYAML)
+}
+
+func TestConvertToConfigYAML_Invalid(t *testing.T) {
+	_, err := ConvertToConfigYAML("kind: [")
+	assert.Error(t, err)
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &AccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &AccessTokenEphemeralResource{}
+)
+
+const (
+	// accessTokenRenewInterval is how often an OAuth access token is renewed
+	// while the run lasts. The provider does not know when the token expires,
+	// so the profile's token provider, which refreshes the token as it nears
+	// expiry, is consulted at this interval.
+	accessTokenRenewInterval = time.Minute
+	// accessTokenPrivateKey is the private state key under which the SHA-256
+	// hash of the handed-out OAuth access token is kept, so that Renew can
+	// tell whether the token was refreshed. The token itself is not stored.
+	accessTokenPrivateKey = "token_sha256"
+)
+
+// ephemeralProviderData is what Configure stores as
+// resp.EphemeralResourceData: the credentials the provider authenticates
+// with, for ephemeral resources that hand them on to other providers.
+type ephemeralProviderData struct {
+	tokenProvider  dash0.AuthTokenProvider
+	isOAuth        bool
+	apiURL         string
+	otlpURL        string
+	defaultDataset string
+}
+
+// NewAccessTokenEphemeralResource is a helper function to simplify the provider
+// implementation.
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+// AccessTokenEphemeralResource surfaces the token the provider authenticates
+// with, without it being stored in plan or state.
+type AccessTokenEphemeralResource struct {
+	data *ephemeralProviderData
+}
+
+// accessTokenModel is the Terraform model of the access token ephemeral
+// resource.
+type accessTokenModel struct {
+	Token   types.String `tfsdk:"token"`
+	OAuth   types.Bool   `tfsdk:"oauth"`
+	APIURL  types.String `tfsdk:"api_url"`
+	OtlpURL types.String `tfsdk:"otlp_url"`
+	Dataset types.String `tfsdk:"dataset"`
+}
+
+// Configure adds the provider configured credentials to the ephemeral resource.
+func (r *AccessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(ephemeralProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected provider.ephemeralProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = &data
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the Dash0 auth token the provider is configured with, for the write-only arguments and ephemeral " +
+			"inputs of other providers, such as a Kubernetes secret or the Helm release of an OpenTelemetry Collector. " +
+			"The token is never stored in the plan or state.\n\n" +
+			"When the provider's credentials come from an OAuth-enabled dash0 CLI profile, the token is the current " +
+			"OAuth access token, refreshed if it is about to expire when the resource is opened. While the run lasts, " +
+			"Terraform renews the resource every minute, which refreshes the profile's access token as it nears expiry. " +
+			"Terraform cannot change a token it has already handed to other resources, so a refresh is reported as a " +
+			"warning: resources that still use the earlier token fail to authenticate once it expires, and running " +
+			"Terraform again hands them the new one. OAuth access tokens are not accepted by the Dash0 OTLP ingress " +
+			"endpoint; check `oauth`, for example in a postcondition, before handing the token to a Collector.\n\n" +
+			"Ephemeral resources require Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "The auth token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"oauth": schema.BoolAttribute{
+				Description: "Whether the token is a short-lived OAuth access token from a dash0 CLI profile rather than an `auth_`-prefixed auth token.",
+				Computed:    true,
+			},
+			"api_url": schema.StringAttribute{
+				Description: "The base URL of the Dash0 API the provider is configured with.",
+				Computed:    true,
+			},
+			"otlp_url": schema.StringAttribute{
+				Description: "The Dash0 OTLP endpoint the provider is configured with, or null when it is not configured.",
+				Computed:    true,
+			},
+			"dataset": schema.StringAttribute{
+				Description: "The provider-level default dataset, to send telemetry to with the `Dash0-Dataset` header.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model accessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RenewAt = r.open(ctx, &model, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "opened an access token ephemeral resource")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// open fills in the token and the provider's settings. For an OAuth access
+// token it records the token's hash in private state and returns when to
+// renew it; otherwise it returns the zero time, as auth tokens do not expire.
+func (r *AccessTokenEphemeralResource) open(ctx context.Context, model *accessTokenModel, private privateStateSetter, diags *diag.Diagnostics) time.Time {
+	if r.data == nil {
+		diags.AddError(
+			"Unconfigured Dash0 Provider",
+			"The Dash0 provider has not been configured, so there is no token to provide. Please report this issue to the provider developers.",
+		)
+		return time.Time{}
+	}
+
+	token, err := r.data.tokenProvider.AuthToken(ctx)
+	if err != nil {
+		diags.AddError("Unable to Get Dash0 Auth Token", fmt.Sprintf("The auth token could not be obtained: %s", err))
+		return time.Time{}
+	}
+
+	model.Token = types.StringValue(token)
+	model.OAuth = types.BoolValue(r.data.isOAuth)
+	model.APIURL = types.StringValue(r.data.apiURL)
+	model.OtlpURL = stringOrNull(r.data.otlpURL)
+	model.Dataset = types.StringValue(r.data.defaultDataset)
+
+	if !r.data.isOAuth {
+		return time.Time{}
+	}
+	writeAccessTokenHash(ctx, private, token, diags)
+	return time.Now().Add(accessTokenRenewInterval)
+}
+
+// Renew refreshes the OAuth access token of the provider's dash0 CLI profile
+// as it nears expiry.
+func (r *AccessTokenEphemeralResource) Renew(ctx context.Context, _ ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	resp.RenewAt = r.renew(ctx, resp.Private, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "renewed an access token ephemeral resource")
+}
+
+// renew asks the token provider for the current token and warns when it is no
+// longer the one handed out, which Terraform cannot replace. It returns when
+// to renew next.
+func (r *AccessTokenEphemeralResource) renew(ctx context.Context, private interface {
+	privateStateGetter
+	privateStateSetter
+}, diags *diag.Diagnostics) time.Time {
+	if r.data == nil {
+		diags.AddError(
+			"Unconfigured Dash0 Provider",
+			"The Dash0 provider has not been configured, so the token cannot be renewed. Please report this issue to the provider developers.",
+		)
+		return time.Time{}
+	}
+
+	token, err := r.data.tokenProvider.AuthToken(ctx)
+	if err != nil {
+		diags.AddError("Unable to Renew Dash0 Auth Token", fmt.Sprintf("The OAuth access token could not be refreshed: %s", err))
+		return time.Time{}
+	}
+
+	raw, d := private.GetKey(ctx, accessTokenPrivateKey)
+	diags.Append(d...)
+	var handedOut string
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &handedOut); err != nil {
+			diags.AddWarning("Unable to read the Dash0 access token hash", fmt.Sprintf("Whether the token was refreshed cannot be determined: %s", err))
+		}
+	}
+	if handedOut != "" && handedOut != accessTokenHash(token) {
+		diags.AddWarning(
+			"Dash0 access token refreshed",
+			"The OAuth access token of the dash0 CLI profile neared expiry and was refreshed. Terraform cannot update the token it "+
+				"already handed to other resources, so resources that still use the earlier token fail to authenticate once it "+
+				"expires. Run Terraform again to hand them the new token.",
+		)
+	}
+	writeAccessTokenHash(ctx, private, token, diags)
+	return time.Now().Add(accessTokenRenewInterval)
+}
+
+// accessTokenHash returns the hex-encoded SHA-256 hash of token.
+func accessTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeAccessTokenHash records the hash of the handed-out token in private
+// state.
+func writeAccessTokenHash(ctx context.Context, private privateStateSetter, token string, diags *diag.Diagnostics) {
+	raw, err := json.Marshal(accessTokenHash(token))
+	if err != nil {
+		diags.AddError("Unable to record the Dash0 access token hash", err.Error())
+		return
+	}
+	diags.Append(private.SetKey(ctx, accessTokenPrivateKey, raw)...)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+func accessTokenTestSchema() schema.Schema {
+	resp := &ephemeral.SchemaResponse{}
+	(&AccessTokenEphemeralResource{}).Schema(context.Background(), ephemeral.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// accessTokenTestConfig builds a configuration in which every attribute is
+// null, as they are all computed.
+func accessTokenTestConfig() tfsdk.Config {
+	objectType := accessTokenTestSchema().Type().TerraformType(context.Background()).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tfsdk.Config{Raw: tftypes.NewValue(objectType, values), Schema: accessTokenTestSchema()}
+}
+
+// failingTokenProvider is an auth token provider whose token cannot be
+// obtained, e.g. because an OAuth refresh failed.
+type failingTokenProvider struct{}
+
+func (failingTokenProvider) AuthToken(context.Context) (string, error) {
+	return "", errors.New("refresh token expired")
+}
+
+// rotatingTokenProvider is an auth token provider that hands out the next of
+// its tokens on every call, as an OAuth profile does after each refresh.
+type rotatingTokenProvider struct {
+	tokens []string
+}
+
+func (p *rotatingTokenProvider) AuthToken(context.Context) (string, error) {
+	token := p.tokens[0]
+	if len(p.tokens) > 1 {
+		p.tokens = p.tokens[1:]
+	}
+	return token, nil
+}
+
+func TestAccessTokenEphemeralResource_Metadata(t *testing.T) {
+	resp := &ephemeral.MetadataResponse{}
+	(&AccessTokenEphemeralResource{}).Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "dash0"}, resp)
+	assert.Equal(t, "dash0_access_token", resp.TypeName)
+}
+
+func TestAccessTokenEphemeralResource_Schema(t *testing.T) {
+	s := accessTokenTestSchema()
+
+	assert.True(t, s.Attributes["token"].IsComputed())
+	assert.True(t, s.Attributes["token"].IsSensitive())
+}
+
+func TestAccessTokenEphemeralResource_Open(t *testing.T) {
+	r := &AccessTokenEphemeralResource{data: &ephemeralProviderData{
+		tokenProvider:  dash0.StaticAuthTokenProvider("auth_test_token"),
+		apiURL:         "https://api.eu-west-1.aws.dash0.com",
+		otlpURL:        "https://ingress.eu-west-1.aws.dash0.com",
+		defaultDataset: "production",
+	}}
+	config := accessTokenTestConfig()
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Raw: config.Raw, Schema: config.Schema}}
+	r.Open(context.Background(), ephemeral.OpenRequest{Config: config}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var model accessTokenModel
+	require.False(t, resp.Result.Get(context.Background(), &model).HasError())
+	assert.Equal(t, "auth_test_token", model.Token.ValueString())
+	assert.False(t, model.OAuth.ValueBool())
+	assert.Equal(t, "https://api.eu-west-1.aws.dash0.com", model.APIURL.ValueString())
+	assert.Equal(t, "https://ingress.eu-west-1.aws.dash0.com", model.OtlpURL.ValueString())
+	assert.Equal(t, "production", model.Dataset.ValueString())
+	assert.True(t, resp.RenewAt.IsZero(), "auth tokens do not expire and are not renewed")
+}
+
+func TestAccessTokenEphemeralResource_OpenAndRenew_OAuth(t *testing.T) {
+	r := &AccessTokenEphemeralResource{data: &ephemeralProviderData{
+		tokenProvider:  &rotatingTokenProvider{tokens: []string{"dash0_at_first", "dash0_at_first", "dash0_at_second"}},
+		isOAuth:        true,
+		apiURL:         "https://api.eu-west-1.aws.dash0.com",
+		defaultDataset: "default",
+	}}
+	private := testPrivateState{}
+
+	var model accessTokenModel
+	var diags diag.Diagnostics
+	renewAt := r.open(context.Background(), &model, private, &diags)
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	assert.Equal(t, "dash0_at_first", model.Token.ValueString())
+	assert.True(t, model.OAuth.ValueBool())
+	assert.True(t, model.OtlpURL.IsNull())
+	assert.WithinDuration(t, time.Now().Add(accessTokenRenewInterval), renewAt, 5*time.Second)
+	assert.NotContains(t, string(private[accessTokenPrivateKey]), "dash0_at_first", "only the token's hash is kept")
+
+	t.Run("token unchanged", func(t *testing.T) {
+		var diags diag.Diagnostics
+		renewAt := r.renew(context.Background(), private, &diags)
+		assert.Empty(t, diags)
+		assert.False(t, renewAt.IsZero())
+	})
+
+	t.Run("token refreshed", func(t *testing.T) {
+		var diags diag.Diagnostics
+		r.renew(context.Background(), private, &diags)
+		assert.False(t, diags.HasError())
+		require.Equal(t, 1, diags.WarningsCount(), "diagnostics: %v", diags)
+		assert.Equal(t, "Dash0 access token refreshed", diags.Warnings()[0].Summary())
+	})
+}
+
+func TestAccessTokenEphemeralResource_Open_TokenError(t *testing.T) {
+	r := &AccessTokenEphemeralResource{data: &ephemeralProviderData{tokenProvider: failingTokenProvider{}, isOAuth: true}}
+
+	var diags diag.Diagnostics
+	r.open(context.Background(), &accessTokenModel{}, testPrivateState{}, &diags)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "refresh token expired")
+
+	diags = nil
+	r.renew(context.Background(), testPrivateState{}, &diags)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "refresh token expired")
+}
+
+func TestAccessTokenEphemeralResource_ConfigureRejectsWrongProviderData(t *testing.T) {
+	resp := &ephemeral.ConfigureResponse{}
+	(&AccessTokenEphemeralResource{}).Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: "not provider data"}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// adoptExistingAttribute returns the `adopt_existing` attribute of an asset
+// resource, described by noun (for example "dashboard"). name describes the
+// field the asset is matched by, and scope where it is looked up.
+func adoptExistingAttribute(noun, name, scope string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether creating the resource takes over an existing %[1]s with the same %[2]s %[3]s, for example one created in the Dash0 UI, instead of creating a second %[1]s next to it. The existing %[1]s is updated in place to match the configuration and keeps its identifier, which becomes the resource's `origin`. If several %[1]ss have the same %[2]s, the apply fails instead of guessing; import one of them instead. Existing %[1]ss created by Terraform, whose origin starts with `tf_`, belong to another resource and are never taken over. Only applies when the resource is created. If omitted, the provider-level `adopt_existing` default is used.", noun, name, scope),
+		Optional:    true,
+	}
+}
+
+// adoptionEnabled returns the `adopt_existing` setting of a resource being
+// created: its own attribute if configured, else the provider-level default.
+func adoptionEnabled(configured types.Bool, providerDefault bool) bool {
+	if configured.IsNull() || configured.IsUnknown() {
+		return providerDefault
+	}
+	return configured.ValueBool()
+}
+
+// assetListName returns the name an asset defined by document, in YAML or
+// JSON, is listed under: `spec.display.name` for assets with a display name
+// (`spec.plugin.display.name` for synthetic checks), the alert of a check
+// rule, and `metadata.name` for everything else. It returns an empty string
+// when the document names none of them.
+func assetListName(document string) string {
+	var doc struct {
+		Metadata struct {
+			Name string `yaml:"name"`
+		} `yaml:"metadata"`
+		Spec struct {
+			Display struct {
+				Name string `yaml:"name"`
+			} `yaml:"display"`
+			Plugin struct {
+				Display struct {
+					Name string `yaml:"name"`
+				} `yaml:"display"`
+			} `yaml:"plugin"`
+			Groups []struct {
+				Rules []struct {
+					Alert string `yaml:"alert"`
+				} `yaml:"rules"`
+			} `yaml:"groups"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(document), &doc); err != nil {
+		return ""
+	}
+	switch {
+	case doc.Spec.Display.Name != "":
+		return doc.Spec.Display.Name
+	case doc.Spec.Plugin.Display.Name != "":
+		return doc.Spec.Plugin.Display.Name
+	case len(doc.Spec.Groups) > 0 && len(doc.Spec.Groups[0].Rules) > 0 && doc.Spec.Groups[0].Rules[0].Alert != "":
+		return doc.Spec.Groups[0].Rules[0].Alert
+	}
+	return doc.Metadata.Name
+}
+
+// terraformManagedOrigin reports whether origin was generated by the provider
+// when creating an asset, which then belongs to a Terraform resource.
+func terraformManagedOrigin(origin string) bool {
+	return strings.HasPrefix(origin, "tf_")
+}
+
+// adoptionTarget returns the origin of the asset a create with
+// `adopt_existing` takes over: the one asset among those list returns whose
+// name is that of document (see assetListName). Assets with a Terraform
+// generated origin belong to another resource and are never adopted; when
+// only such assets have the name, that is an error. found is false when no
+// asset has the name, or document has none, and a new asset is to be created.
+// Several assets with the name are an error rather than a guess; noun names the
+// asset kind and scope where it was looked up in messages.
+func adoptionTarget(ctx context.Context, noun, scope, document string, list func(ctx context.Context) ([]client.Asset, error), diags *diag.Diagnostics) (origin string, found bool) {
+	name := assetListName(document)
+	if name == "" {
+		return "", false
+	}
+
+	assets, err := list(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %ss to find an existing %s named %q to adopt, got error: %s", noun, noun, name, err))
+		return "", false
+	}
+
+	var candidates, managed []client.Asset
+	for _, asset := range assets {
+		switch {
+		case asset.Name != name:
+		case terraformManagedOrigin(asset.Origin):
+			managed = append(managed, asset)
+		default:
+			candidates = append(candidates, asset)
+		}
+	}
+	if len(candidates) == 0 && len(managed) > 0 {
+		diags.AddError(
+			"Asset to Adopt Is Managed by Terraform",
+			fmt.Sprintf("The %ss named %q %s were all created by Terraform and belong to other resources, possibly in another configuration, so adopt_existing does not take them over. Give this %s a different name, or remove the other resource first:%s", noun, name, scope, noun, describeAssets(managed)),
+		)
+		return "", false
+	}
+	switch len(candidates) {
+	case 0:
+		tflog.Debug(ctx, fmt.Sprintf("No existing %s named %q %s, creating a new one", noun, name, scope))
+		return "", false
+	case 1:
+		tflog.Info(ctx, fmt.Sprintf("Adopting the existing %s named %q %s with origin %s", noun, name, scope, candidates[0].Origin))
+		return candidates[0].Origin, true
+	}
+
+	diags.AddError(
+		"Ambiguous Asset to Adopt",
+		fmt.Sprintf("%d %ss named %q exist %s, so adopt_existing cannot tell which one to take over. Import one of them by origin or id instead:%s", len(candidates), noun, name, scope, describeAssets(candidates)),
+	)
+	return "", false
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+func TestAssetListName(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		expected string
+	}{
+		{
+			name:     "dashboard display name",
+			document: `{"kind":"Dashboard","metadata":{"name":"checkout"},"spec":{"display":{"name":"Checkout Overview"}}}`,
+			expected: "Checkout Overview",
+		},
+		{
+			name:     "synthetic check plugin display name",
+			document: "kind: Dash0SyntheticCheck\nmetadata:\n  name: examplecom\nspec:\n  plugin:\n    display:\n      name: example.com\n",
+			expected: "example.com",
+		},
+		{
+			name:     "check rule alert",
+			document: "kind: PrometheusRule\nmetadata:\n  name: adservice\nspec:\n  groups:\n    - name: Alerting\n      rules:\n        - alert: adservice errors\n",
+			expected: "adservice errors",
+		},
+		{
+			name:     "recording rule metadata name",
+			document: "kind: PrometheusRule\nmetadata:\n  name: http-request-rates\nspec:\n  groups:\n    - name: HttpRequestRates\n      rules:\n        - record: job:http_requests_total:rate5m\n",
+			expected: "http-request-rates",
+		},
+		{
+			name:     "no name",
+			document: "kind: Dash0View\nspec: {}\n",
+		},
+		{
+			name:     "invalid document",
+			document: "{",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, assetListName(tt.document))
+		})
+	}
+}
+
+func TestAdoptionTarget(t *testing.T) {
+	const document = `{"metadata":{"name":"checkout"},"spec":{"display":{"name":"Checkout Overview"}}}`
+	tests := []struct {
+		name          string
+		assets        []client.Asset
+		listErr       error
+		expectOrigin  string
+		expectFound   bool
+		expectSummary string
+	}{
+		{
+			name:   "no asset with the name",
+			assets: []client.Asset{{Origin: "tf_other", ID: "1", Name: "Other"}},
+		},
+		{
+			name: "one asset with the name",
+			assets: []client.Asset{
+				{Origin: "tf_other", ID: "1", Name: "Other"},
+				{Origin: "b9a1f3c2", ID: "b9a1f3c2", Name: "Checkout Overview"},
+			},
+			expectOrigin: "b9a1f3c2",
+			expectFound:  true,
+		},
+		{
+			name: "several assets with the name",
+			assets: []client.Asset{
+				{Origin: "1", ID: "1", Name: "Checkout Overview"},
+				{Origin: "2", ID: "2", Name: "Checkout Overview"},
+			},
+			expectSummary: "Ambiguous Asset to Adopt",
+		},
+		{
+			name: "asset created by Terraform is skipped",
+			assets: []client.Asset{
+				{Origin: "tf_one", ID: "1", Name: "Checkout Overview"},
+				{Origin: "2", ID: "2", Name: "Checkout Overview"},
+			},
+			expectOrigin: "2",
+			expectFound:  true,
+		},
+		{
+			name: "only assets created by Terraform have the name",
+			assets: []client.Asset{
+				{Origin: "tf_one", ID: "1", Name: "Checkout Overview"},
+			},
+			expectSummary: "Asset to Adopt Is Managed by Terraform",
+		},
+		{
+			name:          "list error",
+			listErr:       errors.New("dash0 api error: unavailable (status: 503)"),
+			expectSummary: "Client Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			origin, found := adoptionTarget(context.Background(), "dashboard", `in dataset "default"`, document, func(context.Context) ([]client.Asset, error) {
+				return tt.assets, tt.listErr
+			}, &diags)
+
+			assert.Equal(t, tt.expectOrigin, origin)
+			assert.Equal(t, tt.expectFound, found)
+			if tt.expectSummary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Equal(t, tt.expectSummary, diags.Errors()[0].Summary())
+		})
+	}
+}
+
+// resourcePlan builds a plan of the resource's schema in which every
+// attribute is null except those in values.
+func resourcePlan(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	raw := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		raw[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		raw[name] = value
+	}
+	return tfsdk.Plan{Raw: tftypes.NewValue(objectType, raw), Schema: schemaResp.Schema}
+}
+
+func TestDashboardResource_CreateAdoptsExisting(t *testing.T) {
+	mockClient := new(MockClient)
+	r := &DashboardResource{client: mockClient}
+	plan := resourcePlan(t, r, map[string]tftypes.Value{
+		"dataset":        tftypes.NewValue(tftypes.String, "default"),
+		"dashboard_yaml": tftypes.NewValue(tftypes.String, "kind: Dashboard\nmetadata:\n  name: checkout\nspec:\n  display:\n    name: Checkout Overview\n"),
+		"adopt_existing": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	mockClient.On("ListDashboards", mock.Anything, "default").Return([]client.Asset{
+		{Origin: "b9a1f3c2", ID: "b9a1f3c2", Name: "Checkout Overview"},
+	}, nil)
+	// The existing dashboard is updated in place; CreateDashboard is not called.
+	mockClient.On("UpdateDashboard", mock.Anything, "b9a1f3c2", mock.Anything, "default").Return(nil)
+	mockClient.On("ResolveDashboard", mock.Anything, "b9a1f3c2", "default").Return("b9a1f3c2", "", nil)
+	mockClient.On("GetDashboard", mock.Anything, "b9a1f3c2", "default").Return("", nil)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+	var state dashboardModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "b9a1f3c2", state.Origin.ValueString())
+}
+
+func TestViewResource_CreateAdoptionFindsNothing(t *testing.T) {
+	mockClient := new(MockClient)
+	// adopt_existing is omitted from the configuration; the provider-level
+	// default enables it.
+	r := &ViewResource{client: mockClient, adoptExisting: true}
+	plan := resourcePlan(t, r, map[string]tftypes.Value{
+		"dataset":   tftypes.NewValue(tftypes.String, "default"),
+		"view_yaml": tftypes.NewValue(tftypes.String, "kind: Dash0View\nmetadata:\n  name: sync-jobs\nspec:\n  display:\n    name: Sync Jobs\n"),
+	})
+
+	mockClient.On("ListViews", mock.Anything, "default").Return([]client.Asset{}, nil)
+	mockClient.On("CreateView", mock.Anything, mock.MatchedBy(func(origin string) bool { return len(origin) > 3 && origin[:3] == "tf_" }), mock.Anything, "default").Return(nil)
+	mockClient.On("ResolveView", mock.Anything, mock.Anything, "default").Return("", "", nil)
+	mockClient.On("GetView", mock.Anything, mock.Anything, "default").Return("", nil)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamResource_CreateAdoptionKeepsMembers(t *testing.T) {
+	mockClient := new(MockClient)
+	r := &TeamResource{client: mockClient, adoptExisting: true}
+	plan := resourcePlan(t, r, map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "platform"),
+		"display_name": tftypes.NewValue(tftypes.String, "Platform"),
+		"color": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"from": tftypes.String, "to": tftypes.String}}, map[string]tftypes.Value{
+			"from": tftypes.NewValue(tftypes.String, "#000000"),
+			"to":   tftypes.NewValue(tftypes.String, "#ffffff"),
+		}),
+	})
+
+	mockClient.On("ListTeams", mock.Anything).Return([]client.Asset{{Origin: "team-1", ID: "team-1", Name: "Platform"}}, nil)
+	mockClient.On("UpdateTeamKeepingMembers", mock.Anything, "team-1", mock.Anything).Return(nil)
+	mockClient.On("GetTeam", mock.Anything, "team-1").Return("", nil)
+	mockClient.On("ResolveTeam", mock.Anything, "team-1").Return("team-1", nil)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+}
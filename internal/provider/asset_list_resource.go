@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &assetListResource{}
+	_ list.ListResourceWithConfigure = &assetListResource{}
+)
+
+// NewDashboardListResource is a helper function to simplify the provider
+// implementation.
+func NewDashboardListResource() list.ListResource {
+	return &assetListResource{
+		typeName:      "dashboard",
+		plural:        "dashboards",
+		datasetScoped: true,
+		newResource:   NewDashboardResource,
+		list: func(ctx context.Context, c client.Client, dataset string) ([]client.Asset, error) {
+			return c.ListDashboards(ctx, dataset)
+		},
+	}
+}
+
+// NewSyntheticCheckListResource is a helper function to simplify the provider
+// implementation.
+func NewSyntheticCheckListResource() list.ListResource {
+	return &assetListResource{
+		typeName:      "synthetic_check",
+		plural:        "synthetic checks",
+		datasetScoped: true,
+		newResource:   NewSyntheticCheckResource,
+		list: func(ctx context.Context, c client.Client, dataset string) ([]client.Asset, error) {
+			return c.ListSyntheticChecks(ctx, dataset)
+		},
+	}
+}
+
+// NewViewListResource is a helper function to simplify the provider
+// implementation.
+func NewViewListResource() list.ListResource {
+	return &assetListResource{
+		typeName:      "view",
+		plural:        "views",
+		datasetScoped: true,
+		newResource:   NewViewResource,
+		list: func(ctx context.Context, c client.Client, dataset string) ([]client.Asset, error) {
+			return c.ListViews(ctx, dataset)
+		},
+	}
+}
+
+// NewCheckRuleListResource is a helper function to simplify the provider
+// implementation.
+func NewCheckRuleListResource() list.ListResource {
+	return &assetListResource{
+		typeName:      "check_rule",
+		plural:        "check rules",
+		datasetScoped: true,
+		newResource:   NewCheckRuleResource,
+		list: func(ctx context.Context, c client.Client, dataset string) ([]client.Asset, error) {
+			return c.ListCheckRules(ctx, dataset)
+		},
+	}
+}
+
+// NewRecordingRuleListResource is a helper function to simplify the provider
+// implementation.
+func NewRecordingRuleListResource() list.ListResource {
+	return &assetListResource{
+		typeName:      "recording_rule",
+		plural:        "recording rules",
+		datasetScoped: true,
+		newResource:   NewRecordingRuleResource,
+		list: func(ctx context.Context, c client.Client, dataset string) ([]client.Asset, error) {
+			return c.ListRecordingRules(ctx, dataset)
+		},
+	}
+}
+
+// NewSpamFilterListResource is a helper function to simplify the provider
+// implementation.
+func NewSpamFilterListResource() list.ListResource {
+	return &assetListResource{
+		typeName:      "spam_filter",
+		plural:        "spam filters",
+		datasetScoped: true,
+		newResource:   NewSpamFilterResource,
+		list: func(ctx context.Context, c client.Client, dataset string) ([]client.Asset, error) {
+			return c.ListSpamFilters(ctx, dataset)
+		},
+	}
+}
+
+// NewNotificationChannelListResource is a helper function to simplify the
+// provider implementation.
+func NewNotificationChannelListResource() list.ListResource {
+	return &assetListResource{
+		typeName:    "notification_channel",
+		plural:      "notification channels",
+		newResource: NewNotificationChannelResource,
+		list: func(ctx context.Context, c client.Client, _ string) ([]client.Asset, error) {
+			return c.ListNotificationChannels(ctx)
+		},
+	}
+}
+
+// NewTeamListResource is a helper function to simplify the provider
+// implementation.
+func NewTeamListResource() list.ListResource {
+	return &assetListResource{
+		typeName:    "team",
+		plural:      "teams",
+		newResource: NewTeamResource,
+		list: func(ctx context.Context, c client.Client, _ string) ([]client.Asset, error) {
+			return c.ListTeams(ctx)
+		},
+	}
+}
+
+// assetListResource lists the assets of one kind for `terraform query`. The
+// kinds differ only in the client list method and in whether they belong to
+// a dataset, so a single implementation serves all of them.
+//
+// Every result carries the asset's resource identity. When Terraform asks for
+// the resource as well (`terraform query -generate-config-out`), the result
+// is populated by the resource's own ImportState, so the generated
+// configuration matches what `terraform import` would produce, with the YAML
+// document stripped of the metadata the server manages.
+type assetListResource struct {
+	// typeName is the resource type name without the provider prefix; the
+	// resource's document attribute is named after it.
+	typeName string
+	// plural names the assets in descriptions and error messages.
+	plural string
+	// datasetScoped is set for the kinds that belong to a dataset, which the
+	// list resource then accepts a `dataset` argument for.
+	datasetScoped bool
+	newResource   func() resource.Resource
+	// list returns the assets of the kind; dataset is empty for kinds that
+	// belong to the organization.
+	list func(ctx context.Context, c client.Client, dataset string) ([]client.Asset, error)
+
+	providerData resourceProviderData
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *assetListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected provider.resourceProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = data
+}
+
+func (r *assetListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *assetListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := map[string]listschema.Attribute{
+		"name": listschema.StringAttribute{
+			Description: fmt.Sprintf("Only list the %s whose display name contains this value, ignoring case.", r.plural),
+			Optional:    true,
+		},
+	}
+	description := fmt.Sprintf("Lists the %s of the organization.", r.plural)
+	if r.datasetScoped {
+		attributes["dataset"] = listschema.StringAttribute{
+			Description: fmt.Sprintf("The identifier of the dataset to list the %s of. If omitted, the provider-level `dataset` default is used.", r.plural),
+			Optional:    true,
+		}
+		description = fmt.Sprintf("Lists the %s of a dataset.", r.plural)
+	}
+
+	resp.Schema = listschema.Schema{
+		Description: description + " Use it with `terraform query` to find assets created outside of Terraform, and with `-generate-config-out` to generate an `import` block plus a resource configuration carrying the current YAML for each of them.\n\n" +
+			"List resources require Terraform 1.14 or later.",
+		Attributes: attributes,
+	}
+}
+
+func (r *assetListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var name types.String
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	var dataset string
+	if r.datasetScoped {
+		var configured types.String
+		diags.Append(req.Config.GetAttribute(ctx, path.Root("dataset"), &configured)...)
+		dataset = r.providerData.defaultDataset
+		if !configured.IsNull() && !configured.IsUnknown() {
+			dataset = configured.ValueString()
+		}
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	assets, err := r.list(ctx, r.providerData.client, dataset)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %s, got error: %s", r.plural, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	nameFilter := strings.ToLower(name.ValueString())
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, asset := range assets {
+			if !strings.Contains(strings.ToLower(asset.Name), nameFilter) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+			if !push(r.listResult(ctx, req, asset, dataset)) {
+				return
+			}
+		}
+	}
+}
+
+// listResult builds the result for a single asset.
+func (r *assetListResource) listResult(ctx context.Context, req list.ListRequest, asset client.Asset, dataset string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = asset.Name
+	if result.DisplayName == "" {
+		result.DisplayName = asset.Origin
+	}
+
+	if r.datasetScoped {
+		setDatasetAssetIdentity(ctx, result.Identity, types.StringValue(dataset), types.StringValue(asset.Origin), &result.Diagnostics)
+	} else {
+		setOrganizationAssetIdentity(ctx, result.Identity, types.StringValue(asset.Origin), &result.Diagnostics)
+	}
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	state, document, diags := r.importAsset(ctx, r.importID(asset, dataset), tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw})
+	result.Diagnostics.Append(diags...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	// Import stores the document as the API returns it, JSON included, while
+	// a generated configuration should read like a hand-written one. Keep the
+	// document as it is when it cannot be converted.
+	if configYAML, err := converter.ConvertToConfigYAML(document); err == nil {
+		result.Diagnostics.Append(state.SetAttribute(ctx, r.yamlPath(), configYAML)...)
+	}
+
+	result.Resource.Raw = state.Raw
+	return result
+}
+
+// importAsset runs the resource's own ImportState for the asset importID
+// addresses, starting from state, and returns the imported state along with
+// the asset's YAML document as the API returned it.
+func (r *assetListResource) importAsset(ctx context.Context, importID string, state tfsdk.State) (tfsdk.State, string, diag.Diagnostics) {
+	res := r.newResource()
+	var configureResp resource.ConfigureResponse
+	res.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: r.providerData}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return state, "", configureResp.Diagnostics
+	}
+	importResp := resource.ImportStateResponse{State: state}
+	res.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: importID}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		return importResp.State, "", importResp.Diagnostics
+	}
+
+	var document types.String
+	importResp.Diagnostics.Append(importResp.State.GetAttribute(ctx, r.yamlPath(), &document)...)
+	return importResp.State, document.ValueString(), importResp.Diagnostics
+}
+
+// yamlPath returns the path of the resource's YAML document attribute.
+func (r *assetListResource) yamlPath() path.Path {
+	return path.Root(r.typeName + "_yaml")
+}
+
+// importID returns the import ID of asset, prefixed with dataset for the kinds
+// that belong to a dataset.
+func (r *assetListResource) importID(asset client.Asset, dataset string) string {
+	if r.datasetScoped {
+		return dataset + "," + asset.Origin
+	}
+	return asset.Origin
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// runAssetList runs the list resource with the given configuration and
+// collects its results.
+func runAssetList(t *testing.T, lr list.ListResource, mockClient *MockClient, config map[string]tftypes.Value, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	lr.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: resourceProviderData{client: mockClient, defaultDataset: "default"},
+	}, &resource.ConfigureResponse{})
+
+	var metadata resource.MetadataResponse
+	lr.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dash0"}, &metadata)
+	var res resource.Resource
+	for _, newResource := range (&dash0Provider{}).Resources(ctx) {
+		var resMetadata resource.MetadataResponse
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dash0"}, &resMetadata)
+		if resMetadata.TypeName == metadata.TypeName {
+			res = newResource()
+		}
+	}
+	require.NotNil(t, res, "no resource for %s", metadata.TypeName)
+	var resourceSchema resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	res.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	var configSchema list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	configValues := map[string]tftypes.Value{}
+	for name := range configSchema.Schema.Attributes {
+		configValues[name] = tftypes.NewValue(tftypes.String, nil)
+	}
+	for name, value := range config {
+		configValues[name] = value
+	}
+
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchema.Schema,
+			Raw:    tftypes.NewValue(configSchema.Schema.Type().TerraformType(ctx), configValues),
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, stream)
+	return slices.Collect(stream.Results)
+}
+
+func TestAssetListResource_Metadata(t *testing.T) {
+	resp := &resource.MetadataResponse{}
+	NewSpamFilterListResource().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, resp)
+	assert.Equal(t, "dash0_spam_filter", resp.TypeName)
+}
+
+func TestAssetListResource_List(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListDashboards", mock.Anything, "default").Return([]client.Asset{
+		{Origin: "tf_checkout", ID: "11111111-1111-1111-1111-111111111111", Name: "Checkout Service"},
+		{Origin: "22222222-2222-2222-2222-222222222222", ID: "22222222-2222-2222-2222-222222222222", Name: "Payments"},
+		{Origin: "tf_untitled", ID: "33333333-3333-3333-3333-333333333333"},
+	}, nil)
+
+	results := runAssetList(t, NewDashboardListResource(), mockClient, nil, false, 0)
+
+	require.Len(t, results, 3)
+	assert.Equal(t, "Checkout Service", results[0].DisplayName)
+	assert.Equal(t, "tf_untitled", results[2].DisplayName)
+
+	var identity datasetAssetIdentityModel
+	require.False(t, results[1].Diagnostics.HasError(), "diagnostics: %v", results[1].Diagnostics)
+	require.False(t, results[1].Identity.Get(context.Background(), &identity).HasError())
+	assert.Equal(t, "default", identity.Dataset.ValueString())
+	assert.Equal(t, "22222222-2222-2222-2222-222222222222", identity.Origin.ValueString())
+	// Without -generate-config-out the asset itself is not fetched.
+	assert.True(t, results[1].Resource.Raw.IsNull())
+	mockClient.AssertNotCalled(t, "GetDashboard", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAssetListResource_List_Filters(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListCheckRules", mock.Anything, "production").Return([]client.Asset{
+		{Origin: "tf_1", Name: "Checkout error rate"},
+		{Origin: "tf_2", Name: "Payments latency"},
+		{Origin: "tf_3", Name: "checkout latency"},
+		{Origin: "tf_4", Name: "Checkout saturation"},
+	}, nil)
+
+	results := runAssetList(t, NewCheckRuleListResource(), mockClient, map[string]tftypes.Value{
+		"dataset": tftypes.NewValue(tftypes.String, "production"),
+		"name":    tftypes.NewValue(tftypes.String, "CHECKOUT"),
+	}, false, 2)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "Checkout error rate", results[0].DisplayName)
+	assert.Equal(t, "checkout latency", results[1].DisplayName)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAssetListResource_List_IncludeResource(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListTeams", mock.Anything).Return([]client.Asset{
+		{Origin: "tf_backend", ID: "00000000-0000-0000-0000-000000000001", Name: "Backend Team"},
+	}, nil)
+	mockClient.On("GetTeam", mock.Anything, "tf_backend").Return(`{"kind":"Dash0Team","metadata":{"name":"backend-team","labels":{"dash0.com/origin":"tf_backend"}},"spec":{"display":{"name":"Backend Team"}}}`, nil)
+	mockClient.On("ResolveTeam", mock.Anything, "tf_backend").Return("00000000-0000-0000-0000-000000000001", nil)
+
+	results := runAssetList(t, NewTeamListResource(), mockClient, nil, true, 0)
+
+	require.Len(t, results, 1)
+	require.False(t, results[0].Diagnostics.HasError(), "diagnostics: %v", results[0].Diagnostics)
+
+	var identity organizationAssetIdentityModel
+	require.False(t, results[0].Identity.Get(context.Background(), &identity).HasError())
+	assert.Equal(t, "tf_backend", identity.Origin.ValueString())
+
+	var state teamModel
+	require.False(t, results[0].Resource.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "tf_backend", state.Origin.ValueString())
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", state.ID.ValueString())
+	assert.Equal(t, `kind: Dash0Team
+metadata:
+  name: backend-team
+spec:
+  display:
+    name: Backend Team
+`, state.TeamYaml.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestAssetListResource_List_IncludeResourceError(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListViews", mock.Anything, "default").Return([]client.Asset{{Origin: "tf_gone", Name: "Gone"}}, nil)
+	mockClient.On("GetView", mock.Anything, "tf_gone", "default").Return("", errors.New("dash0 api error: not found (status: 404)"))
+
+	results := runAssetList(t, NewViewListResource(), mockClient, nil, true, 0)
+
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Diagnostics.HasError())
+}
+
+func TestAssetListResource_List_ClientError(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListNotificationChannels", mock.Anything).Return(nil, errors.New("dash0 api error: forbidden (status: 403)"))
+
+	results := runAssetList(t, NewNotificationChannelListResource(), mockClient, nil, false, 0)
+
+	require.Len(t, results, 1)
+	require.True(t, results[0].Diagnostics.HasError())
+	assert.Contains(t, results[0].Diagnostics.Errors()[0].Detail(), "Unable to list notification channels")
+}
@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"maps"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,7 +11,6 @@ import (
 
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,9 +23,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &CheckRuleResource{}
-	_ resource.ResourceWithConfigure   = &CheckRuleResource{}
-	_ resource.ResourceWithImportState = &CheckRuleResource{}
+	_ resource.Resource                   = &CheckRuleResource{}
+	_ resource.ResourceWithConfigure      = &CheckRuleResource{}
+	_ resource.ResourceWithImportState    = &CheckRuleResource{}
+	_ resource.ResourceWithIdentity       = &CheckRuleResource{}
+	_ resource.ResourceWithValidateConfig = &CheckRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &CheckRuleResource{}
 )
 
 // NewCheckRuleResource is a helper function to simplify the provider implementation.
@@ -40,15 +42,40 @@ type CheckRuleResource struct {
 	// defaultDataset is the provider-level default dataset, inherited by this
 	// resource's `dataset` attribute when it is omitted from configuration.
 	defaultDataset string
+	// policy is the provider-level policy, enforced in ModifyPlan.
+	policy policy
+	// planValidation is the provider's `plan_validation` setting, applied in
+	// ModifyPlan.
+	planValidation planValidation
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
 }
 
 // checkRuleModel is the Terraform state model for a check rule resource.
 type checkRuleModel struct {
-	Origin        types.String `tfsdk:"origin"`
-	ID            types.String `tfsdk:"id"`
-	Dataset       types.String `tfsdk:"dataset"`
-	CheckRuleYaml types.String `tfsdk:"check_rule_yaml"`
-	URL           types.String `tfsdk:"url"`
+	Origin             types.String `tfsdk:"origin"`
+	ID                 types.String `tfsdk:"id"`
+	Dataset            types.String `tfsdk:"dataset"`
+	CheckRuleYaml      types.String `tfsdk:"check_rule_yaml"`
+	URL                types.String `tfsdk:"url"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	AllowDatasetMove   types.Bool   `tfsdk:"allow_dataset_move"`
+	Datasets           types.Set    `tfsdk:"datasets"`
+	DatasetIDs         types.Map    `tfsdk:"dataset_ids"`
+	DatasetURLs        types.Map    `tfsdk:"dataset_urls"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -68,10 +95,43 @@ func (r *CheckRuleResource) Configure(_ context.Context, req resource.ConfigureR
 
 	r.client = data.client
 	r.defaultDataset = data.defaultDataset
+	r.policy = data.policy
+	r.planValidation = data.planValidation
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
 }
 
 func (r *CheckRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_check_rule"
+	// Moving the resource to another dataset (allow_dataset_move) changes the
+	// dataset in its identity.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the check rule by.
+func (r *CheckRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = datasetAssetIdentitySchema("check rule")
+}
+
+// ValidateConfig checks check_rule_yaml offline, so `terraform validate` reports an
+// invalid PromQL expression, duration, or label or annotation key without
+// credentials and before any rule is written (see validatePrometheusRuleYAML).
+// It also checks that at most one of dataset and datasets is set.
+func (r *CheckRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateDatasetReplicas(ctx, req.Config, &resp.Diagnostics)
+
+	var model checkRuleModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.CheckRuleYaml.IsNull() || model.CheckRuleYaml.IsUnknown() {
+		return
+	}
+	validatePrometheusRuleYAML(model.CheckRuleYaml.ValueString(), path.Root("check_rule_yaml"), &resp.Diagnostics)
 }
 
 func (r *CheckRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -96,19 +156,19 @@ More information on how Prometheus rules are mapped to Dash0 check rules can be
 				},
 			},
 			"dataset": schema.StringAttribute{
-				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the check rule belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated.",
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the check rule belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated, unless `allow_dataset_move` is set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					datasetRequiresReplace(),
 				},
 			},
 			"check_rule_yaml": schema.StringAttribute{
-				Description: "The check rule definition in YAML format, following the [Prometheus alerting rule specification](https://prometheus.io/docs/prometheus/latest/configuration/alerting_rules/). Must contain exactly one group with exactly one rule. The document's top-level `metadata.annotations` are merged into the rule's own annotations, and the rule's own annotations take precedence when the same key is set in both places, so a document written for the Dash0 Kubernetes operator can be used here verbatim. Setting `dash0.com/sharing` controls sharing, and changes to it trigger a resource update.",
+				Description: "The check rule definition in YAML format, following the [Prometheus alerting rule specification](https://prometheus.io/docs/prometheus/latest/configuration/alerting_rules/). Must contain exactly one group with exactly one rule; use `dash0_prometheus_rule` to manage a document with several groups or rules. The document's top-level `metadata.annotations` are merged into the rule's own annotations, and the rule's own annotations take precedence when the same key is set in both places, so a document written for the Dash0 Kubernetes operator can be used here verbatim. Setting `dash0.com/sharing` controls sharing, and changes to it trigger a resource update. Each rule's `expr` is parsed as PromQL, and its `for` and `keep_firing_for` durations and label and annotation keys are checked, at plan time.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqualNormalizing(converter.MoveTopLevelAnnotationsIntoRules, converter.AnnotationSharing),
+					yamlKinds["check_rule"].planModifier(),
 				},
 			},
 			"url": schema.StringAttribute{
@@ -120,6 +180,24 @@ More information on how Prometheus rules are mapped to Dash0 check rules can be
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("check rule"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("check rule")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("check rule", "name (the rule's `alert`)", "in its dataset")
+	resp.Schema.Attributes["allow_dataset_move"] = allowDatasetMoveAttribute("check rule")
+	resp.Schema.Attributes["datasets"] = datasetsAttribute("check rule")
+	resp.Schema.Attributes["dataset_ids"] = datasetIDsAttribute("check rule")
+	resp.Schema.Attributes["dataset_urls"] = datasetURLsAttribute("check rule")
+}
+
+// ModifyPlan plans the provider-level deletion protection default, dataset
+// moves and replicas, and enforces the provider-level policy and plan
+// validation on the planned check rule.
+func (r *CheckRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	planDatasetMove(ctx, req, resp, "id", "url")
+	planDatasetReplicas(ctx, req, resp, "id", "url")
+	r.policy.enforcePlan(ctx, req.Plan, "dash0_check_rule", path.Root("check_rule_yaml"), &resp.Diagnostics)
+	r.planValidation.validatePlan(ctx, r.client, req.Plan, "dash0_check_rule", path.Root("check_rule_yaml"), &resp.Diagnostics)
 }
 
 // resolveCheckRule populates the check rule's server-assigned id and web app
@@ -141,6 +219,28 @@ func (r *CheckRuleResource) resolveCheckRule(ctx context.Context, model *checkRu
 	model.URL = stringOrNull(checkRuleURL)
 }
 
+// replicas returns the replicas of a check rule with `datasets`.
+func (r *CheckRuleResource) replicas() datasetReplicas {
+	return datasetReplicas{
+		noun:    "check rule",
+		create:  r.client.CreateCheckRule,
+		update:  r.client.UpdateCheckRule,
+		remove:  r.client.DeleteCheckRule,
+		get:     r.client.GetCheckRule,
+		resolve: r.client.ResolveCheckRule,
+	}
+}
+
+// resolveLastModified populates the check rule's last-modified attributes after
+// a create or update (best-effort), and records the revision the write produced
+// for conflict detection.
+func (r *CheckRuleResource) resolveLastModified(ctx context.Context, model *checkRuleModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "check rule", func(ctx context.Context) (string, error) {
+		return r.client.GetCheckRule(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *CheckRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model checkRuleModel
 	diags := req.Plan.Get(ctx, &model)
@@ -150,7 +250,7 @@ func (r *CheckRuleResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	model.Origin = types.StringValue("tf_" + uuid.New().String())
-	if model.Dataset.IsNull() || model.Dataset.IsUnknown() {
+	if model.Datasets.IsNull() && (model.Dataset.IsNull() || model.Dataset.IsUnknown()) {
 		model.Dataset = types.StringValue(r.defaultDataset)
 	}
 
@@ -166,7 +266,33 @@ func (r *CheckRuleResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Pass YAML directly to client (the client handles Prometheus->Dash0 conversion)
-	err = r.client.CreateCheckRule(ctx, model.Origin.ValueString(), model.CheckRuleYaml.ValueString(), model.Dataset.ValueString())
+	// Write a replica of the check rule to each of its datasets instead, if
+	// datasets is set.
+	if !model.Datasets.IsNull() {
+		model.Datasets, model.DatasetIDs, model.DatasetURLs = r.replicas().apply(ctx, model.Origin.ValueString(), model.CheckRuleYaml.ValueString(), types.SetNull(types.StringType), model.Datasets, model.DatasetIDs, model.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "created a replicated check rule resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Take over an existing check rule with the same name instead of creating a
+	// second one, if adopt_existing is set.
+	createCheckRule := r.client.CreateCheckRule
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "check rule", fmt.Sprintf("in dataset %q", model.Dataset.ValueString()), model.CheckRuleYaml.ValueString(), func(ctx context.Context) ([]client.Asset, error) {
+			return r.client.ListCheckRules(ctx, model.Dataset.ValueString())
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createCheckRule = r.client.UpdateCheckRule
+		}
+	}
+
+	err = createCheckRule(ctx, model.Origin.ValueString(), model.CheckRuleYaml.ValueString(), model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create check rule, got error: %s", err))
 		return
@@ -174,12 +300,14 @@ func (r *CheckRuleResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Resolve the id and web app URL for the newly created check rule (best-effort).
 	r.resolveCheckRule(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a check rule resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
 }
 
 func (r *CheckRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,6 +319,14 @@ func (r *CheckRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Read each replica of a check rule with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.readReplicas(ctx, &state, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+		return
+	}
+
 	// The client returns a Prometheus YAML string (Dash0->Prometheus conversion is done internally)
 	apiResponseYAML, err := r.client.GetCheckRule(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
@@ -199,13 +335,28 @@ func (r *CheckRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	tflog.Trace(ctx, "read a check rule resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseYAML, &resp.Diagnostics)
+
+	r.refreshCheckRule(ctx, &state, apiResponseYAML, &resp.Diagnostics)
+
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseYAML)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+}
 
+// refreshCheckRule compares the check rule in state with document, the
+// check rule as returned by the API, and replaces check_rule_yaml with it only
+// if they differ beyond metadata the server manages.
+func (r *CheckRuleResource) refreshCheckRule(ctx context.Context, state *checkRuleModel, document string, diags *diag.Diagnostics) {
 	// TODO Clean this up when we switch to the CRD-native API for check rules
 	//
 	// The Dash0 API does not preserve metadata.name for check rules (the
 	// PrometheusAlertRule format lacks that field). Inject the name from
 	// state into the API response so drift detection can compare properly.
-	apiResponseYAML = injectMetadataName(state.CheckRuleYaml.ValueString(), apiResponseYAML)
+	document = injectMetadataName(state.CheckRuleYaml.ValueString(), document)
 
 	// Compare the current state with the retrieved check rule
 	if state.CheckRuleYaml.ValueString() != "" {
@@ -215,29 +366,42 @@ func (r *CheckRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		// diffing, or a config that legitimately uses metadata.annotations would
 		// never compare equal to the API response and would drift on every plan.
 		// This must not affect what gets written back to state below; only the
-		// value compared against apiResponseYAML.
+		// value compared against document.
 		stateYAML := converter.MoveTopLevelAnnotationsIntoRules(state.CheckRuleYaml.ValueString())
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseYAML, additionalIgnored, []string{converter.AnnotationSharing})
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, document, additionalIgnored, []string{converter.AnnotationSharing})
 		if err != nil {
-			resp.Diagnostics.AddWarning(
+			diags.AddWarning(
 				"Check Rule Comparison Error",
 				fmt.Sprintf("Error comparing check rules: %s. Using API response as source of truth.", err),
 			)
-			state.CheckRuleYaml = types.StringValue(apiResponseYAML)
+			state.CheckRuleYaml = types.StringValue(document)
 		} else if !equivalent {
 			tflog.Debug(ctx, "Check rule has changed, updating state")
-			state.CheckRuleYaml = types.StringValue(apiResponseYAML)
+			warnChangedOutsideTerraform("check rule", document, diags)
+			state.CheckRuleYaml = types.StringValue(document)
 		} else {
 			tflog.Debug(ctx, "Check rule is equivalent, ignoring changes in metadata fields")
 		}
 	} else {
-		state.CheckRuleYaml = types.StringValue(apiResponseYAML)
+		state.CheckRuleYaml = types.StringValue(document)
 	}
+}
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+// readReplicas refreshes a check rule with datasets from its replicas. The
+// first replica that differs from the configured check rule replaces
+// check_rule_yaml, so that the next apply rewrites all of them.
+func (r *CheckRuleResource) readReplicas(ctx context.Context, state *checkRuleModel, diags *diag.Diagnostics) {
+	configured := *state
+	drifted := false
+	state.Datasets, state.DatasetIDs, state.DatasetURLs = r.replicas().read(ctx, state.Origin.ValueString(), state.Datasets, state.DatasetIDs, state.DatasetURLs, func(document string, diags *diag.Diagnostics) {
+		replica := configured
+		r.refreshCheckRule(ctx, &replica, document, diags)
+		if !drifted && !replica.CheckRuleYaml.Equal(configured.CheckRuleYaml) {
+			state.CheckRuleYaml, drifted = replica.CheckRuleYaml, true
+		}
+	}, diags)
+	tflog.Trace(ctx, "read a replicated check rule resource")
 }
 
 func (r *CheckRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -268,7 +432,19 @@ func (r *CheckRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// Update the existing check rule (dataset changes force recreation via RequiresReplace)
+	// Update the replicas of a check rule with datasets instead, creating and
+	// deleting those of added and removed datasets.
+	if !plan.Datasets.IsNull() {
+		plan.Origin = state.Origin
+		plan.Datasets, plan.DatasetIDs, plan.DatasetURLs = r.replicas().apply(ctx, plan.Origin.ValueString(), plan.CheckRuleYaml.ValueString(), state.Datasets, plan.Datasets, state.DatasetIDs, state.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "updated a replicated check rule resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Update the existing check rule. A dataset change forces recreation via
+	// RequiresReplace, unless allow_dataset_move is set; then it is moved below.
 	// Pass YAML directly to client (the client handles Prometheus->Dash0 conversion)
 	plan.Origin = state.Origin
 	// The check rule's identifier is immutable, so neither the id nor the URL
@@ -276,17 +452,38 @@ func (r *CheckRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	// the API.
 	plan.ID = state.ID
 	plan.URL = state.URL
-	err = r.client.UpdateCheckRule(ctx, plan.Origin.ValueString(), plan.CheckRuleYaml.ValueString(), plan.Dataset.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update check rule, got error: %s", err))
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "check rule", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetCheckRule(ctx, plan.Origin.ValueString(), state.Dataset.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.Dataset.Equal(state.Dataset) {
+		err = r.client.UpdateCheckRule(ctx, plan.Origin.ValueString(), plan.CheckRuleYaml.ValueString(), plan.Dataset.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update check rule, got error: %s", err))
+			return
+		}
+	} else {
+		if !moveToDataset(ctx, "check rule", plan.Origin.ValueString(), plan.CheckRuleYaml.ValueString(), state.Dataset.ValueString(), plan.Dataset.ValueString(), r.client.CreateCheckRule, r.client.DeleteCheckRule, &resp.Diagnostics) {
+			return
+		}
+
+		// The moved check rule has a new id and URL.
+		r.resolveCheckRule(ctx, &plan, &resp.Diagnostics)
+	}
+
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a check rule resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *CheckRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -296,6 +493,15 @@ func (r *CheckRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("check rule", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete every replica of a check rule with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.replicas().deleteAll(ctx, state.Origin.ValueString(), state.Datasets, &resp.Diagnostics)
+		return
+	}
 
 	err := r.client.DeleteCheckRule(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
@@ -308,18 +514,11 @@ func (r *CheckRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState function is required for resources that support import
 func (r *CheckRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
-		)
+	dataset, origin, ok := datasetImportTarget(ctx, req, "check rule", r.client.ListCheckRules, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	dataset := idParts[0]
-	origin := idParts[1]
-
 	apiResponseYAML, err := r.client.GetCheckRule(ctx, origin, dataset)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -338,6 +537,7 @@ func (r *CheckRuleResource) ImportState(ctx context.Context, req resource.Import
 	r.resolveCheckRule(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), model.URL)...)
+	setDatasetAssetIdentity(ctx, resp.Identity, types.StringValue(dataset), types.StringValue(origin), &resp.Diagnostics)
 }
 
 // injectMetadataName copies metadata.name from sourceYAML into targetYAML when
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -198,8 +199,9 @@ spec:
           labels:
             severity: warning
 `,
+			// Drift is reported as a warning naming who changed the rule.
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			name:              "invalid YAML response - should update and warn",
@@ -213,6 +215,15 @@ spec:
 		t.Run(tc.name, func(t *testing.T) {
 			testSchema := schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -247,19 +258,37 @@ spec:
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":          tftypes.String,
-						"id":              tftypes.String,
-						"dataset":         tftypes.String,
-						"check_rule_yaml": tftypes.String,
-						"url":             tftypes.String,
+						"last_modified_at":    tftypes.String,
+						"last_modified_by":    tftypes.String,
+						"server_version":      tftypes.String,
+						"deletion_protection": tftypes.Bool,
+						"adopt_existing":      tftypes.Bool,
+						"allow_dataset_move":  tftypes.Bool,
+						"datasets":            tftypes.Set{ElementType: tftypes.String},
+						"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+						"dataset_urls":        tftypes.Map{ElementType: tftypes.String},
+						"origin":              tftypes.String,
+						"id":                  tftypes.String,
+						"dataset":             tftypes.String,
+						"check_rule_yaml":     tftypes.String,
+						"url":                 tftypes.String,
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":          tftypes.NewValue(tftypes.String, testOrigin),
-					"id":              tftypes.NewValue(tftypes.String, nil),
-					"dataset":         tftypes.NewValue(tftypes.String, testDataset),
-					"check_rule_yaml": tftypes.NewValue(tftypes.String, stateYaml),
-					"url":             tftypes.NewValue(tftypes.String, testURL),
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+					"id":                  tftypes.NewValue(tftypes.String, nil),
+					"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+					"check_rule_yaml":     tftypes.NewValue(tftypes.String, stateYaml),
+					"url":                 tftypes.NewValue(tftypes.String, testURL),
 				},
 			)
 
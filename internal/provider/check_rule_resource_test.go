@@ -165,23 +165,50 @@ func TestCheckRuleResource_Create_InvalidYAML(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":          tftypes.String,
-					"id":              tftypes.String,
-					"dataset":         tftypes.String,
-					"check_rule_yaml": tftypes.String,
-					"url":             tftypes.String,
+					"last_modified_at":    tftypes.String,
+					"last_modified_by":    tftypes.String,
+					"server_version":      tftypes.String,
+					"deletion_protection": tftypes.Bool,
+					"adopt_existing":      tftypes.Bool,
+					"allow_dataset_move":  tftypes.Bool,
+					"datasets":            tftypes.Set{ElementType: tftypes.String},
+					"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+					"dataset_urls":        tftypes.Map{ElementType: tftypes.String},
+					"origin":              tftypes.String,
+					"id":                  tftypes.String,
+					"dataset":             tftypes.String,
+					"check_rule_yaml":     tftypes.String,
+					"url":                 tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
-				"origin":          tftypes.NewValue(tftypes.String, "test-origin"),
-				"id":              tftypes.NewValue(tftypes.String, nil),
-				"dataset":         tftypes.NewValue(tftypes.String, "test-dataset"),
-				"check_rule_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
-				"url":             tftypes.NewValue(tftypes.String, nil),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, "test-origin"),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
+				"check_rule_yaml":     tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+				"url":                 tftypes.NewValue(tftypes.String, nil),
 			},
 		),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+				"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
@@ -213,6 +240,15 @@ func TestCheckRuleResource_Create_InvalidYAML(t *testing.T) {
 func testCheckRuleSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"last_modified_at":    schema.StringAttribute{Computed: true},
+			"last_modified_by":    schema.StringAttribute{Computed: true},
+			"server_version":      schema.StringAttribute{Computed: true},
+			"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+			"adopt_existing":      schema.BoolAttribute{Optional: true},
+			"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+			"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+			"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 			"origin": schema.StringAttribute{
 				Computed: true,
 			},
@@ -232,6 +268,94 @@ func testCheckRuleSchema() schema.Schema {
 	}
 }
 
+// TestCheckRuleResource_ValidateConfig asserts that an invalid PromQL
+// expression fails at plan time, attributed to check_rule_yaml, while the
+// Dash0 $__threshold placeholder is accepted.
+func TestCheckRuleResource_ValidateConfig(t *testing.T) {
+	cases := []struct {
+		name      string
+		expr      string
+		expectErr bool
+	}{
+		{name: "threshold placeholder", expr: "up > $__threshold"},
+		{name: "invalid expression", expr: "sum(up", expectErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &CheckRuleResource{}
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
+						"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+						"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+						"server_version":      tftypes.NewValue(tftypes.String, nil),
+						"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+						"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+						"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+						"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+						"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"origin":              tftypes.NewValue(tftypes.String, nil),
+						"id":                  tftypes.NewValue(tftypes.String, nil),
+						"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
+						"check_rule_yaml": tftypes.NewValue(tftypes.String, `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test-rule
+spec:
+  groups:
+    - name: TestGroup
+      rules:
+        - alert: TestAlert
+          expr: `+tc.expr),
+						"url": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testCheckRuleSchema(),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), req, resp)
+
+			assert.Equal(t, tc.expectErr, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tc.expectErr {
+				assert.Equal(t, "Invalid PromQL expression", resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+// TestCheckRuleResource_ValidateConfig_UnknownYAML asserts that validation is
+// skipped while check_rule_yaml is unknown, e.g. when it interpolates a value
+// only known after apply.
+func TestCheckRuleResource_ValidateConfig_UnknownYAML(t *testing.T) {
+	r := &CheckRuleResource{}
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, nil),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
+				"check_rule_yaml":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"url":                 tftypes.NewValue(tftypes.String, nil),
+			}),
+			Schema: testCheckRuleSchema(),
+		},
+	}
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
 func TestCheckRuleResource_Create(t *testing.T) {
 	mockClient := new(MockClient)
 	r := &CheckRuleResource{client: mockClient}
@@ -251,11 +375,20 @@ spec:
 
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":          tftypes.NewValue(tftypes.String, ""),
-			"id":              tftypes.NewValue(tftypes.String, nil),
-			"dataset":         tftypes.NewValue(tftypes.String, testDataset),
-			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":             tftypes.NewValue(tftypes.String, nil),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, ""),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"check_rule_yaml":     tftypes.NewValue(tftypes.String, testYaml),
+			"url":                 tftypes.NewValue(tftypes.String, nil),
 		}),
 		Schema: testCheckRuleSchema(),
 	}
@@ -265,6 +398,7 @@ spec:
 	req := resource.CreateRequest{Plan: plan}
 
 	mockClient.On("CreateCheckRule", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetCheckRule", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 	// After create, the URL is resolved by origin (generated tf_-prefixed value).
 	mockClient.On("ResolveCheckRule", mock.Anything, mock.Anything, testDataset).Return("test-id", testURL, nil)
 
@@ -299,21 +433,39 @@ spec:
 
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":          tftypes.NewValue(tftypes.String, testOrigin),
-			"id":              tftypes.NewValue(tftypes.String, nil),
-			"dataset":         tftypes.NewValue(tftypes.String, testDataset),
-			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":             tftypes.NewValue(tftypes.String, testURL),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"check_rule_yaml":     tftypes.NewValue(tftypes.String, testYaml),
+			"url":                 tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: testCheckRuleSchema(),
 	}
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":          tftypes.NewValue(tftypes.String, testOrigin),
-			"id":              tftypes.NewValue(tftypes.String, nil),
-			"dataset":         tftypes.NewValue(tftypes.String, testDataset),
-			"check_rule_yaml": tftypes.NewValue(tftypes.String, testYaml+"\n          for: 5m"),
-			"url":             tftypes.NewValue(tftypes.String, testURL),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"check_rule_yaml":     tftypes.NewValue(tftypes.String, testYaml+"\n          for: 5m"),
+			"url":                 tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: state.Schema,
 	}
@@ -321,6 +473,7 @@ spec:
 	resp := resource.UpdateResponse{State: state}
 
 	mockClient.On("UpdateCheckRule", mock.Anything, testOrigin, mock.Anything, testDataset).Return(nil)
+	mockClient.On("GetCheckRule", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 
 	r.Update(context.Background(), req, &resp)
 
@@ -461,23 +614,50 @@ func TestCheckRuleResource_ReadError(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":          tftypes.String,
-					"id":              tftypes.String,
-					"dataset":         tftypes.String,
-					"check_rule_yaml": tftypes.String,
-					"url":             tftypes.String,
+					"last_modified_at":    tftypes.String,
+					"last_modified_by":    tftypes.String,
+					"server_version":      tftypes.String,
+					"deletion_protection": tftypes.Bool,
+					"adopt_existing":      tftypes.Bool,
+					"allow_dataset_move":  tftypes.Bool,
+					"datasets":            tftypes.Set{ElementType: tftypes.String},
+					"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+					"dataset_urls":        tftypes.Map{ElementType: tftypes.String},
+					"origin":              tftypes.String,
+					"id":                  tftypes.String,
+					"dataset":             tftypes.String,
+					"check_rule_yaml":     tftypes.String,
+					"url":                 tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
-				"origin":          tftypes.NewValue(tftypes.String, "test-origin"),
-				"id":              tftypes.NewValue(tftypes.String, nil),
-				"dataset":         tftypes.NewValue(tftypes.String, "test-dataset"),
-				"check_rule_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
-				"url":             tftypes.NewValue(tftypes.String, nil),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, "test-origin"),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
+				"check_rule_yaml":     tftypes.NewValue(tftypes.String, "test-yaml"),
+				"url":                 tftypes.NewValue(tftypes.String, nil),
 			},
 		),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+				"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
@@ -97,3 +97,22 @@ func (c *dash0Client) ResolveCheckRule(ctx context.Context, origin string, datas
 	logResolvedURL(ctx, "check rule", origin, checkRuleURL)
 	return id, checkRuleURL, nil
 }
+
+// ListCheckRules returns the check rules of the dataset.
+func (c *dash0Client) ListCheckRules(ctx context.Context, dataset string) ([]Asset, error) {
+	items, err := c.inner.ListCheckRules(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		assets = append(assets, newAsset(item.Id, item.Origin, item.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d check rules in dataset %s", len(assets), dataset))
+	return assets, nil
+}
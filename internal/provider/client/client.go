@@ -18,30 +18,38 @@ import (
 // the Dash0 web app). All are best-effort: when the asset cannot be located,
 // they return empty strings and no error so callers can surface the result as
 // optional metadata rather than failing the operation.
+//
+// The ListX methods return a summary of every asset of a kind, in a dataset
+// where the kind is dataset-scoped. They back the list resources that
+// `terraform query` runs.
 type Client interface {
 	CreateDashboard(ctx context.Context, origin string, dashboardJSON string, dataset string) error
 	GetDashboard(ctx context.Context, origin string, dataset string) (string, error)
 	UpdateDashboard(ctx context.Context, origin string, dashboardJSON string, dataset string) error
 	DeleteDashboard(ctx context.Context, origin string, dataset string) error
 	ResolveDashboard(ctx context.Context, origin string, dataset string) (string, string, error)
+	ListDashboards(ctx context.Context, dataset string) ([]Asset, error)
 
 	CreateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error
 	GetSyntheticCheck(ctx context.Context, origin string, dataset string) (string, error)
 	UpdateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error
 	DeleteSyntheticCheck(ctx context.Context, origin string, dataset string) error
 	ResolveSyntheticCheck(ctx context.Context, origin string, dataset string) (string, string, error)
+	ListSyntheticChecks(ctx context.Context, dataset string) ([]Asset, error)
 
 	CreateView(ctx context.Context, origin string, viewJSON string, dataset string) error
 	GetView(ctx context.Context, origin string, dataset string) (string, error)
 	UpdateView(ctx context.Context, origin string, viewJSON string, dataset string) error
 	DeleteView(ctx context.Context, origin string, dataset string) error
 	ResolveView(ctx context.Context, origin string, dataset string) (string, string, error)
+	ListViews(ctx context.Context, dataset string) ([]Asset, error)
 
 	CreateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error
 	GetCheckRule(ctx context.Context, origin string, dataset string) (string, error)
 	UpdateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error
 	DeleteCheckRule(ctx context.Context, origin string, dataset string) error
 	ResolveCheckRule(ctx context.Context, origin string, dataset string) (string, string, error)
+	ListCheckRules(ctx context.Context, dataset string) ([]Asset, error)
 
 	CreateRecordingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error
 	GetRecordingRule(ctx context.Context, origin string, dataset string) (string, error)
@@ -51,21 +59,38 @@ type Client interface {
 	// with the given origin (no deep-link URL — the Dash0 web app does not
 	// expose a per-recording-rule page).
 	ResolveRecordingRule(ctx context.Context, origin string, dataset string) (string, error)
+	ListRecordingRules(ctx context.Context, dataset string) ([]Asset, error)
 
 	CreateNotificationChannel(ctx context.Context, origin string, channelJSON string) error
 	GetNotificationChannel(ctx context.Context, origin string) (string, error)
 	UpdateNotificationChannel(ctx context.Context, origin string, channelJSON string) error
 	DeleteNotificationChannel(ctx context.Context, origin string) error
 	ResolveNotificationChannel(ctx context.Context, origin string) (string, string, error)
+	ListNotificationChannels(ctx context.Context) ([]Asset, error)
 
 	CreateTeam(ctx context.Context, origin string, teamJSON string) error
 	GetTeam(ctx context.Context, origin string) (string, error)
 	UpdateTeam(ctx context.Context, origin string, teamJSON string) error
+	// UpdateTeamKeepingMembers updates the team like UpdateTeam but keeps its
+	// current members, read under the same lock as AddTeamMember and
+	// RemoveTeamMember.
+	UpdateTeamKeepingMembers(ctx context.Context, origin string, teamJSON string) error
 	DeleteTeam(ctx context.Context, origin string) error
 	// ResolveTeam returns the server-assigned id of the team with the given
 	// origin (no deep-link URL — the Dash0 web app does not currently expose
 	// a per-team page distinct from the settings screen).
 	ResolveTeam(ctx context.Context, origin string) (string, error)
+	ListTeams(ctx context.Context) ([]Asset, error)
+	// ListMembers returns the members of the organization, which team
+	// membership may reference by email address or id.
+	ListMembers(ctx context.Context) ([]Member, error)
+	// AddTeamMember and RemoveTeamMember add or remove a single member,
+	// referenced by email address or id, on the team with the given origin or
+	// id, leaving all other members untouched. HasTeamMember reports whether
+	// the member belongs to the team.
+	AddTeamMember(ctx context.Context, team string, member string) error
+	RemoveTeamMember(ctx context.Context, team string, member string) error
+	HasTeamMember(ctx context.Context, team string, member string) (bool, error)
 
 	CreateSpamFilter(ctx context.Context, origin string, filterJSON string, dataset string) error
 	GetSpamFilter(ctx context.Context, origin string, dataset string) (string, error)
@@ -75,6 +100,7 @@ type Client interface {
 	// the given origin (no deep-link URL — the Dash0 web app does not expose
 	// a per-spam-filter page).
 	ResolveSpamFilter(ctx context.Context, origin string, dataset string) (string, error)
+	ListSpamFilters(ctx context.Context, dataset string) ([]Asset, error)
 
 	// SendLogEvent emits a single log record to the Dash0 OTLP/HTTP ingress
 	// endpoint. Unlike every other method on this interface it does not manage
@@ -84,6 +110,50 @@ type Client interface {
 	SendLogEvent(ctx context.Context, event LogEvent, dataset string) error
 }
 
+// Asset summarizes an asset as returned by a list endpoint.
+type Asset struct {
+	// Origin is the identifier the single-asset endpoints address the asset
+	// by: its origin, or its id when it was created in the Dash0 UI and
+	// carries no origin (see matchOriginID).
+	Origin string
+	// ID is the asset's server-assigned id.
+	ID string
+	// Name is the asset's display name.
+	Name string
+}
+
+// newAsset builds the summary of a list item from its id, origin and name,
+// either of which may be absent.
+func newAsset(id string, origin *string, name *string) Asset {
+	asset := Asset{Origin: id, ID: id}
+	if origin != nil && *origin != "" {
+		asset.Origin = *origin
+	}
+	if name != nil {
+		asset.Name = *name
+	}
+	return asset
+}
+
+// AssetByID returns the asset among assets whose origin, or as a fallback
+// whose id, is identifier, matching assets the way the ResolveX methods do
+// (see matchOriginID).
+func AssetByID(assets []Asset, identifier string) (Asset, bool) {
+	items := make([]*Asset, len(assets))
+	for i := range assets {
+		items[i] = &assets[i]
+	}
+	id := matchOriginID(items, identifier, func(asset *Asset) (string, *string) {
+		return asset.ID, &asset.Origin
+	})
+	for _, asset := range assets {
+		if id != "" && asset.ID == id {
+			return asset, true
+		}
+	}
+	return Asset{}, false
+}
+
 // Ensure dash0Client implements Client
 var _ Client = &dash0Client{}
 
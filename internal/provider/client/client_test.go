@@ -28,3 +28,21 @@ func TestNewDash0Client_InvalidToken(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, c)
 }
+
+func TestAssetByID(t *testing.T) {
+	assets := []Asset{
+		{Origin: "tf_checkout", ID: "11111111-1111-1111-1111-111111111111", Name: "Checkout"},
+		{Origin: "22222222-2222-2222-2222-222222222222", ID: "22222222-2222-2222-2222-222222222222", Name: "Created in the UI"},
+	}
+
+	asset, ok := AssetByID(assets, "11111111-1111-1111-1111-111111111111")
+	assert.True(t, ok)
+	assert.Equal(t, "tf_checkout", asset.Origin)
+
+	asset, ok = AssetByID(assets, "tf_checkout")
+	assert.True(t, ok)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", asset.ID)
+
+	_, ok = AssetByID(assets, "33333333-3333-3333-3333-333333333333")
+	assert.False(t, ok)
+}
@@ -92,3 +92,22 @@ func (c *dash0Client) ResolveDashboard(ctx context.Context, origin string, datas
 	logResolvedURL(ctx, "dashboard", origin, dashboardURL)
 	return id, dashboardURL, nil
 }
+
+// ListDashboards returns the dashboards of the dataset.
+func (c *dash0Client) ListDashboards(ctx context.Context, dataset string) ([]Asset, error) {
+	items, err := c.inner.ListDashboards(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		assets = append(assets, newAsset(item.Id, item.Origin, item.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d dashboards in dataset %s", len(assets), dataset))
+	return assets, nil
+}
@@ -108,3 +108,31 @@ func TestGetDashboardURL(t *testing.T) {
 		assert.Equal(t, "", url)
 	})
 }
+
+func TestListDashboards(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]dash0.DashboardApiListItem{
+			{Id: "11111111-1111-1111-1111-111111111111", Origin: strPtr("tf_checkout"), Name: strPtr("Checkout")},
+			{Id: "22222222-2222-2222-2222-222222222222", Name: strPtr("Created in the UI")},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	inner, err := dash0.NewClient(
+		dash0.WithApiUrl(server.URL),
+		dash0.WithAuthToken("auth_test-token"),
+		dash0.WithUserAgent("test"),
+	)
+	require.NoError(t, err)
+	c := &dash0Client{inner: inner, apiURL: server.URL}
+
+	assets, err := c.ListDashboards(t.Context(), "default")
+	require.NoError(t, err)
+	// Assets without an origin are addressed by their id.
+	assert.Equal(t, []Asset{
+		{Origin: "tf_checkout", ID: "11111111-1111-1111-1111-111111111111", Name: "Checkout"},
+		{Origin: "22222222-2222-2222-2222-222222222222", ID: "22222222-2222-2222-2222-222222222222", Name: "Created in the UI"},
+	}, assets)
+}
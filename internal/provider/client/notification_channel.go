@@ -110,6 +110,27 @@ func (c *dash0Client) ResolveNotificationChannel(ctx context.Context, origin str
 	return id, url, nil
 }
 
+// ListNotificationChannels returns the notification channels of the
+// organization.
+func (c *dash0Client) ListNotificationChannels(ctx context.Context) ([]Asset, error) {
+	channels, err := c.inner.ListNotificationChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(channels))
+	for _, channel := range channels {
+		if channel == nil {
+			continue
+		}
+		origin := dash0.GetNotificationChannelOrigin(channel)
+		assets = append(assets, newAsset(dash0.GetNotificationChannelID(channel), &origin, &channel.Metadata.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d notification channels", len(assets)))
+	return assets, nil
+}
+
 // unmarshalNotificationChannel parses a JSON string into a NotificationChannelDefinition.
 func unmarshalNotificationChannel(jsonStr string) (*dash0.NotificationChannelDefinition, error) {
 	var def dash0.NotificationChannelDefinition
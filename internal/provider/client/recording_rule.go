@@ -101,6 +101,31 @@ func (c *dash0Client) ResolveRecordingRule(ctx context.Context, origin string, d
 	return "", nil
 }
 
+// ListRecordingRules returns the recording rules of the dataset.
+func (c *dash0Client) ListRecordingRules(ctx context.Context, dataset string) ([]Asset, error) {
+	items, err := c.inner.ListRecordingRules(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(items))
+	for _, rule := range items {
+		if rule == nil {
+			continue
+		}
+		var origin *string
+		if rule.Metadata.Labels != nil {
+			if o := (*rule.Metadata.Labels)[dash0.LabelOrigin]; o != "" {
+				origin = &o
+			}
+		}
+		assets = append(assets, newAsset(dash0.GetRecordingRuleID(rule), origin, &rule.Metadata.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d recording rules in dataset %s", len(assets), dataset))
+	return assets, nil
+}
+
 // unmarshalRecordingRule parses a JSON string into a RecordingRule.
 func unmarshalRecordingRule(jsonStr string) (*dash0.RecordingRule, error) {
 	var rule dash0.RecordingRule
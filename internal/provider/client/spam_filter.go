@@ -170,6 +170,37 @@ func (c *dash0Client) ResolveSpamFilter(ctx context.Context, origin string, data
 	return "", nil
 }
 
+// ListSpamFilters returns the spam filters of the dataset, in either API
+// version.
+func (c *dash0Client) ListSpamFilters(ctx context.Context, dataset string) ([]Asset, error) {
+	items, err := c.inner.ListSpamFilterObjects(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(items))
+	for _, obj := range items {
+		var meta *dash0.SpamFilterMetadata
+		switch f := obj.(type) {
+		case *dash0.SpamFilter:
+			if f != nil {
+				meta = &f.Metadata
+			}
+		case *dash0.SpamFilterV1Alpha2:
+			if f != nil {
+				meta = &f.Metadata
+			}
+		}
+		if meta == nil || meta.Labels == nil || meta.Labels.Dash0Comid == nil {
+			continue
+		}
+		assets = append(assets, newAsset(*meta.Labels.Dash0Comid, meta.Labels.Dash0Comorigin, &meta.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d spam filters in dataset %s", len(assets), dataset))
+	return assets, nil
+}
+
 // spamFilterIsV1Alpha2 reports whether the JSON document declares apiVersion
 // v1alpha2. The apiVersion may be either the bare form ("v1alpha2") or the
 // operator-style prefixed form ("operator.dash0.com/v1alpha2"); both are
@@ -87,3 +87,22 @@ func (c *dash0Client) ResolveSyntheticCheck(ctx context.Context, origin string,
 	logResolvedURL(ctx, "synthetic check", origin, syntheticCheckURL)
 	return id, syntheticCheckURL, nil
 }
+
+// ListSyntheticChecks returns the synthetic checks of the dataset.
+func (c *dash0Client) ListSyntheticChecks(ctx context.Context, dataset string) ([]Asset, error) {
+	items, err := c.inner.ListSyntheticChecks(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		assets = append(assets, newAsset(item.Id, item.Origin, item.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d synthetic checks in dataset %s", len(assets), dataset))
+	return assets, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -92,6 +95,32 @@ func (c *dash0Client) UpdateTeam(ctx context.Context, origin string, teamJSON st
 	return nil
 }
 
+// UpdateTeamKeepingMembers updates the team with the given origin like
+// UpdateTeam, but keeps the members the team currently has instead of the
+// ones in teamJSON. The members are read and the team written under the
+// team's lock, so that a concurrent AddTeamMember or RemoveTeamMember on the
+// same team is not overwritten.
+func (c *dash0Client) UpdateTeamKeepingMembers(ctx context.Context, origin string, teamJSON string) error {
+	def, err := unmarshalTeam(teamJSON)
+	if err != nil {
+		return fmt.Errorf("error parsing team JSON: %w", err)
+	}
+
+	setTeamOrigin(def, origin)
+
+	err = c.withLockedTeam(ctx, origin, func(current *dash0.TeamDefinitionV1Alpha1) error {
+		def.Spec.Members = current.Spec.Members
+		_, err := c.inner.UpsertTeam(ctx, origin, def)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Team updated with origin: %s, keeping its members", origin))
+	return nil
+}
+
 // DeleteTeam deletes the team identified by origin.
 func (c *dash0Client) DeleteTeam(ctx context.Context, origin string) error {
 	err := c.inner.DeleteTeam(ctx, origin)
@@ -117,6 +146,201 @@ func (c *dash0Client) ResolveTeam(ctx context.Context, origin string) (string, e
 	return dash0.GetTeamID(def), nil
 }
 
+// ListTeams returns the teams of the organization.
+func (c *dash0Client) ListTeams(ctx context.Context) ([]Asset, error) {
+	defs, err := c.inner.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(defs))
+	for _, def := range defs {
+		if def == nil {
+			continue
+		}
+		var origin *string
+		if def.Metadata.Labels != nil {
+			origin = def.Metadata.Labels.Dash0Comorigin
+		}
+		assets = append(assets, newAsset(dash0.GetTeamID(def), origin, &def.Spec.Display.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d teams", len(assets)))
+	return assets, nil
+}
+
+// Member is an organization member as referenced from team membership.
+type Member struct {
+	// ID is the member's internal Dash0 id (the dash0.com/id label).
+	ID string
+	// Email is the member's email address. It is empty for members without
+	// one, such as pending invitations.
+	Email string
+}
+
+// ListMembers returns the organization's members with their ids and email
+// addresses.
+func (c *dash0Client) ListMembers(ctx context.Context) ([]Member, error) {
+	defs, err := c.inner.ListMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(defs))
+	for _, def := range defs {
+		if def == nil {
+			continue
+		}
+		var m Member
+		if def.Metadata.Labels != nil && def.Metadata.Labels.Dash0Comid != nil {
+			m.ID = *def.Metadata.Labels.Dash0Comid
+		}
+		if def.Spec.Display.Email != nil {
+			m.Email = *def.Spec.Display.Email
+		}
+		members = append(members, m)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d organization members", len(members)))
+	return members, nil
+}
+
+// teamLocks holds one *sync.Mutex per team id, serializing the
+// read-modify-write cycles of AddTeamMember, RemoveTeamMember and
+// UpdateTeamKeepingMembers. Like
+// datasetLocks it is package-level so that aliased provider blocks share it.
+var teamLocks sync.Map
+
+// lockTeam acquires the membership lock for the team with the given id and
+// returns a function that releases it. The lock is keyed by id rather than by
+// the identifier the caller passed, so that memberships referencing the same
+// team by origin and by id still serialize. It is in-process only; see
+// lockDataset for the same caveat.
+func lockTeam(id string) func() {
+	value, _ := teamLocks.LoadOrStore(id, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// AddTeamMember adds member to the team unless it already belongs to it.
+func (c *dash0Client) AddTeamMember(ctx context.Context, team string, member string) error {
+	return c.modifyTeamMembers(ctx, team, member, func(members []string, index int) ([]string, bool) {
+		if index >= 0 {
+			return members, false
+		}
+		return append(members, member), true
+	})
+}
+
+// RemoveTeamMember removes member from the team if it belongs to it.
+func (c *dash0Client) RemoveTeamMember(ctx context.Context, team string, member string) error {
+	return c.modifyTeamMembers(ctx, team, member, func(members []string, index int) ([]string, bool) {
+		if index < 0 {
+			return members, false
+		}
+		return slices.Delete(members, index, index+1), true
+	})
+}
+
+// HasTeamMember reports whether member belongs to the team.
+func (c *dash0Client) HasTeamMember(ctx context.Context, team string, member string) (bool, error) {
+	def, err := c.inner.GetTeam(ctx, team)
+	if err != nil {
+		return false, err
+	}
+	index, err := c.teamMemberIndex(ctx, teamMembers(def), member)
+	if err != nil {
+		return false, err
+	}
+	return index >= 0, nil
+}
+
+// withLockedTeam calls fn with the team with the given origin or id, read
+// under the team's lock, and releases the lock once fn returns.
+func (c *dash0Client) withLockedTeam(ctx context.Context, team string, fn func(def *dash0.TeamDefinitionV1Alpha1) error) error {
+	def, err := c.inner.GetTeam(ctx, team)
+	if err != nil {
+		return err
+	}
+	lockKey := dash0.GetTeamID(def)
+	if lockKey == "" {
+		lockKey = team
+	}
+	unlock := lockTeam(lockKey)
+	defer unlock()
+
+	// Re-read under the lock: the first read only identified the team.
+	def, err = c.inner.GetTeam(ctx, team)
+	if err != nil {
+		return err
+	}
+	return fn(def)
+}
+
+// modifyTeamMembers reads the team, applies modify to its members and writes
+// it back if modify reports a change, all under the team's lock so that
+// concurrent membership changes on the same team do not overwrite each other.
+// modify receives the index of member in the list, or -1.
+func (c *dash0Client) modifyTeamMembers(ctx context.Context, team string, member string, modify func(members []string, index int) ([]string, bool)) error {
+	return c.withLockedTeam(ctx, team, func(def *dash0.TeamDefinitionV1Alpha1) error {
+		members := teamMembers(def)
+		index, err := c.teamMemberIndex(ctx, members, member)
+		if err != nil {
+			return err
+		}
+		members, changed := modify(members, index)
+		if !changed {
+			tflog.Debug(ctx, fmt.Sprintf("Team %s membership of %s is already up to date", team, member))
+			return nil
+		}
+
+		dash0.StripTeamServerFields(def)
+		def.Spec.Members = &members
+		if _, err := c.inner.UpsertTeam(ctx, team, def); err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Team %s membership of %s updated", team, member))
+		return nil
+	})
+}
+
+// teamMemberIndex returns the index of member in members, or -1. Both sides
+// may reference organization members by email address or id; they are
+// compared by the member they resolve to, with emails matched
+// case-insensitively.
+func (c *dash0Client) teamMemberIndex(ctx context.Context, members []string, member string) (int, error) {
+	orgMembers, err := c.ListMembers(ctx)
+	if err != nil {
+		return -1, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	resolve := func(ref string) string {
+		for _, o := range orgMembers {
+			if o.ID != "" && (o.ID == ref || (o.Email != "" && strings.EqualFold(o.Email, ref))) {
+				return o.ID
+			}
+		}
+		return strings.ToLower(ref)
+	}
+
+	target := resolve(member)
+	for i, m := range members {
+		if resolve(m) == target {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// teamMembers returns a copy of the team's spec.members.
+func teamMembers(def *dash0.TeamDefinitionV1Alpha1) []string {
+	if def == nil || def.Spec.Members == nil {
+		return nil
+	}
+	return slices.Clone(*def.Spec.Members)
+}
+
 // unmarshalTeam parses a JSON string into a TeamDefinitionV1Alpha1.
 func unmarshalTeam(jsonStr string) (*dash0.TeamDefinitionV1Alpha1, error) {
 	var def dash0.TeamDefinitionV1Alpha1
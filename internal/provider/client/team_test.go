@@ -447,3 +447,125 @@ func TestResolveTeam(t *testing.T) {
 		})
 	}
 }
+
+// TestListMembers asserts that members are reduced to their dash0.com/id
+// label and email address, and that members without an email (pending
+// invitations) are kept with an empty one.
+func TestListMembers(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/members" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]dash0.MemberDefinition{
+			{
+				Kind:     "Dash0Member",
+				Metadata: dash0.MemberMetadata{Name: "alice", Labels: &dash0.MemberLabels{Dash0Comid: strPtr("00000000-0000-0000-0000-0000000000A1")}},
+				Spec:     dash0.MemberSpec{Display: dash0.MemberDisplay{Email: strPtr("alice@example.com")}},
+			},
+			{
+				Kind:     "Dash0Member",
+				Metadata: dash0.MemberMetadata{Name: "pending", Labels: &dash0.MemberLabels{Dash0Comid: strPtr("00000000-0000-0000-0000-0000000000A2")}},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+
+	members, err := c.ListMembers(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []Member{
+		{ID: "00000000-0000-0000-0000-0000000000A1", Email: "alice@example.com"},
+		{ID: "00000000-0000-0000-0000-0000000000A2"},
+	}, members)
+}
+
+// TestAddTeamMember_KeepsOtherMembers asserts the read-modify-write contract
+// of the membership methods: the new member is appended to the members the
+// server returned, which are never dropped, and the write is skipped when the
+// member (matched by id or by email in any case) already belongs to the team.
+func TestAddTeamMember_KeepsOtherMembers(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	var upserts []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/members":
+			_ = json.NewEncoder(w).Encode([]dash0.MemberDefinition{
+				{Kind: "Dash0Member", Metadata: dash0.MemberMetadata{Name: "alice", Labels: &dash0.MemberLabels{Dash0Comid: strPtr("user_alice")}}, Spec: dash0.MemberSpec{Display: dash0.MemberDisplay{Email: strPtr("alice@example.com")}}},
+				{Kind: "Dash0Member", Metadata: dash0.MemberMetadata{Name: "bob", Labels: &dash0.MemberLabels{Dash0Comid: strPtr("user_bob")}}, Spec: dash0.MemberSpec{Display: dash0.MemberDisplay{Email: strPtr("bob@example.com")}}},
+			})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/teams/tf_backend":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			upserts = append(upserts, body)
+			_ = json.NewEncoder(w).Encode(body)
+		case r.URL.Path == "/api/teams/tf_backend":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"team": map[string]interface{}{
+					"kind":     "Dash0Team",
+					"metadata": map[string]interface{}{"name": "backend-team", "labels": map[string]interface{}{"dash0.com/id": "team_1", "dash0.com/origin": "tf_backend"}},
+					"spec":     map[string]interface{}{"display": map[string]interface{}{"name": "Backend"}, "members": []string{"user_alice"}},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+
+	require.NoError(t, c.AddTeamMember(t.Context(), "tf_backend", "ALICE@example.com"))
+	assert.Empty(t, upserts, "an existing member must not trigger a write")
+
+	require.NoError(t, c.AddTeamMember(t.Context(), "tf_backend", "bob@example.com"))
+	require.Len(t, upserts, 1)
+	spec := upserts[0]["spec"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"user_alice", "bob@example.com"}, spec["members"])
+}
+
+// TestUpdateTeamKeepingMembers_KeepsCurrentMembers asserts that the team is
+// written with the members the server returned rather than the ones in the
+// provided JSON, and with the provided display settings and origin.
+func TestUpdateTeamKeepingMembers_KeepsCurrentMembers(t *testing.T) {
+	var upserts []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/api/teams/tf_backend":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			upserts = append(upserts, body)
+			_ = json.NewEncoder(w).Encode(body)
+		case r.URL.Path == "/api/teams/tf_backend":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"team": map[string]interface{}{
+					"kind":     "Dash0Team",
+					"metadata": map[string]interface{}{"name": "backend-team", "labels": map[string]interface{}{"dash0.com/id": "team_1", "dash0.com/origin": "tf_backend"}},
+					"spec":     map[string]interface{}{"display": map[string]interface{}{"name": "Backend"}, "members": []string{"user_alice", "user_bob"}},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTeamTestClient(t, server)
+
+	teamJSON := `{"kind":"Dash0Team","metadata":{"name":"backend-team"},"spec":{"display":{"name":"Backend Team"}}}`
+	require.NoError(t, c.UpdateTeamKeepingMembers(t.Context(), "tf_backend", teamJSON))
+
+	require.Len(t, upserts, 1)
+	spec := upserts[0]["spec"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"user_alice", "user_bob"}, spec["members"])
+	assert.Equal(t, "Backend Team", spec["display"].(map[string]interface{})["name"])
+	labels := upserts[0]["metadata"].(map[string]interface{})["labels"].(map[string]interface{})
+	assert.Equal(t, "tf_backend", labels["dash0.com/origin"])
+}
@@ -101,3 +101,22 @@ func (c *dash0Client) ResolveView(ctx context.Context, origin string, dataset st
 	logResolvedURL(ctx, "view", origin, viewURL)
 	return matched.Id, viewURL, nil
 }
+
+// ListViews returns the views of the dataset.
+func (c *dash0Client) ListViews(ctx context.Context, dataset string) ([]Asset, error) {
+	items, err := c.inner.ListViews(ctx, &dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		assets = append(assets, newAsset(item.Id, item.Origin, item.Name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d views in dataset %s", len(assets), dataset))
+	return assets, nil
+}
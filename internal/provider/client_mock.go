@@ -38,6 +38,12 @@ func (m *MockClient) ResolveDashboard(ctx context.Context, origin string, datase
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) ListDashboards(ctx context.Context, dataset string) ([]client.Asset, error) {
+	args := m.Called(ctx, dataset)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
 func (m *MockClient) CreateSyntheticCheck(ctx context.Context, origin string, checkJSON string, dataset string) error {
 	args := m.Called(ctx, origin, checkJSON, dataset)
 	return args.Error(0)
@@ -63,6 +69,12 @@ func (m *MockClient) ResolveSyntheticCheck(ctx context.Context, origin string, d
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) ListSyntheticChecks(ctx context.Context, dataset string) ([]client.Asset, error) {
+	args := m.Called(ctx, dataset)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
 func (m *MockClient) CreateView(ctx context.Context, origin string, viewJSON string, dataset string) error {
 	args := m.Called(ctx, origin, viewJSON, dataset)
 	return args.Error(0)
@@ -88,6 +100,12 @@ func (m *MockClient) ResolveView(ctx context.Context, origin string, dataset str
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) ListViews(ctx context.Context, dataset string) ([]client.Asset, error) {
+	args := m.Called(ctx, dataset)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
 func (m *MockClient) CreateCheckRule(ctx context.Context, origin string, ruleYAML string, dataset string) error {
 	args := m.Called(ctx, origin, ruleYAML, dataset)
 	return args.Error(0)
@@ -113,6 +131,12 @@ func (m *MockClient) ResolveCheckRule(ctx context.Context, origin string, datase
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) ListCheckRules(ctx context.Context, dataset string) ([]client.Asset, error) {
+	args := m.Called(ctx, dataset)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
 func (m *MockClient) CreateRecordingRule(ctx context.Context, origin string, ruleJSON string, dataset string) error {
 	args := m.Called(ctx, origin, ruleJSON, dataset)
 	return args.Error(0)
@@ -138,6 +162,12 @@ func (m *MockClient) ResolveRecordingRule(ctx context.Context, origin string, da
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) ListRecordingRules(ctx context.Context, dataset string) ([]client.Asset, error) {
+	args := m.Called(ctx, dataset)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
 func (m *MockClient) CreateNotificationChannel(ctx context.Context, origin string, channelJSON string) error {
 	args := m.Called(ctx, origin, channelJSON)
 	return args.Error(0)
@@ -163,6 +193,12 @@ func (m *MockClient) ResolveNotificationChannel(ctx context.Context, origin stri
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockClient) ListNotificationChannels(ctx context.Context) ([]client.Asset, error) {
+	args := m.Called(ctx)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
 func (m *MockClient) CreateTeam(ctx context.Context, origin string, teamJSON string) error {
 	args := m.Called(ctx, origin, teamJSON)
 	return args.Error(0)
@@ -178,6 +214,11 @@ func (m *MockClient) UpdateTeam(ctx context.Context, origin string, teamJSON str
 	return args.Error(0)
 }
 
+func (m *MockClient) UpdateTeamKeepingMembers(ctx context.Context, origin string, teamJSON string) error {
+	args := m.Called(ctx, origin, teamJSON)
+	return args.Error(0)
+}
+
 func (m *MockClient) DeleteTeam(ctx context.Context, origin string) error {
 	args := m.Called(ctx, origin)
 	return args.Error(0)
@@ -188,6 +229,33 @@ func (m *MockClient) ResolveTeam(ctx context.Context, origin string) (string, er
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) ListTeams(ctx context.Context) ([]client.Asset, error) {
+	args := m.Called(ctx)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
+func (m *MockClient) ListMembers(ctx context.Context) ([]client.Member, error) {
+	args := m.Called(ctx)
+	members, _ := args.Get(0).([]client.Member)
+	return members, args.Error(1)
+}
+
+func (m *MockClient) AddTeamMember(ctx context.Context, team string, member string) error {
+	args := m.Called(ctx, team, member)
+	return args.Error(0)
+}
+
+func (m *MockClient) RemoveTeamMember(ctx context.Context, team string, member string) error {
+	args := m.Called(ctx, team, member)
+	return args.Error(0)
+}
+
+func (m *MockClient) HasTeamMember(ctx context.Context, team string, member string) (bool, error) {
+	args := m.Called(ctx, team, member)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockClient) CreateSpamFilter(ctx context.Context, origin string, filterJSON string, dataset string) error {
 	args := m.Called(ctx, origin, filterJSON, dataset)
 	return args.Error(0)
@@ -213,6 +281,12 @@ func (m *MockClient) ResolveSpamFilter(ctx context.Context, origin string, datas
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) ListSpamFilters(ctx context.Context, dataset string) ([]client.Asset, error) {
+	args := m.Called(ctx, dataset)
+	assets, _ := args.Get(0).([]client.Asset)
+	return assets, args.Error(1)
+}
+
 func (m *MockClient) SendLogEvent(ctx context.Context, event client.LogEvent, dataset string) error {
 	args := m.Called(ctx, event, dataset)
 	return args.Error(0)
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// privateKeyServerRevision is the private state key under which the revision
+// of an asset that Terraform last read or wrote is recorded.
+const privateKeyServerRevision = "server_revision"
+
+// conflictDetection is the provider's `conflict_detection` setting: whether
+// an update first checks that the asset was not changed on the server since
+// Terraform last read it, instead of overwriting that change.
+//
+// The Dash0 API accepts no precondition on updates, so the check is a
+// compare-before-write: it narrows the window in which a change made in the
+// Dash0 UI is lost from the time between plan and apply to the time between
+// the check and the write.
+type conflictDetection bool
+
+// serverRevision identifies one revision of an asset on the server. Exactly
+// one field is set: the server version if the API reports one, else the time
+// of the last change, else a digest of the whole document.
+type serverRevision struct {
+	Version   string `json:"version,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	Digest    string `json:"digest,omitempty"`
+}
+
+// revisionOf returns the revision of an asset as returned by the API.
+func revisionOf(document string) serverRevision {
+	modification := converter.LastModification(document)
+	switch {
+	case modification.Version != "":
+		return serverRevision{Version: modification.Version}
+	case modification.At != "":
+		return serverRevision{UpdatedAt: modification.At}
+	default:
+		sum := sha256.Sum256([]byte(document))
+		return serverRevision{Digest: hex.EncodeToString(sum[:])}
+	}
+}
+
+// record stores the revision of document, the asset as Read or a write last
+// saw it, in private state. An empty document, which a failed read after a
+// write leaves, removes the record, so that the next update is not refused
+// for a revision Terraform never knew about.
+func (c conflictDetection) record(ctx context.Context, private privateStateSetter, document string, diags *diag.Diagnostics) {
+	if !c {
+		return
+	}
+	var value []byte
+	if document != "" {
+		value, _ = json.Marshal(revisionOf(document))
+	}
+	diags.Append(private.SetKey(ctx, privateKeyServerRevision, value)...)
+}
+
+// check refuses an update when the asset, as get returns it now, is no longer
+// the revision recorded in private state. Without a record, for example for
+// state written before the setting was enabled and not refreshed since, there
+// is nothing to compare and the update proceeds.
+func (c conflictDetection) check(ctx context.Context, noun string, private privateStateGetter, get func(ctx context.Context) (string, error), diags *diag.Diagnostics) {
+	if !c {
+		return
+	}
+	recorded, privateDiags := private.GetKey(ctx, privateKeyServerRevision)
+	diags.Append(privateDiags...)
+	var expected serverRevision
+	if len(recorded) == 0 || json.Unmarshal(recorded, &expected) != nil {
+		tflog.Debug(ctx, fmt.Sprintf("No recorded %s revision, skipping the concurrent modification check", noun))
+		return
+	}
+
+	document, err := get(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s to check for concurrent modifications, got error: %s", noun, err))
+		return
+	}
+	if revisionOf(document) == expected {
+		return
+	}
+	detail := fmt.Sprintf("The %s was modified", noun)
+	if description := converter.LastModification(document).Describe(); description != "" {
+		detail += " " + description
+	}
+	detail += " after Terraform last read it. The update was not applied, so that change is not overwritten. Run `terraform plan` again to review the change against the configuration, then apply the new plan."
+	diags.AddError(fmt.Sprintf("%s modified concurrently, re-plan", sentenceCase(noun)), detail)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	conflictDetectionReadDocument    = `{"kind":"Dash0View","metadata":{"name":"errors","version":3},"spec":{}}`
+	conflictDetectionChangedDocument = `{"kind":"Dash0View","metadata":{"name":"errors","version":4,"updatedAt":"2024-05-02T14:02:31Z","dash0Extensions":{"updatedBy":"alice@example.com"}},"spec":{}}`
+)
+
+func TestRevisionOf(t *testing.T) {
+	assert.Equal(t, serverRevision{Version: "3"}, revisionOf(conflictDetectionReadDocument))
+	assert.Equal(t, serverRevision{UpdatedAt: "2024-05-02T14:02:31Z"}, revisionOf(`{"metadata":{"updatedAt":"2024-05-02T14:02:31Z"}}`))
+
+	// Without version or timestamp, any change to the document is a new revision.
+	digest := revisionOf(`{"metadata":{"name":"errors"},"spec":{"a":1}}`)
+	assert.NotEmpty(t, digest.Digest)
+	assert.NotEqual(t, digest, revisionOf(`{"metadata":{"name":"errors"},"spec":{"a":2}}`))
+}
+
+func TestConflictDetection_Check(t *testing.T) {
+	tests := []struct {
+		name          string
+		recorded      string
+		current       string
+		getErr        error
+		expectSummary string
+	}{
+		{
+			name:     "unchanged since read",
+			recorded: conflictDetectionReadDocument,
+			current:  conflictDetectionReadDocument,
+		},
+		{
+			name:          "changed since read",
+			recorded:      conflictDetectionReadDocument,
+			current:       conflictDetectionChangedDocument,
+			expectSummary: "View modified concurrently, re-plan",
+		},
+		{
+			name:    "nothing recorded",
+			current: conflictDetectionChangedDocument,
+		},
+		{
+			name:          "read error",
+			recorded:      conflictDetectionReadDocument,
+			getErr:        errors.New("dash0 api error: unavailable (status: 503)"),
+			expectSummary: "Client Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			private := testPrivateState{}
+			var diags diag.Diagnostics
+			conflictDetection(true).record(ctx, private, tt.recorded, &diags)
+
+			conflictDetection(true).check(ctx, "view", private, func(context.Context) (string, error) {
+				return tt.current, tt.getErr
+			}, &diags)
+
+			if tt.expectSummary == "" {
+				assert.Empty(t, diags)
+				return
+			}
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Equal(t, tt.expectSummary, diags.Errors()[0].Summary())
+		})
+	}
+}
+
+func TestConflictDetection_CheckDetail(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+	var diags diag.Diagnostics
+	conflictDetection(true).record(ctx, private, conflictDetectionReadDocument, &diags)
+	conflictDetection(true).check(ctx, "view", private, func(context.Context) (string, error) {
+		return conflictDetectionChangedDocument, nil
+	}, &diags)
+
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "The view was modified by alice@example.com at 2024-05-02 14:02 UTC (server version 4) after Terraform last read it. The update was not applied, so that change is not overwritten. Run `terraform plan` again to review the change against the configuration, then apply the new plan.", diags.Errors()[0].Detail())
+}
+
+func TestConflictDetection_Disabled(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+	var diags diag.Diagnostics
+	conflictDetection(false).record(ctx, private, conflictDetectionReadDocument, &diags)
+	assert.Empty(t, private)
+
+	private[privateKeyServerRevision] = []byte(`{"version":"1"}`)
+	conflictDetection(false).check(ctx, "view", private, func(context.Context) (string, error) {
+		t.Fatal("a disabled check must not read the asset")
+		return "", nil
+	}, &diags)
+	assert.Empty(t, diags)
+}
+
+func TestConflictDetection_RecordFailedRead(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+	var diags diag.Diagnostics
+	conflictDetection(true).record(ctx, private, conflictDetectionReadDocument, &diags)
+
+	// A read after a write that failed leaves no document; the revision
+	// recorded before the write must not make the next update fail.
+	conflictDetection(true).record(ctx, private, "", &diags)
+	conflictDetection(true).check(ctx, "view", private, func(context.Context) (string, error) {
+		return conflictDetectionChangedDocument, nil
+	}, &diags)
+
+	assert.Empty(t, diags)
+}
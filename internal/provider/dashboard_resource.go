@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"maps"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,7 +11,6 @@ import (
 
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,9 +23,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &DashboardResource{}
-	_ resource.ResourceWithConfigure   = &DashboardResource{}
-	_ resource.ResourceWithImportState = &DashboardResource{}
+	_ resource.Resource                   = &DashboardResource{}
+	_ resource.ResourceWithConfigure      = &DashboardResource{}
+	_ resource.ResourceWithImportState    = &DashboardResource{}
+	_ resource.ResourceWithIdentity       = &DashboardResource{}
+	_ resource.ResourceWithValidateConfig = &DashboardResource{}
+	_ resource.ResourceWithModifyPlan     = &DashboardResource{}
 )
 
 // NewDashboardResource is a helper function to simplify the provider implementation.
@@ -40,15 +42,37 @@ type DashboardResource struct {
 	// defaultDataset is the provider-level default dataset, inherited by this
 	// resource's `dataset` attribute when it is omitted from configuration.
 	defaultDataset string
+	// policy is the provider-level policy, enforced in ModifyPlan.
+	policy policy
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
 }
 
 // dashboardModel is the Terraform state model for a dashboard resource.
 type dashboardModel struct {
-	Origin        types.String `tfsdk:"origin"`
-	ID            types.String `tfsdk:"id"`
-	Dataset       types.String `tfsdk:"dataset"`
-	DashboardYaml types.String `tfsdk:"dashboard_yaml"`
-	URL           types.String `tfsdk:"url"`
+	Origin             types.String `tfsdk:"origin"`
+	ID                 types.String `tfsdk:"id"`
+	Dataset            types.String `tfsdk:"dataset"`
+	DashboardYaml      types.String `tfsdk:"dashboard_yaml"`
+	URL                types.String `tfsdk:"url"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	AllowDatasetMove   types.Bool   `tfsdk:"allow_dataset_move"`
+	Datasets           types.Set    `tfsdk:"datasets"`
+	DatasetIDs         types.Map    `tfsdk:"dataset_ids"`
+	DatasetURLs        types.Map    `tfsdk:"dataset_urls"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -68,10 +92,41 @@ func (r *DashboardResource) Configure(_ context.Context, req resource.ConfigureR
 
 	r.client = data.client
 	r.defaultDataset = data.defaultDataset
+	r.policy = data.policy
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
 }
 
 func (r *DashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dashboard"
+	// Moving the resource to another dataset (allow_dataset_move) changes the
+	// dataset in its identity.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the dashboard by.
+func (r *DashboardResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = datasetAssetIdentitySchema("dashboard")
+}
+
+// ValidateConfig checks dashboard_yaml offline against the embedded Perses
+// schema and the dashboard's internal references (see validateDashboardYAML),
+// and that at most one of dataset and datasets is set.
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateDatasetReplicas(ctx, req.Config, &resp.Diagnostics)
+
+	var model dashboardModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.DashboardYaml.IsNull() || model.DashboardYaml.IsUnknown() {
+		return
+	}
+	validateDashboardYAML(model.DashboardYaml.ValueString(), path.Root("dashboard_yaml"), &resp.Diagnostics)
 }
 
 func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -93,19 +148,19 @@ func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"dataset": schema.StringAttribute{
-				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the dashboard belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated.",
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the dashboard belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated, unless `allow_dataset_move` is set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					datasetRequiresReplace(),
 				},
 			},
 			"dashboard_yaml": schema.StringAttribute{
-				Description: "The dashboard definition in YAML format, following the [Perses Dashboard specification](https://dash0.com/docs/dash0/dashboards/reference-dashboard-source-format). The following `metadata.annotations` are supported: `dash0.com/sharing` (sharing settings) and `dash0.com/folder-path` (folder location). Changes to these annotations trigger a resource update; all other metadata annotations are managed by the server and ignored during drift detection.",
+				Description: "The dashboard definition in YAML format, following the [Perses Dashboard specification](https://dash0.com/docs/dash0/dashboards/reference-dashboard-source-format). The following `metadata.annotations` are supported: `dash0.com/sharing` (sharing settings) and `dash0.com/folder-path` (folder location). Changes to these annotations trigger a resource update; all other metadata annotations are managed by the server and ignored during drift detection. A `PersesDashboard` custom resource as managed by the Dash0 Operator for Kubernetes (including `perses.dev/v1alpha2`, which nests the dashboard under `spec.config`) is accepted as-is: the provider unwraps it the same way the Operator does, keeping `metadata.name` and the `dash0.com/` labels and annotations and defaulting `spec.display.name` to `metadata.name`. The dashboard is validated at plan time against the Perses dashboard schema; layout items must reference panels that exist, and every `$variable` used in a query must be declared in `spec.variables`. Panel plugin, query and variable kinds that are neither shipped with Perses nor prefixed with `Dash0` are reported as warnings.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing, converter.AnnotationFolderPath),
+					yamlKinds["dashboard"].planModifier(),
 				},
 			},
 			"url": schema.StringAttribute{
@@ -117,6 +172,23 @@ func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("dashboard"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("dashboard")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("dashboard", "display name (`spec.display.name`)", "in its dataset")
+	resp.Schema.Attributes["allow_dataset_move"] = allowDatasetMoveAttribute("dashboard")
+	resp.Schema.Attributes["datasets"] = datasetsAttribute("dashboard")
+	resp.Schema.Attributes["dataset_ids"] = datasetIDsAttribute("dashboard")
+	resp.Schema.Attributes["dataset_urls"] = datasetURLsAttribute("dashboard")
+}
+
+// ModifyPlan plans the provider-level deletion protection default, dataset
+// moves and replicas, and enforces the provider-level policy on the planned
+// dashboard.
+func (r *DashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	planDatasetMove(ctx, req, resp, "id", "url")
+	planDatasetReplicas(ctx, req, resp, "id", "url")
+	r.policy.enforcePlan(ctx, req.Plan, "dash0_dashboard", path.Root("dashboard_yaml"), &resp.Diagnostics)
 }
 
 // resolveDashboard populates the dashboard's server-assigned id and web app
@@ -138,6 +210,28 @@ func (r *DashboardResource) resolveDashboard(ctx context.Context, model *dashboa
 	model.URL = stringOrNull(dashboardURL)
 }
 
+// replicas returns the replicas of a dashboard with `datasets`.
+func (r *DashboardResource) replicas() datasetReplicas {
+	return datasetReplicas{
+		noun:    "dashboard",
+		create:  r.client.CreateDashboard,
+		update:  r.client.UpdateDashboard,
+		remove:  r.client.DeleteDashboard,
+		get:     r.client.GetDashboard,
+		resolve: r.client.ResolveDashboard,
+	}
+}
+
+// resolveLastModified populates the dashboard's last-modified attributes after
+// a create or update (best-effort), and records the revision the write produced
+// for conflict detection.
+func (r *DashboardResource) resolveLastModified(ctx context.Context, model *dashboardModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "dashboard", func(ctx context.Context) (string, error) {
+		return r.client.GetDashboard(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model dashboardModel
 	diags := req.Plan.Get(ctx, &model)
@@ -147,7 +241,7 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	model.Origin = types.StringValue("tf_" + uuid.New().String())
-	if model.Dataset.IsNull() || model.Dataset.IsUnknown() {
+	if model.Datasets.IsNull() && (model.Dataset.IsNull() || model.Dataset.IsUnknown()) {
 		model.Dataset = types.StringValue(r.defaultDataset)
 	}
 
@@ -162,14 +256,41 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.DashboardYaml.ValueString())
+	// Unwrap an Operator custom resource envelope, if any, and convert YAML
+	// to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(converter.UnwrapOperatorDashboard(model.DashboardYaml.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert dashboard YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateDashboard(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Write a replica of the dashboard to each of its datasets instead, if
+	// datasets is set.
+	if !model.Datasets.IsNull() {
+		model.Datasets, model.DatasetIDs, model.DatasetURLs = r.replicas().apply(ctx, model.Origin.ValueString(), jsonBody, types.SetNull(types.StringType), model.Datasets, model.DatasetIDs, model.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "created a replicated dashboard resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Take over an existing dashboard with the same name instead of creating a
+	// second one, if adopt_existing is set.
+	createDashboard := r.client.CreateDashboard
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "dashboard", fmt.Sprintf("in dataset %q", model.Dataset.ValueString()), jsonBody, func(ctx context.Context) ([]client.Asset, error) {
+			return r.client.ListDashboards(ctx, model.Dataset.ValueString())
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createDashboard = r.client.UpdateDashboard
+		}
+	}
+
+	err = createDashboard(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard, got error: %s", err))
 		return
@@ -177,12 +298,14 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Resolve the id and web app URL for the newly created dashboard (best-effort).
 	r.resolveDashboard(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a dashboard resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
 }
 
 func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -194,6 +317,14 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Read each replica of a dashboard with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.readReplicas(ctx, &state, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+		return
+	}
+
 	apiResponseJSON, err := r.client.GetDashboard(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard, got error: %s", err))
@@ -201,31 +332,59 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	tflog.Trace(ctx, "read a dashboard resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseJSON, &resp.Diagnostics)
+
+	r.refreshDashboard(ctx, &state, apiResponseJSON, &resp.Diagnostics)
+
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseJSON)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+}
 
+// refreshDashboard compares the dashboard in state with document, the
+// dashboard as returned by the API, and replaces dashboard_yaml with it only
+// if they differ beyond metadata the server manages.
+func (r *DashboardResource) refreshDashboard(ctx context.Context, state *dashboardModel, document string, diags *diag.Diagnostics) {
 	// Compare the current state with the retrieved dashboard
 	if state.DashboardYaml.ValueString() != "" {
-		stateYAML := state.DashboardYaml.ValueString()
+		stateYAML := converter.UnwrapOperatorDashboard(state.DashboardYaml.ValueString())
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, document, additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
 		if err != nil {
-			resp.Diagnostics.AddWarning(
+			diags.AddWarning(
 				"Dashboard Comparison Error",
 				fmt.Sprintf("Error comparing dashboards: %s. Using API response as source of truth.", err),
 			)
-			state.DashboardYaml = types.StringValue(apiResponseJSON)
+			state.DashboardYaml = types.StringValue(document)
 		} else if !equivalent {
 			tflog.Debug(ctx, "Dashboard has changed, updating state")
-			state.DashboardYaml = types.StringValue(apiResponseJSON)
+			warnChangedOutsideTerraform("dashboard", document, diags)
+			state.DashboardYaml = types.StringValue(document)
 		} else {
 			tflog.Debug(ctx, "Dashboard is equivalent, ignoring changes in metadata fields")
 		}
 	} else {
-		state.DashboardYaml = types.StringValue(apiResponseJSON)
+		state.DashboardYaml = types.StringValue(document)
 	}
+}
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+// readReplicas refreshes a dashboard with datasets from its replicas. The
+// first replica that differs from the configured dashboard replaces
+// dashboard_yaml, so that the next apply rewrites all of them.
+func (r *DashboardResource) readReplicas(ctx context.Context, state *dashboardModel, diags *diag.Diagnostics) {
+	configured := *state
+	drifted := false
+	state.Datasets, state.DatasetIDs, state.DatasetURLs = r.replicas().read(ctx, state.Origin.ValueString(), state.Datasets, state.DatasetIDs, state.DatasetURLs, func(document string, diags *diag.Diagnostics) {
+		replica := configured
+		r.refreshDashboard(ctx, &replica, document, diags)
+		if !drifted && !replica.DashboardYaml.Equal(configured.DashboardYaml) {
+			state.DashboardYaml, drifted = replica.DashboardYaml, true
+		}
+	}, diags)
+	tflog.Trace(ctx, "read a replicated dashboard resource")
 }
 
 func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -256,31 +415,65 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.DashboardYaml.ValueString())
+	// Unwrap an Operator custom resource envelope, if any, and convert YAML
+	// to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(converter.UnwrapOperatorDashboard(plan.DashboardYaml.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert dashboard YAML to JSON: %s", err))
 		return
 	}
 
-	// Update the existing dashboard (dataset changes force recreation via RequiresReplace)
+	// Update the replicas of a dashboard with datasets instead, creating and
+	// deleting those of added and removed datasets.
+	if !plan.Datasets.IsNull() {
+		plan.Origin = state.Origin
+		plan.Datasets, plan.DatasetIDs, plan.DatasetURLs = r.replicas().apply(ctx, plan.Origin.ValueString(), jsonBody, state.Datasets, plan.Datasets, state.DatasetIDs, state.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "updated a replicated dashboard resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Update the existing dashboard. A dataset change forces recreation via
+	// RequiresReplace, unless allow_dataset_move is set; then it is moved below.
 	plan.Origin = state.Origin
 	// The dashboard's identifier is immutable, so neither the id nor the URL
 	// change on update; carry them from state instead of re-resolving them via
 	// the API.
 	plan.ID = state.ID
 	plan.URL = state.URL
-	err = r.client.UpdateDashboard(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dashboard, got error: %s", err))
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "dashboard", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetDashboard(ctx, plan.Origin.ValueString(), state.Dataset.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.Dataset.Equal(state.Dataset) {
+		err = r.client.UpdateDashboard(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dashboard, got error: %s", err))
+			return
+		}
+	} else {
+		if !moveToDataset(ctx, "dashboard", plan.Origin.ValueString(), jsonBody, state.Dataset.ValueString(), plan.Dataset.ValueString(), r.client.CreateDashboard, r.client.DeleteDashboard, &resp.Diagnostics) {
+			return
+		}
+
+		// The moved dashboard has a new id and URL.
+		r.resolveDashboard(ctx, &plan, &resp.Diagnostics)
+	}
+
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a dashboard resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -290,6 +483,15 @@ func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("dashboard", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete every replica of a dashboard with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.replicas().deleteAll(ctx, state.Origin.ValueString(), state.Datasets, &resp.Diagnostics)
+		return
+	}
 
 	err := r.client.DeleteDashboard(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
@@ -302,18 +504,11 @@ func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState function is required for resources that support import
 func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
-		)
+	dataset, origin, ok := datasetImportTarget(ctx, req, "dashboard", r.client.ListDashboards, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	dataset := idParts[0]
-	origin := idParts[1]
-
 	apiResponseJSON, err := r.client.GetDashboard(ctx, origin, dataset)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -332,4 +527,5 @@ func (r *DashboardResource) ImportState(ctx context.Context, req resource.Import
 	r.resolveDashboard(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), model.URL)...)
+	setDatasetAssetIdentity(ctx, resp.Identity, types.StringValue(dataset), types.StringValue(origin), &resp.Diagnostics)
 }
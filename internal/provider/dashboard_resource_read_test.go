@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -80,7 +81,7 @@ spec:
   description: Updated description
 `,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			name:              "invalid YAML response - should update and warn",
@@ -95,6 +96,15 @@ spec:
 			// Create the test schema
 			testSchema := schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -125,19 +135,37 @@ spec:
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":         tftypes.String,
-						"id":             tftypes.String,
-						"dataset":        tftypes.String,
-						"dashboard_yaml": tftypes.String,
-						"url":            tftypes.String,
+						"last_modified_at":    tftypes.String,
+						"last_modified_by":    tftypes.String,
+						"server_version":      tftypes.String,
+						"deletion_protection": tftypes.Bool,
+						"adopt_existing":      tftypes.Bool,
+						"allow_dataset_move":  tftypes.Bool,
+						"datasets":            tftypes.Set{ElementType: tftypes.String},
+						"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+						"dataset_urls":        tftypes.Map{ElementType: tftypes.String},
+						"origin":              tftypes.String,
+						"id":                  tftypes.String,
+						"dataset":             tftypes.String,
+						"dashboard_yaml":      tftypes.String,
+						"url":                 tftypes.String,
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-					"id":             tftypes.NewValue(tftypes.String, nil),
-					"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-					"dashboard_yaml": tftypes.NewValue(tftypes.String, originalYaml),
-					"url":            tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+					"id":                  tftypes.NewValue(tftypes.String, nil),
+					"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+					"dashboard_yaml":      tftypes.NewValue(tftypes.String, originalYaml),
+					"url":                 tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
 				},
 			)
 
@@ -178,3 +206,125 @@ spec:
 		})
 	}
 }
+
+// TestDashboardResource_ReadOperatorEnvelope checks that a dashboard_yaml
+// holding a PersesDashboard custom resource, as managed by the Dash0
+// Operator, is compared against the API response in its unwrapped form, so
+// the Kubernetes envelope itself is not reported as drift.
+func TestDashboardResource_ReadOperatorEnvelope(t *testing.T) {
+	envelopeYaml := `
+apiVersion: perses.dev/v1alpha2
+kind: PersesDashboard
+metadata:
+  name: checkout
+  namespace: monitoring
+  uid: 0b9c1a54-2d7e-4f0a-9d4f-0b6f0e0a1b2c
+  labels:
+    app.kubernetes.io/part-of: shop
+  annotations:
+    dash0.com/folder-path: /shop
+spec:
+  config:
+    duration: 30m
+`
+
+	tests := []struct {
+		name              string
+		apiResponseYaml   string
+		expectYamlUpdated bool
+	}{
+		{
+			name: "unwrapped envelope matches the API response",
+			apiResponseYaml: `
+apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: checkout
+  annotations:
+    dash0.com/folder-path: /shop
+  labels:
+    dash0.com/dataset: default
+spec:
+  display:
+    name: checkout
+  duration: 30m
+`,
+			expectYamlUpdated: false,
+		},
+		{
+			name: "changed duration is drift",
+			apiResponseYaml: `
+apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: checkout
+  annotations:
+    dash0.com/folder-path: /shop
+spec:
+  display:
+    name: checkout
+  duration: 1h
+`,
+			expectYamlUpdated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &DashboardResource{client: &testDashboardClient{getResponse: tc.apiResponseYaml}}
+
+			var testSchema resource.SchemaResponse
+			r.Schema(context.Background(), resource.SchemaRequest{}, &testSchema)
+
+			raw := tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"last_modified_at":    tftypes.String,
+						"last_modified_by":    tftypes.String,
+						"server_version":      tftypes.String,
+						"deletion_protection": tftypes.Bool,
+						"adopt_existing":      tftypes.Bool,
+						"allow_dataset_move":  tftypes.Bool,
+						"datasets":            tftypes.Set{ElementType: tftypes.String},
+						"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+						"dataset_urls":        tftypes.Map{ElementType: tftypes.String},
+						"origin":              tftypes.String,
+						"id":                  tftypes.String,
+						"dataset":             tftypes.String,
+						"dashboard_yaml":      tftypes.String,
+						"url":                 tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"origin":              tftypes.NewValue(tftypes.String, "tf_dashboard"),
+					"id":                  tftypes.NewValue(tftypes.String, nil),
+					"dataset":             tftypes.NewValue(tftypes.String, "default"),
+					"dashboard_yaml":      tftypes.NewValue(tftypes.String, envelopeYaml),
+					"url":                 tftypes.NewValue(tftypes.String, nil),
+				},
+			)
+			state := tfsdk.State{Raw: raw, Schema: testSchema.Schema}
+			resp := resource.ReadResponse{State: state}
+
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var resultState dashboardModel
+			resp.State.Get(context.Background(), &resultState)
+			if tc.expectYamlUpdated {
+				assert.Equal(t, tc.apiResponseYaml, resultState.DashboardYaml.ValueString())
+			} else {
+				assert.Equal(t, envelopeYaml, resultState.DashboardYaml.ValueString())
+			}
+		})
+	}
+}
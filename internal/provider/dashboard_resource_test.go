@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -83,14 +85,32 @@ func TestDashboardResource_Create(t *testing.T) {
 	// Setup plan
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":         tftypes.NewValue(tftypes.String, ""),
-			"id":             tftypes.NewValue(tftypes.String, nil),
-			"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-			"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":            tftypes.NewValue(tftypes.String, nil),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, ""),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"dashboard_yaml":      tftypes.NewValue(tftypes.String, testYaml),
+			"url":                 tftypes.NewValue(tftypes.String, nil),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+				"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
@@ -127,6 +147,7 @@ func TestDashboardResource_Create(t *testing.T) {
 
 	// Setup mock expectations - CreateDashboard(ctx, origin, jsonBody, dataset)
 	mockClient.On("CreateDashboard", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetDashboard", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 	// After create, the URL is resolved by origin (generated tf_-prefixed value).
 	mockClient.On("ResolveDashboard", mock.Anything, mock.Anything, testDataset).Return("test-id", testURL, nil)
 
@@ -149,6 +170,149 @@ func TestDashboardResource_Create(t *testing.T) {
 // covered table-driven across all six dataset-scoped resources in
 // dataset_default_test.go, rather than per-resource here.
 
+// TestDashboardResource_ValidateConfig asserts that schema violations in
+// dashboard_yaml fail at plan time, attributed to the attribute.
+func TestDashboardResource_ValidateConfig(t *testing.T) {
+	r := &DashboardResource{}
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, nil),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
+				"dashboard_yaml": tftypes.NewValue(tftypes.String, strings.Replace(
+					validationTestDashboardYaml, "duration: 30m", "duration: half an hour", 1)),
+				"url": tftypes.NewValue(tftypes.String, nil),
+			}),
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"origin":              schema.StringAttribute{Computed: true},
+					"id":                  schema.StringAttribute{Computed: true},
+					"dataset":             schema.StringAttribute{Optional: true},
+					"dashboard_yaml":      schema.StringAttribute{Required: true},
+					"url":                 schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	}
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), req, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Dashboard schema violation", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "spec.duration")
+}
+
+// TestDashboardResource_CreateOperatorEnvelope checks that a dashboard_yaml
+// holding a PersesDashboard custom resource, as managed by the Dash0 Operator,
+// is sent to the API in its unwrapped form while state keeps the envelope as
+// configured, and that reading the dashboard back does not report the
+// envelope as drift.
+func TestDashboardResource_CreateOperatorEnvelope(t *testing.T) {
+	ctx := context.Background()
+	mockClient := new(MockClient)
+	r := &DashboardResource{client: mockClient}
+
+	envelopeYaml := `apiVersion: perses.dev/v1alpha2
+kind: PersesDashboard
+metadata:
+  name: checkout
+  namespace: monitoring
+  labels:
+    app.kubernetes.io/part-of: shop
+  annotations:
+    dash0.com/folder-path: /shop
+    kubectl.kubernetes.io/last-applied-configuration: "{}"
+spec:
+  config:
+    duration: 30m
+  instanceSelector:
+    matchLabels:
+      app: perses
+`
+
+	var testSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &testSchema)
+	objectType := testSchema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["dataset"] = tftypes.NewValue(tftypes.String, "default")
+	values["dashboard_yaml"] = tftypes.NewValue(tftypes.String, envelopeYaml)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Raw: tftypes.NewValue(objectType, values), Schema: testSchema.Schema},
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: testSchema.Schema}}
+
+	var sent string
+	mockClient.On("CreateDashboard", mock.Anything, mock.Anything, mock.Anything, "default").
+		Run(func(args mock.Arguments) { sent = args.String(2) }).
+		Return(nil)
+	mockClient.On("GetDashboard", mock.Anything, mock.Anything, "default").Return("", nil).Once()
+	mockClient.On("ResolveDashboard", mock.Anything, mock.Anything, "default").Return("test-id", "", nil)
+
+	r.Create(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.JSONEq(t, `{
+		"apiVersion": "perses.dev/v1alpha1",
+		"kind": "PersesDashboard",
+		"metadata": {
+			"name": "checkout",
+			"annotations": {"dash0.com/folder-path": "/shop"}
+		},
+		"spec": {
+			"duration": "30m",
+			"display": {"name": "checkout"}
+		}
+	}`, sent)
+
+	var created dashboardModel
+	require.False(t, resp.State.Get(ctx, &created).HasError(), "state cannot be unmarshalled")
+	assert.Equal(t, envelopeYaml, created.DashboardYaml.ValueString())
+
+	// The API returns the dashboard it was sent, plus metadata it manages.
+	var stored map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(sent), &stored))
+	stored["metadata"].(map[string]interface{})["labels"] = map[string]interface{}{
+		"dash0.com/dataset": "default",
+		"dash0.com/origin":  created.Origin.ValueString(),
+	}
+	apiResponse, err := json.Marshal(stored)
+	require.NoError(t, err)
+	mockClient.On("GetDashboard", mock.Anything, created.Origin.ValueString(), "default").Return(string(apiResponse), nil)
+
+	readResp := resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "%v", readResp.Diagnostics)
+	assert.Zero(t, readResp.Diagnostics.WarningsCount(), "%v", readResp.Diagnostics)
+
+	var read dashboardModel
+	require.False(t, readResp.State.Get(ctx, &read).HasError(), "state cannot be unmarshalled")
+	assert.Equal(t, envelopeYaml, read.DashboardYaml.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
 func TestDashboardResource_Read(t *testing.T) {
 	mockClient := new(MockClient)
 	r := &DashboardResource{client: mockClient}
@@ -162,6 +326,15 @@ func TestDashboardResource_Read(t *testing.T) {
 
 	stateSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"last_modified_at":    schema.StringAttribute{Computed: true},
+			"last_modified_by":    schema.StringAttribute{Computed: true},
+			"server_version":      schema.StringAttribute{Computed: true},
+			"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+			"adopt_existing":      schema.BoolAttribute{Optional: true},
+			"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+			"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+			"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 			"origin": schema.StringAttribute{
 				Computed: true,
 			},
@@ -185,11 +358,20 @@ func TestDashboardResource_Read(t *testing.T) {
 	// Setup state
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-			"id":             tftypes.NewValue(tftypes.String, nil),
-			"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-			"dashboard_yaml": tftypes.NewValue(tftypes.String, "old yaml"),
-			"url":            tftypes.NewValue(tftypes.String, testURL),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"dashboard_yaml":      tftypes.NewValue(tftypes.String, "old yaml"),
+			"url":                 tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: stateSchema,
 	}
@@ -262,14 +444,32 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":            tftypes.NewValue(tftypes.String, testURL),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":      tftypes.NewValue(tftypes.String, testYaml),
+				"url":                 tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -294,11 +494,20 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create plan with updated YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, updatedYaml),
-				"url":            tftypes.NewValue(tftypes.String, testURL),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":      tftypes.NewValue(tftypes.String, updatedYaml),
+				"url":                 tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: state.Schema,
 		}
@@ -314,6 +523,7 @@ func TestDashboardResource_Update(t *testing.T) {
 
 		// Setup mock expectations - UpdateDashboard(ctx, origin, jsonBody, dataset)
 		mockClient.On("UpdateDashboard", mock.Anything, testOrigin, mock.Anything, testDataset).Return(nil)
+		mockClient.On("GetDashboard", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 
 		// Execute the update operation
 		r.Update(context.Background(), req, &resp)
@@ -338,14 +548,32 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":            tftypes.NewValue(tftypes.String, nil),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":      tftypes.NewValue(tftypes.String, testYaml),
+				"url":                 tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -368,11 +596,20 @@ func TestDashboardResource_Update(t *testing.T) {
 		// Create plan with invalid YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-				"id":             tftypes.NewValue(tftypes.String, nil),
-				"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-				"dashboard_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
-				"url":            tftypes.NewValue(tftypes.String, nil),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"dashboard_yaml":      tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
+				"url":                 tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: state.Schema,
 		}
@@ -765,14 +1002,32 @@ func TestDashboardResource_Delete(t *testing.T) {
 	// Create a state with test data
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":         tftypes.NewValue(tftypes.String, testOrigin),
-			"id":             tftypes.NewValue(tftypes.String, nil),
-			"dataset":        tftypes.NewValue(tftypes.String, testDataset),
-			"dashboard_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":            tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"dashboard_yaml":      tftypes.NewValue(tftypes.String, testYaml),
+			"url":                 tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/dashboards?dashboard_id=internal-uuid"),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+				"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
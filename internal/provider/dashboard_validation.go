@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"gopkg.in/yaml.v3"
+)
+
+// persesDashboardSchemaJSON is a JSON Schema for the spec of a PersesDashboard
+// as the Dash0 API accepts it, following the Perses dashboard specification.
+// It does not restrict plugin, query and variable kinds; those are checked by
+// validateDashboardKinds. It is embedded so that dashboards can be validated
+// without credentials or network access.
+//
+//go:embed schemas/perses_dashboard.schema.json
+var persesDashboardSchemaJSON []byte
+
+const persesDashboardSchemaURL = "urn:dash0:perses-dashboard-spec"
+
+// persesPanelRefPrefix is the JSON pointer prefix that layout items use to
+// reference a panel of the same dashboard.
+const persesPanelRefPrefix = "#/spec/panels/"
+
+// persesDashboardSchema compiles the embedded schema once.
+var persesDashboardSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(persesDashboardSchemaJSON))
+	if err != nil {
+		return nil, err
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(persesDashboardSchemaURL, doc); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(persesDashboardSchemaURL)
+})
+
+var schemaErrorPrinter = message.NewPrinter(language.English)
+
+// persesVariableReferencePattern matches the variable references Perses
+// interpolates into queries: $name, ${name}, ${name:format} and
+// ${name.field}. Like Perses, a bare $name ends at the first non-word
+// character, so in "$env-api" the variable is $env. A hyphenated name can
+// only be referenced in braces, as in ${my-var}.
+var persesVariableReferencePattern = regexp.MustCompile(`\$(\w+)|\$\{([\w-]+)(?:\.[^:}]+)?(?::[^}]+)?\}`)
+
+// persesPanelPluginKinds, persesQueryKinds and persesVariableKinds are the
+// kinds shipped with Perses. Together with the Dash0-specific kinds, which are
+// all prefixed with dash0KindPrefix, they are the kinds the provider knows.
+var (
+	persesPanelPluginKinds = []string{
+		"BarChart", "FlameChart", "GaugeChart", "HeatmapChart", "HistogramChart", "LogsTable", "Markdown", "PieChart",
+		"ScatterChart", "StatChart", "StatusHistoryChart", "Table", "TimeSeriesChart", "TimeSeriesTable", "TraceTable",
+		"TracingGanttChart",
+	}
+	persesQueryKinds    = []string{"LogQuery", "ProfileQuery", "TimeSeriesQuery", "TraceQuery"}
+	persesVariableKinds = []string{"ListVariable", "TextVariable"}
+)
+
+const dash0KindPrefix = "Dash0"
+
+// validateDashboardYAML checks a dashboard definition offline, so
+// `terraform validate` catches mistakes that the Dash0 API would otherwise
+// only reject at apply time:
+//   - the dashboard spec conforms to the embedded Perses schema (panel, query,
+//     variable and layout definitions),
+//   - panel plugin, query and variable kinds are ones the provider knows.
+//     Unknown kinds are reported as warnings, since the Dash0 API may accept
+//     plugins the provider has not learned about yet,
+//   - every layout item references a panel that exists,
+//   - every $variable used in a panel query or variable definition is
+//     declared in spec.variables. Perses built-in variables ($__interval,
+//     $__range, ...) are always available.
+//
+// Both plain PersesDashboard documents and Dash0 Operator envelopes (where the
+// spec is nested under spec.config) are accepted. Every other problem is
+// reported as an error on attr, with a detail naming the location and the YAML line it
+// was found at. A document that cannot be parsed at all is reported once and
+// not checked further.
+func validateDashboardYAML(yamlStr string, attr path.Path, diags *diag.Diagnostics) {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(yamlStr), &root); err != nil {
+		diags.AddAttributeError(attr, "Invalid YAML", fmt.Sprintf("The dashboard definition is not valid YAML: %s", err))
+		return
+	}
+	if len(root.Content) == 0 {
+		return
+	}
+	doc := root.Content[0]
+
+	spec, specLocation := dashboardSpecNode(doc)
+	if spec == nil {
+		return
+	}
+
+	validateDashboardSchema(spec, specLocation, attr, diags)
+	validateDashboardKinds(spec, specLocation, attr, diags)
+	validateDashboardPanelReferences(spec, specLocation, attr, diags)
+	validateDashboardVariableReferences(spec, specLocation, attr, diags)
+}
+
+// dashboardSpecNode returns the node holding the dashboard spec and its
+// location, or nil when the document has no spec mapping. For a Dash0
+// Operator envelope (perses.dev/v1alpha2) that is spec.config.
+func dashboardSpecNode(doc *yaml.Node) (*yaml.Node, string) {
+	spec := mappingValue(doc, "spec")
+	if spec == nil || spec.Kind != yaml.MappingNode {
+		return nil, ""
+	}
+	apiVersion := mappingValue(doc, "apiVersion")
+	if config := mappingValue(spec, "config"); config != nil && config.Kind == yaml.MappingNode &&
+		apiVersion != nil && strings.HasPrefix(apiVersion.Value, "perses.dev/") {
+		return config, "spec.config"
+	}
+	return spec, "spec"
+}
+
+// validateDashboardSchema validates spec against the embedded Perses schema
+// and reports every violation at the YAML line of the offending value.
+func validateDashboardSchema(spec *yaml.Node, specLocation string, attr path.Path, diags *diag.Diagnostics) {
+	schema, err := persesDashboardSchema()
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to compile the embedded dashboard schema: %s. Please report this issue to the provider developers.", err))
+		return
+	}
+
+	// Round-trip through JSON so the validator sees JSON values (json.Number
+	// rather than Go integers, string-keyed maps only).
+	var value interface{}
+	if err := spec.Decode(&value); err != nil {
+		diags.AddAttributeError(attr, "Invalid dashboard", fmt.Sprintf("%s: %s", specLocation, err))
+		return
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddAttributeError(attr, "Invalid dashboard", fmt.Sprintf("%s: %s", specLocation, err))
+		return
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(encoded))
+	if err != nil {
+		diags.AddAttributeError(attr, "Invalid dashboard", fmt.Sprintf("%s: %s", specLocation, err))
+		return
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if err := schema.Validate(instance); errors.As(err, &validationErr) {
+		for _, leaf := range schemaErrorLeaves(validationErr) {
+			node, location := locate(spec, specLocation, leaf.InstanceLocation)
+			diags.AddAttributeError(attr, "Dashboard schema violation", fmt.Sprintf("%s (line %d): %s",
+				location, node.Line, leaf.ErrorKind.LocalizedString(schemaErrorPrinter)))
+		}
+	} else if err != nil {
+		diags.AddAttributeError(attr, "Invalid dashboard", fmt.Sprintf("%s: %s", specLocation, err))
+	}
+}
+
+// schemaErrorLeaves returns the most specific causes of a validation error,
+// the ones that name the offending value rather than the enclosing schema.
+func schemaErrorLeaves(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var leaves []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		leaves = append(leaves, schemaErrorLeaves(cause)...)
+	}
+	return leaves
+}
+
+// validateDashboardKinds warns about panel plugin, query and variable kinds
+// that are neither shipped with Perses nor Dash0-specific. Missing and
+// non-string kinds are reported by the schema.
+func validateDashboardKinds(spec *yaml.Node, specLocation string, attr path.Path, diags *diag.Diagnostics) {
+	check := func(kind *yaml.Node, location, what string, known []string) {
+		if kind == nil || kind.Kind != yaml.ScalarNode || kind.Value == "" ||
+			slices.Contains(known, kind.Value) || strings.HasPrefix(kind.Value, dash0KindPrefix) {
+			return
+		}
+		diags.AddAttributeWarning(attr, fmt.Sprintf("Unknown %s", what), fmt.Sprintf(
+			"%s (line %d): %q is not a %s the provider knows. The Dash0 API may still accept it; if it does not, check the kind for typos.",
+			location, kind.Line, kind.Value, what))
+	}
+
+	if panels := mappingValue(spec, "panels"); panels != nil && panels.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(panels.Content); i += 2 {
+			key, panelSpec := panels.Content[i], mappingValue(panels.Content[i+1], "spec")
+			location := fmt.Sprintf("%s.panels.%s.spec", specLocation, key.Value)
+			check(mappingValue(mappingValue(panelSpec, "plugin"), "kind"), location+".plugin.kind", "panel plugin kind", persesPanelPluginKinds)
+			if queries := mappingValue(panelSpec, "queries"); queries != nil && queries.Kind == yaml.SequenceNode {
+				for qi, query := range queries.Content {
+					check(mappingValue(query, "kind"), fmt.Sprintf("%s.queries[%d].kind", location, qi), "query kind", persesQueryKinds)
+				}
+			}
+		}
+	}
+	if variables := mappingValue(spec, "variables"); variables != nil && variables.Kind == yaml.SequenceNode {
+		for vi, variable := range variables.Content {
+			check(mappingValue(variable, "kind"), fmt.Sprintf("%s.variables[%d].kind", specLocation, vi), "variable kind", persesVariableKinds)
+		}
+	}
+}
+
+// validateDashboardPanelReferences checks that every layout item references a
+// panel defined in spec.panels.
+func validateDashboardPanelReferences(spec *yaml.Node, specLocation string, attr path.Path, diags *diag.Diagnostics) {
+	panels := mappingValue(spec, "panels")
+	layouts := mappingValue(spec, "layouts")
+	if layouts == nil || layouts.Kind != yaml.SequenceNode {
+		return
+	}
+	for li, layout := range layouts.Content {
+		items := mappingValue(mappingValue(layout, "spec"), "items")
+		if items == nil || items.Kind != yaml.SequenceNode {
+			continue
+		}
+		for ii, item := range items.Content {
+			ref := mappingValue(mappingValue(item, "content"), "$ref")
+			if ref == nil || ref.Kind != yaml.ScalarNode || !strings.HasPrefix(ref.Value, persesPanelRefPrefix) {
+				// Missing or malformed references are reported by the schema.
+				continue
+			}
+			key := unescapeJSONPointerToken(strings.TrimPrefix(ref.Value, persesPanelRefPrefix))
+			if mappingValue(panels, key) != nil {
+				continue
+			}
+			diags.AddAttributeError(attr, "Unknown panel reference", fmt.Sprintf(
+				"%s.layouts[%d].spec.items[%d].content.$ref (line %d): %q references panel %q, which is not defined in %s.panels",
+				specLocation, li, ii, ref.Line, ref.Value, key, specLocation))
+		}
+	}
+}
+
+// validateDashboardVariableReferences checks that every variable referenced in
+// a panel query or a variable definition is declared in spec.variables.
+func validateDashboardVariableReferences(spec *yaml.Node, specLocation string, attr path.Path, diags *diag.Diagnostics) {
+	declared := map[string]bool{}
+	variables := mappingValue(spec, "variables")
+	if variables != nil && variables.Kind == yaml.SequenceNode {
+		for _, variable := range variables.Content {
+			if name := mappingValue(mappingValue(variable, "spec"), "name"); name != nil {
+				declared[name.Value] = true
+			}
+		}
+	}
+
+	check := func(node *yaml.Node, location string) {
+		forEachString(node, location, func(value *yaml.Node, location string) {
+			reported := map[string]bool{}
+			for _, match := range persesVariableReferencePattern.FindAllStringSubmatch(value.Value, -1) {
+				name := match[1] + match[2]
+				if declared[name] || reported[name] || isBuiltinVariable(name) {
+					continue
+				}
+				reported[name] = true
+				diags.AddAttributeError(attr, "Undeclared variable", fmt.Sprintf(
+					"%s (line %d): $%s is used but not declared in %s.variables", location, value.Line, name, specLocation))
+			}
+		})
+	}
+
+	if panels := mappingValue(spec, "panels"); panels != nil && panels.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(panels.Content); i += 2 {
+			key, panel := panels.Content[i], panels.Content[i+1]
+			check(mappingValue(mappingValue(panel, "spec"), "queries"), fmt.Sprintf("%s.panels.%s.spec.queries", specLocation, key.Value))
+		}
+	}
+	if variables != nil && variables.Kind == yaml.SequenceNode {
+		for vi, variable := range variables.Content {
+			check(mappingValue(mappingValue(variable, "spec"), "plugin"), fmt.Sprintf("%s.variables[%d].spec.plugin", specLocation, vi))
+		}
+	}
+}
+
+// isBuiltinVariable reports whether name is a Perses built-in variable (such
+// as __interval or __range), which is always available, or a purely numeric
+// reference such as the $1 of a label_replace replacement, which is not a
+// variable at all.
+func isBuiltinVariable(name string) bool {
+	if strings.HasPrefix(name, "__") {
+		return true
+	}
+	_, err := strconv.Atoi(name)
+	return err == nil
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil when
+// node is nil, not a mapping, or has no such key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// forEachString calls fn for every string scalar below node, which is found
+// at location, with the scalar's own location.
+func forEachString(node *yaml.Node, location string, fn func(value *yaml.Node, location string)) {
+	if node == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!str" {
+			fn(node, location)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			forEachString(node.Content[i+1], location+"."+node.Content[i].Value, fn)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			forEachString(child, fmt.Sprintf("%s[%d]", location, i), fn)
+		}
+	case yaml.AliasNode:
+		forEachString(node.Alias, location, fn)
+	}
+}
+
+// locate resolves JSON pointer tokens below node, which is found at
+// location. It returns the node the tokens point at and its location rendered
+// the way the other plan-time diagnostics do (".key" for mapping keys, "[i]"
+// for sequence indices). When the tokens lead to a value that does not exist,
+// as for a missing required property, the deepest existing ancestor is
+// returned, since that is where the fix has to go.
+func locate(node *yaml.Node, location string, tokens []string) (*yaml.Node, string) {
+	for _, token := range tokens {
+		var next *yaml.Node
+		switch node.Kind {
+		case yaml.MappingNode:
+			next = mappingValue(node, token)
+			location += "." + token
+		case yaml.SequenceNode:
+			if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(node.Content) {
+				next = node.Content[i]
+			}
+			location += "[" + token + "]"
+		}
+		if next == nil {
+			return node, location
+		}
+		node = next
+	}
+	return node, location
+}
+
+// unescapeJSONPointerToken reverses the ~0 and ~1 escapes of a JSON pointer
+// reference token (RFC 6901).
+func unescapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validationTestDashboardYaml = `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: checkout
+spec:
+  duration: 30m
+  display:
+    name: Checkout
+  variables:
+    - kind: ListVariable
+      spec:
+        name: service
+        allowMultiple: true
+        plugin:
+          kind: PrometheusLabelValuesVariable
+          spec:
+            labelName: service_name
+            matchers:
+              - 'up{namespace="$namespace"}'
+    - kind: TextVariable
+      spec:
+        name: namespace
+        value: production
+  layouts:
+    - kind: Grid
+      spec:
+        display:
+          title: Overview
+        items:
+          - content:
+              $ref: "#/spec/panels/requests"
+            x: 0
+            "y": 0
+            width: 12
+            height: 8
+  panels:
+    requests:
+      kind: Panel
+      spec:
+        display:
+          name: Requests
+        plugin:
+          kind: TimeSeriesChart
+          spec: {}
+        queries:
+          - kind: TimeSeriesQuery
+            spec:
+              plugin:
+                kind: PrometheusTimeSeriesQuery
+                spec:
+                  query: sum by (service_name) (rate(http_requests_total{service_name=~"${service:regex}"}[$__rate_interval]))
+                  seriesNameFormat: '{{service_name}}'
+          - kind: TimeSeriesQuery
+            spec:
+              plugin:
+                kind: PrometheusTimeSeriesQuery
+                spec:
+                  query: label_replace(up, "svc", "$1", "service_name", "(.*)")
+`
+
+func validateDashboardForTest(t *testing.T, yamlStr string) diag.Diagnostics {
+	t.Helper()
+	var diags diag.Diagnostics
+	validateDashboardYAML(yamlStr, path.Root("dashboard_yaml"), &diags)
+	return diags
+}
+
+func TestValidateDashboardYAML_Valid(t *testing.T) {
+	diags := validateDashboardForTest(t, validationTestDashboardYaml)
+	assert.Empty(t, diags, "unexpected diagnostics: %v", diags)
+}
+
+func TestValidateDashboardYAML_Example(t *testing.T) {
+	// The example used in the documentation must pass validation.
+	example, err := os.ReadFile("../../examples/resources/dash0_dashboard/dashboard.yaml")
+	require.NoError(t, err)
+
+	diags := validateDashboardForTest(t, string(example))
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+}
+
+func TestValidateDashboardYAML_EmptyCollections(t *testing.T) {
+	diags := validateDashboardForTest(t, `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: home
+spec:
+  duration: 30m
+  display:
+    name: Home
+  layouts: []
+  panels: []
+  variables: []
+`)
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+}
+
+func TestValidateDashboardYAML_Errors(t *testing.T) {
+	cases := []struct {
+		name          string
+		old           string
+		new           string
+		expectSummary string
+		expectDetail  string
+	}{
+		{
+			name:          "empty panel plugin kind",
+			old:           "kind: TimeSeriesChart",
+			new:           `kind: ""`,
+			expectSummary: "Dashboard schema violation",
+			expectDetail:  "spec.panels.requests.spec.plugin.kind (line 43):",
+		},
+		{
+			name:          "list variable without plugin",
+			old:           "        plugin:\n          kind: PrometheusLabelValuesVariable\n          spec:\n            labelName: service_name\n            matchers:\n              - 'up{namespace=\"$namespace\"}'\n",
+			new:           "",
+			expectSummary: "Dashboard schema violation",
+			expectDetail:  "spec.variables[0].spec (line 12): missing property 'plugin'",
+		},
+		{
+			name:          "layout item with non-integer width",
+			old:           "width: 12",
+			new:           "width: wide",
+			expectSummary: "Dashboard schema violation",
+			expectDetail:  "spec.layouts[0].spec.items[0].width (line 34): got string, want integer",
+		},
+		{
+			name:          "invalid duration",
+			old:           "duration: 30m",
+			new:           "duration: half an hour",
+			expectSummary: "Dashboard schema violation",
+			expectDetail:  "spec.duration (line 6):",
+		},
+		{
+			name:          "layout item referencing a missing panel",
+			old:           `$ref: "#/spec/panels/requests"`,
+			new:           `$ref: "#/spec/panels/errors"`,
+			expectSummary: "Unknown panel reference",
+			expectDetail:  `spec.layouts[0].spec.items[0].content.$ref (line 31): "#/spec/panels/errors" references panel "errors", which is not defined in spec.panels`,
+		},
+		{
+			name:          "undeclared variable in a panel query",
+			old:           `${service:regex}`,
+			new:           `${svc:regex}`,
+			expectSummary: "Undeclared variable",
+			expectDetail:  "spec.panels.requests.spec.queries[0].spec.plugin.spec.query (line 51): $svc is used but not declared in spec.variables",
+		},
+		{
+			name:          "undeclared variable in a variable definition",
+			old:           `$namespace`,
+			new:           `$ns`,
+			expectSummary: "Undeclared variable",
+			expectDetail:  "spec.variables[0].spec.plugin.spec.matchers[0] (line 19): $ns is used but not declared in spec.variables",
+		},
+		{
+			name:          "undeclared hyphenated variable",
+			old:           `${service:regex}`,
+			new:           `${service-name:regex}`,
+			expectSummary: "Undeclared variable",
+			expectDetail:  "$service-name is used but not declared in spec.variables",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, validationTestDashboardYaml, tc.old)
+			diags := validateDashboardForTest(t, strings.Replace(validationTestDashboardYaml, tc.old, tc.new, 1))
+
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			errDiag := diags.Errors()[0]
+			assert.Equal(t, tc.expectSummary, errDiag.Summary())
+			assert.Contains(t, errDiag.Detail(), tc.expectDetail)
+			withPath, ok := errDiag.(diag.DiagnosticWithPath)
+			require.True(t, ok, "diagnostic should be attributed to dashboard_yaml")
+			assert.Equal(t, path.Root("dashboard_yaml"), withPath.Path())
+		})
+	}
+}
+
+// TestValidateDashboardYAML_UnknownKinds checks that kinds the provider does
+// not know are reported as warnings, so that plugins the Dash0 API accepts
+// but the provider has not learned about do not fail the plan.
+func TestValidateDashboardYAML_UnknownKinds(t *testing.T) {
+	cases := []struct {
+		name          string
+		old           string
+		new           string
+		expectSummary string
+		expectDetail  string
+	}{
+		{
+			name:          "unknown panel plugin kind",
+			old:           "kind: TimeSeriesChart",
+			new:           "kind: TimeSeriesGraph",
+			expectSummary: "Unknown panel plugin kind",
+			expectDetail:  `spec.panels.requests.spec.plugin.kind (line 43): "TimeSeriesGraph" is not a panel plugin kind the provider knows`,
+		},
+		{
+			name:          "unknown query kind",
+			old:           "          - kind: TimeSeriesQuery\n            spec:\n              plugin:\n                kind: PrometheusTimeSeriesQuery\n                spec:\n                  query: sum",
+			new:           "          - kind: MetricsQuery\n            spec:\n              plugin:\n                kind: PrometheusTimeSeriesQuery\n                spec:\n                  query: sum",
+			expectSummary: "Unknown query kind",
+			expectDetail:  `spec.panels.requests.spec.queries[0].kind (line 46): "MetricsQuery"`,
+		},
+		{
+			name:          "unknown variable kind",
+			old:           "kind: TextVariable",
+			new:           "kind: ConstantVariable",
+			expectSummary: "Unknown variable kind",
+			expectDetail:  `spec.variables[1].kind (line 20): "ConstantVariable"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, validationTestDashboardYaml, tc.old)
+			diags := validateDashboardForTest(t, strings.Replace(validationTestDashboardYaml, tc.old, tc.new, 1))
+
+			require.False(t, diags.HasError(), "diagnostics: %v", diags)
+			require.Equal(t, 1, diags.WarningsCount(), "diagnostics: %v", diags)
+			warning := diags.Warnings()[0]
+			assert.Equal(t, tc.expectSummary, warning.Summary())
+			assert.Contains(t, warning.Detail(), tc.expectDetail)
+			withPath, ok := warning.(diag.DiagnosticWithPath)
+			require.True(t, ok, "diagnostic should be attributed to dashboard_yaml")
+			assert.Equal(t, path.Root("dashboard_yaml"), withPath.Path())
+		})
+	}
+
+	t.Run("Dash0-specific kinds are known", func(t *testing.T) {
+		diags := validateDashboardForTest(t, strings.Replace(validationTestDashboardYaml, "kind: TimeSeriesChart", "kind: Dash0ServiceMap", 1))
+		assert.Empty(t, diags, "diagnostics: %v", diags)
+	})
+}
+
+func TestValidateDashboardYAML_HyphenatedVariable(t *testing.T) {
+	dashboard := strings.Replace(validationTestDashboardYaml, "name: service\n", "name: service-name\n", 1)
+	dashboard = strings.Replace(dashboard, `${service:regex}`, `${service-name:regex}`, 1)
+	diags := validateDashboardForTest(t, dashboard)
+
+	assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+}
+
+// TestValidateDashboardYAML_BareVariableFollowedByHyphen checks that a bare
+// $name ends at the hyphen, as in Perses, so "$service-api" references the
+// declared $service and "$1-canary" is a label_replace capture group.
+func TestValidateDashboardYAML_BareVariableFollowedByHyphen(t *testing.T) {
+	dashboard := strings.Replace(validationTestDashboardYaml, `${service:regex}`, `$service-api`, 1)
+	dashboard = strings.Replace(dashboard, `"$1"`, `"$1-canary"`, 1)
+	diags := validateDashboardForTest(t, dashboard)
+
+	assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+}
+
+func TestValidateDashboardYAML_OperatorEnvelope(t *testing.T) {
+	diags := validateDashboardForTest(t, `apiVersion: perses.dev/v1alpha2
+kind: PersesDashboard
+metadata:
+  name: checkout
+  namespace: monitoring
+spec:
+  config:
+    display:
+      name: Checkout
+    layouts:
+      - kind: Grid
+        spec:
+          items:
+            - content:
+                $ref: "#/spec/panels/missing"
+              x: 0
+              "y": 0
+              width: 12
+              height: 8
+    panels: {}
+`)
+
+	require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+	assert.Equal(t, "Unknown panel reference", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "spec.config.layouts[0].spec.items[0].content.$ref (line 15)")
+}
+
+func TestValidateDashboardYAML_InvalidYAML(t *testing.T) {
+	diags := validateDashboardForTest(t, "invalid: yaml: content: [")
+
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "Invalid YAML", diags.Errors()[0].Summary())
+}
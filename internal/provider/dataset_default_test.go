@@ -25,7 +25,6 @@ type datasetInheritanceCase struct {
 	newResource func() resource.Resource
 	yamlAttr    string
 	yamlValue   string
-	hasURL      bool
 	// mockSetup registers the Create<X>/Resolve<X> expectations for a Create
 	// call that is expected to use dataset as the resolved dataset.
 	mockSetup func(m *MockClient, dataset string)
@@ -37,10 +36,10 @@ var datasetInheritanceCases = []datasetInheritanceCase{
 		newResource: NewDashboardResource,
 		yamlAttr:    "dashboard_yaml",
 		yamlValue:   "kind: Dashboard\nmetadata:\n  name: system-overview\nspec:\n  title: System Overview",
-		hasURL:      true,
 		mockSetup: func(m *MockClient, dataset string) {
 			m.On("CreateDashboard", mock.Anything, mock.Anything, mock.Anything, dataset).Return(nil)
 			m.On("ResolveDashboard", mock.Anything, mock.Anything, dataset).Return("test-id", "", nil)
+			m.On("GetDashboard", mock.Anything, mock.Anything, dataset).Return("", nil)
 		},
 	},
 	{
@@ -58,10 +57,10 @@ spec:
         - alert: TestAlert
           expr: up == 0
           for: 5m`,
-		hasURL: true,
 		mockSetup: func(m *MockClient, dataset string) {
 			m.On("CreateCheckRule", mock.Anything, mock.Anything, mock.Anything, dataset).Return(nil)
 			m.On("ResolveCheckRule", mock.Anything, mock.Anything, dataset).Return("test-id", "", nil)
+			m.On("GetCheckRule", mock.Anything, mock.Anything, dataset).Return("", nil)
 		},
 	},
 	{
@@ -78,10 +77,10 @@ spec:
       rules:
         - record: test_metric
           expr: sum(rate(http_requests_total[5m]))`,
-		hasURL: false,
 		mockSetup: func(m *MockClient, dataset string) {
 			m.On("CreateRecordingRule", mock.Anything, mock.Anything, mock.Anything, dataset).Return(nil)
 			m.On("ResolveRecordingRule", mock.Anything, mock.Anything, dataset).Return("test-id", nil)
+			m.On("GetRecordingRule", mock.Anything, mock.Anything, dataset).Return("", nil)
 		},
 	},
 	{
@@ -99,10 +98,10 @@ spec:
     - key: "k8s.namespace.name"
       operator: "is"
       value: "kube-system"`,
-		hasURL: false,
 		mockSetup: func(m *MockClient, dataset string) {
 			m.On("CreateSpamFilter", mock.Anything, mock.Anything, mock.Anything, dataset).Return(nil)
 			m.On("ResolveSpamFilter", mock.Anything, mock.Anything, dataset).Return("test-id", nil)
+			m.On("GetSpamFilter", mock.Anything, mock.Anything, dataset).Return("", nil)
 		},
 	},
 	{
@@ -120,10 +119,10 @@ spec:
     spec:
       request:
         url: https://www.example.com`,
-		hasURL: true,
 		mockSetup: func(m *MockClient, dataset string) {
 			m.On("CreateSyntheticCheck", mock.Anything, mock.Anything, mock.Anything, dataset).Return(nil)
 			m.On("ResolveSyntheticCheck", mock.Anything, mock.Anything, dataset).Return("test-id", "", nil)
+			m.On("GetSyntheticCheck", mock.Anything, mock.Anything, dataset).Return("", nil)
 		},
 	},
 	{
@@ -131,10 +130,10 @@ spec:
 		newResource: NewViewResource,
 		yamlAttr:    "view_yaml",
 		yamlValue:   "kind: View\nmetadata:\n  name: example-view\nspec:\n  title: Example View",
-		hasURL:      true,
 		mockSetup: func(m *MockClient, dataset string) {
 			m.On("CreateView", mock.Anything, mock.Anything, mock.Anything, dataset).Return(nil)
 			m.On("ResolveView", mock.Anything, mock.Anything, dataset).Return("test-id", "", nil)
+			m.On("GetView", mock.Anything, mock.Anything, dataset).Return("", nil)
 		},
 	},
 }
@@ -144,33 +143,22 @@ spec:
 // Optional+Computed attribute with no config value and no prior state, the
 // framework's planned value is unknown -- exactly as it would be for a real
 // `terraform plan` on a new resource that relies on the provider default.
-func buildOmittedDatasetCreatePlan(yamlAttr, yamlValue string, hasURL bool) tfsdk.Plan {
-	attrTypes := map[string]tftypes.Type{
-		"origin":  tftypes.String,
-		"id":      tftypes.String,
-		"dataset": tftypes.String,
-		yamlAttr:  tftypes.String,
-	}
-	attrValues := map[string]tftypes.Value{
-		"origin":  tftypes.NewValue(tftypes.String, ""),
-		"id":      tftypes.NewValue(tftypes.String, nil),
-		"dataset": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-		yamlAttr:  tftypes.NewValue(tftypes.String, yamlValue),
-	}
-	schemaAttrs := map[string]schema.Attribute{
-		"origin":  schema.StringAttribute{Computed: true},
-		"id":      schema.StringAttribute{Computed: true},
-		"dataset": schema.StringAttribute{Optional: true, Computed: true},
-		yamlAttr:  schema.StringAttribute{Required: true},
-	}
-	if hasURL {
-		attrTypes["url"] = tftypes.String
-		attrValues["url"] = tftypes.NewValue(tftypes.String, nil)
-		schemaAttrs["url"] = schema.StringAttribute{Computed: true}
+// All other attributes besides origin and the YAML attribute are null.
+func buildOmittedDatasetCreatePlan(r resource.Resource, yamlAttr, yamlValue string) tfsdk.Plan {
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	attrValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attrValues[name] = tftypes.NewValue(attrType, nil)
 	}
+	attrValues["origin"] = tftypes.NewValue(tftypes.String, "")
+	attrValues["dataset"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	attrValues[yamlAttr] = tftypes.NewValue(tftypes.String, yamlValue)
 	return tfsdk.Plan{
-		Raw:    tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, attrValues),
-		Schema: schema.Schema{Attributes: schemaAttrs},
+		Raw:    tftypes.NewValue(objectType, attrValues),
+		Schema: schemaResp.Schema,
 	}
 }
 
@@ -199,7 +187,7 @@ func TestDatasetScopedResources_Create_InheritsProviderDefaultDataset(t *testing
 			}, configureResp)
 			require.False(t, configureResp.Diagnostics.HasError(), "configure diagnostics: %v", configureResp.Diagnostics.Errors())
 
-			plan := buildOmittedDatasetCreatePlan(tc.yamlAttr, tc.yamlValue, tc.hasURL)
+			plan := buildOmittedDatasetCreatePlan(r, tc.yamlAttr, tc.yamlValue)
 			createReq := resource.CreateRequest{Plan: plan}
 			createResp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
 
@@ -227,6 +215,7 @@ var datasetSchemaCases = []datasetSchemaCase{
 	{"dashboard", NewDashboardResource},
 	{"check_rule", NewCheckRuleResource},
 	{"recording_rule", NewRecordingRuleResource},
+	{"prometheus_rule", NewPrometheusRuleResource},
 	{"spam_filter", NewSpamFilterResource},
 	{"synthetic_check", NewSyntheticCheckResource},
 	{"view", NewViewResource},
@@ -263,7 +252,7 @@ func runStringPlanModifiers(ctx context.Context, modifiers []planmodifier.String
 
 // TestDatasetScopedResources_DatasetPlanModifiers exercises the `dataset`
 // attribute's real plan modifiers -- UseStateForUnknown then RequiresReplace
-// -- for all six dataset-scoped resources, at the planning stage rather than
+// -- for every dataset-scoped resource, at the planning stage rather than
 // through Create. This is deliberately independent of any provider-level
 // default: plan modifiers never see the provider configuration, so a
 // resource's own prior state is the only thing an omitted `dataset` can pin
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// allowDatasetMoveAttribute returns the `allow_dataset_move` attribute of a
+// dataset-scoped asset resource, described by noun (for example "dashboard").
+//
+// The Dash0 API cannot move an asset between datasets, so a move creates the
+// asset in the new dataset under the same origin and then deletes it in the
+// old one. The server assigns the copy a new id.
+func allowDatasetMoveAttribute(noun string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether changing `dataset` moves the %[1]s to the new dataset in place instead of replacing the resource. The Dash0 API cannot move assets between datasets, so the provider creates the %[1]s in the new dataset under the same `origin` and then deletes it in the old one, in a single update; the server assigns the moved %[1]s a new `id`, which is known after the apply. If deleting the old %[1]s fails, the apply warns and leaves it in the old dataset. Defaults to `false`, so that a dataset change replaces the resource.", noun),
+		Optional:    true,
+	}
+}
+
+// datasetRequiresReplace returns the plan modifier of the `dataset` attribute
+// that forces replacement when the dataset changes, unless the configuration
+// sets `allow_dataset_move`.
+func datasetRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.Config.Raw.IsNull() {
+				resp.RequiresReplace = true
+				return
+			}
+			var allowMove types.Bool
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allow_dataset_move"), &allowMove)...)
+			resp.RequiresReplace = !allowMove.ValueBool()
+		},
+		"Changing the dataset forces replacement, unless allow_dataset_move is set.",
+		"Changing the dataset forces replacement, unless `allow_dataset_move` is set.",
+	)
+}
+
+// planDatasetMove plans a move of the asset to another dataset, which
+// datasetRequiresReplace lets through instead of replacing the resource: the
+// computed attributes the move changes, such as the id, become unknown, and
+// the resource identity addresses the new dataset.
+func planDatasetMove(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, computed ...string) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Config.Raw.IsNull() {
+		return
+	}
+	var allowMove types.Bool
+	var prior, planned, origin types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allow_dataset_move"), &allowMove)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("dataset"), &prior)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("dataset"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("origin"), &origin)...)
+	if resp.Diagnostics.HasError() || !allowMove.ValueBool() || planned.Equal(prior) {
+		return
+	}
+
+	for _, name := range computed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+	if resp.Identity != nil && !planned.IsUnknown() {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, datasetAssetIdentityModel{Dataset: planned, Origin: origin})...)
+	}
+}
+
+// moveToDataset moves an asset, whose definition in the API's format is body,
+// from one dataset to another: create writes it to the new dataset under the
+// same origin, then remove deletes it in the old one. It returns false, with
+// the error recorded in diags, if the asset could not be created in the new
+// dataset and nothing changed. A failed delete only warns, since the asset
+// Terraform manages is then in place.
+func moveToDataset(ctx context.Context, noun, origin, body, from, to string, create func(ctx context.Context, origin, body, dataset string) error, remove func(ctx context.Context, origin, dataset string) error, diags *diag.Diagnostics) bool {
+	tflog.Debug(ctx, fmt.Sprintf("Moving %s %s from dataset %s to dataset %s", noun, origin, from, to))
+	if err := create(ctx, origin, body, to); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to move %s to dataset %q, got error: %s", noun, to, err))
+		return false
+	}
+	if err := remove(ctx, origin, from); err != nil {
+		diags.AddWarning(
+			fmt.Sprintf("Unable to delete moved %s in dataset %q", noun, from),
+			fmt.Sprintf("The %s was created in dataset %q, but the copy in dataset %q could not be deleted and is no longer managed by Terraform. Delete it in the Dash0 UI. Error: %s", noun, to, from, err),
+		)
+	}
+	return true
+}
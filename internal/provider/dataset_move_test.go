@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// skipWithoutDatasetMove skips the test for resources without an
+// allow_dataset_move attribute, such as dash0_prometheus_rule.
+func skipWithoutDatasetMove(t *testing.T, r resource.Resource) {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	if _, ok := schemaResp.Schema.Attributes["allow_dataset_move"]; !ok {
+		t.Skip("no allow_dataset_move attribute")
+	}
+}
+
+// TestDatasetScopedResources_AllowDatasetMove complements
+// TestDatasetScopedResources_DatasetPlanModifiers: with allow_dataset_move
+// configured, an explicit dataset change no longer requires replacement.
+func TestDatasetScopedResources_AllowDatasetMove(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range datasetSchemaCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.newResource()
+			skipWithoutDatasetMove(t, r)
+			for _, allowMove := range []bool{true, false} {
+				config := resourcePlan(t, r, map[string]tftypes.Value{
+					"dataset":            tftypes.NewValue(tftypes.String, "new-dataset"),
+					"allow_dataset_move": tftypes.NewValue(tftypes.Bool, allowMove),
+				})
+				datasetAttr := config.Schema.(schema.Schema).Attributes["dataset"].(schema.StringAttribute)
+
+				_, requiresReplace := runStringPlanModifiers(ctx, datasetAttr.PlanModifiers, planmodifier.StringRequest{
+					Config:      tfsdk.Config{Raw: config.Raw, Schema: config.Schema},
+					State:       tfsdk.State{Raw: nonNullEmptyObject()},
+					Plan:        tfsdk.Plan{Raw: nonNullEmptyObject()},
+					ConfigValue: types.StringValue("new-dataset"),
+					PlanValue:   types.StringValue("new-dataset"),
+					StateValue:  types.StringValue("prior-dataset"),
+				})
+
+				assert.Equal(t, !allowMove, requiresReplace, "allow_dataset_move = %t", allowMove)
+			}
+		})
+	}
+}
+
+func TestPlanDatasetMove(t *testing.T) {
+	ctx := context.Background()
+	r := NewDashboardResource()
+	state := resourcePlan(t, r, map[string]tftypes.Value{
+		"origin":  tftypes.NewValue(tftypes.String, "tf_1"),
+		"id":      tftypes.NewValue(tftypes.String, "old-id"),
+		"url":     tftypes.NewValue(tftypes.String, "https://app.dash0.com/old"),
+		"dataset": tftypes.NewValue(tftypes.String, "prior-dataset"),
+	})
+	plan := resourcePlan(t, r, map[string]tftypes.Value{
+		"origin":             tftypes.NewValue(tftypes.String, "tf_1"),
+		"id":                 tftypes.NewValue(tftypes.String, "old-id"),
+		"url":                tftypes.NewValue(tftypes.String, "https://app.dash0.com/old"),
+		"dataset":            tftypes.NewValue(tftypes.String, "new-dataset"),
+		"allow_dataset_move": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Raw: plan.Raw, Schema: plan.Schema},
+		State:  tfsdk.State{Raw: state.Raw, Schema: state.Schema},
+		Plan:   plan,
+	}
+	resp := &resource.ModifyPlanResponse{Plan: plan, Identity: identity}
+	planDatasetMove(ctx, req, resp, "id", "url")
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	var planned dashboardModel
+	require.False(t, resp.Plan.Get(ctx, &planned).HasError())
+	assert.True(t, planned.ID.IsUnknown(), "the moved dashboard gets a new id")
+	assert.True(t, planned.URL.IsUnknown())
+	var plannedIdentity datasetAssetIdentityModel
+	require.False(t, resp.Identity.Get(ctx, &plannedIdentity).HasError())
+	assert.Equal(t, datasetAssetIdentityModel{Dataset: types.StringValue("new-dataset"), Origin: types.StringValue("tf_1")}, plannedIdentity)
+}
+
+func TestDashboardResource_UpdateMovesDataset(t *testing.T) {
+	ctx := context.Background()
+	mockClient := new(MockClient)
+	r := &DashboardResource{client: mockClient}
+	const dashboardYAML = "kind: Dashboard\nmetadata:\n  name: checkout\nspec:\n  display:\n    name: Checkout Overview\n"
+	state := resourcePlan(t, r, map[string]tftypes.Value{
+		"origin":         tftypes.NewValue(tftypes.String, "tf_1"),
+		"id":             tftypes.NewValue(tftypes.String, "old-id"),
+		"dataset":        tftypes.NewValue(tftypes.String, "prior-dataset"),
+		"dashboard_yaml": tftypes.NewValue(tftypes.String, dashboardYAML),
+	})
+	plan := resourcePlan(t, r, map[string]tftypes.Value{
+		"origin":             tftypes.NewValue(tftypes.String, "tf_1"),
+		"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"url":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"dataset":            tftypes.NewValue(tftypes.String, "new-dataset"),
+		"dashboard_yaml":     tftypes.NewValue(tftypes.String, dashboardYAML),
+		"allow_dataset_move": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	// The dashboard is created in the new dataset under its origin before it
+	// is deleted in the old one; UpdateDashboard is not called.
+	createCall := mockClient.On("CreateDashboard", mock.Anything, "tf_1", mock.Anything, "new-dataset").Return(nil)
+	mockClient.On("DeleteDashboard", mock.Anything, "tf_1", "prior-dataset").Return(nil).NotBefore(createCall)
+	mockClient.On("ResolveDashboard", mock.Anything, "tf_1", "new-dataset").Return("new-id", "https://app.dash0.com/new", nil)
+	mockClient.On("GetDashboard", mock.Anything, "tf_1", "new-dataset").Return("", nil)
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Update(ctx, resource.UpdateRequest{
+		State: tfsdk.State{Raw: state.Raw, Schema: state.Schema},
+		Plan:  plan,
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+	var result dashboardModel
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "tf_1", result.Origin.ValueString(), "the origin is preserved")
+	assert.Equal(t, "new-dataset", result.Dataset.ValueString())
+	assert.Equal(t, "new-id", result.ID.ValueString())
+	assert.Equal(t, "https://app.dash0.com/new", result.URL.ValueString())
+}
+
+func TestMoveToDataset(t *testing.T) {
+	ctx := context.Background()
+	created := func(context.Context, string, string, string) error { return nil }
+	deleted := func(context.Context, string, string) error { return nil }
+	failed := errors.New("dash0 api error: unavailable (status: 503)")
+
+	var diags diag.Diagnostics
+	assert.True(t, moveToDataset(ctx, "view", "tf_1", "{}", "a", "b", created, deleted, &diags))
+	assert.Empty(t, diags)
+
+	// Nothing was moved when the asset could not be created in the new dataset.
+	diags = nil
+	assert.False(t, moveToDataset(ctx, "view", "tf_1", "{}", "a", "b", func(context.Context, string, string, string) error { return failed }, func(context.Context, string, string) error {
+		t.Fatal("the view must not be deleted in the old dataset")
+		return nil
+	}, &diags))
+	require.Equal(t, 1, diags.ErrorsCount())
+
+	// The move succeeded when only the old copy could not be deleted.
+	diags = nil
+	assert.True(t, moveToDataset(ctx, "view", "tf_1", "{}", "a", "b", created, func(context.Context, string, string) error { return failed }, &diags))
+	assert.False(t, diags.HasError())
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, `Unable to delete moved view in dataset "a"`, diags.Warnings()[0].Summary())
+}
+
+// TestDatasetScopedResources_MutableIdentity checks that every resource with
+// allow_dataset_move declares that its identity may change.
+func TestDatasetScopedResources_MutableIdentity(t *testing.T) {
+	for _, tc := range datasetSchemaCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.newResource()
+			skipWithoutDatasetMove(t, r)
+			var metadataResp resource.MetadataResponse
+			r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, &metadataResp)
+			assert.True(t, metadataResp.ResourceBehavior.MutableIdentity)
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// datasetsAttribute returns the `datasets` attribute of a dataset-scoped asset
+// resource, described by noun (for example "dashboard"), which replicates the
+// asset into several datasets instead of writing it to a single `dataset`.
+func datasetsAttribute(noun string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Description: fmt.Sprintf("The identifiers of several datasets to write the %[1]s to, instead of the single `dataset`. The provider keeps a copy (replica) of the %[1]s in each of them, under an origin derived from the resource's `origin` and the dataset, and updates and deletes all of them together; adding or removing a dataset creates or deletes only that dataset's replica. Drift is detected in each replica independently, and a replica deleted outside Terraform is recreated by the next apply. The replicas' server-assigned identifiers are available in `dataset_ids`, while `dataset`, `id`, and the last-modified attributes are null. Conflicts with `dataset`, and switching between the two forces the resource to be recreated. `adopt_existing`, `allow_dataset_move`, and the provider's `conflict_detection` do not apply to replicated %[1]ss, which cannot be imported.", noun),
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.RequiresReplaceIf(
+				func(_ context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+				},
+				"Switching between dataset and datasets forces replacement.",
+				"Switching between `dataset` and `datasets` forces replacement.",
+			),
+		},
+	}
+}
+
+// datasetIDsAttribute returns the `dataset_ids` attribute, which maps each
+// dataset of `datasets` to the server-assigned id of the replica in it.
+func datasetIDsAttribute(noun string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("The server-assigned identifier of the %s's replica in each of `datasets`, keyed by dataset. Null unless `datasets` is set; a replica whose identifier could not be resolved is missing from the map.", noun),
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// datasetURLsAttribute returns the `dataset_urls` attribute, which maps each
+// dataset of `datasets` to the web app URL of the replica in it.
+func datasetURLsAttribute(noun string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("The URL to open the %s's replica in each of `datasets` in the Dash0 web app, keyed by dataset. Null unless `datasets` is set; a replica whose URL could not be derived is missing from the map.", noun),
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// validateDatasetReplicas rejects a configuration that sets both `dataset`
+// and `datasets`, or an empty `datasets`.
+func validateDatasetReplicas(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var dataset types.String
+	var datasets types.Set
+	diags.Append(config.GetAttribute(ctx, path.Root("dataset"), &dataset)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("datasets"), &datasets)...)
+	if diags.HasError() || datasets.IsNull() {
+		return
+	}
+	if !dataset.IsNull() {
+		diags.AddAttributeError(
+			path.Root("datasets"),
+			"Conflicting Dataset Attributes",
+			"Only one of `dataset` and `datasets` can be set.",
+		)
+		return
+	}
+	if !datasets.IsUnknown() && len(datasets.Elements()) == 0 {
+		diags.AddAttributeError(
+			path.Root("datasets"),
+			"Missing Datasets",
+			"`datasets` must contain at least one dataset. Omit it to use the single `dataset` instead.",
+		)
+	}
+}
+
+// planDatasetReplicas plans the attributes that depend on whether the asset is
+// replicated. For an asset with `datasets`, the single-dataset attributes,
+// `dataset` and the last-modified attributes plus computed, are null, and
+// `dataset_ids` and `dataset_urls` keep their state while the datasets do not
+// change. For any other asset, the latter two are null.
+func planDatasetReplicas(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, computed ...string) {
+	if req.Plan.Raw.IsNull() || req.Config.Raw.IsNull() {
+		return
+	}
+	maps := []string{"dataset_ids"}
+	if _, ok := req.Plan.Schema.GetAttributes()["dataset_urls"]; ok {
+		maps = append(maps, "dataset_urls")
+	}
+
+	var datasets types.Set
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("datasets"), &datasets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if datasets.IsNull() {
+		for _, name := range maps {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.MapNull(types.StringType))...)
+		}
+		return
+	}
+
+	for _, name := range append([]string{"dataset", "last_modified_at", "last_modified_by", "server_version"}, computed...) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringNull())...)
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var prior types.Set
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("datasets"), &prior)...)
+	if resp.Diagnostics.HasError() || !prior.Equal(datasets) {
+		return
+	}
+	for _, name := range maps {
+		var value types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &value)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// replicaOrigin returns the origin of the replica of the asset with origin in
+// dataset. Each replica has an origin of its own, so that they cannot be
+// mistaken for one another, or for an asset created outside Terraform.
+func replicaOrigin(origin, dataset string) string {
+	return origin + "_" + dataset
+}
+
+// datasetReplicas writes, reads and deletes the replicas of a dataset-scoped
+// asset with `datasets`, one per dataset, through the client methods of its
+// kind. noun names the asset kind in messages.
+type datasetReplicas struct {
+	noun    string
+	create  func(ctx context.Context, origin, body, dataset string) error
+	update  func(ctx context.Context, origin, body, dataset string) error
+	remove  func(ctx context.Context, origin, dataset string) error
+	get     func(ctx context.Context, origin, dataset string) (string, error)
+	resolve func(ctx context.Context, origin, dataset string) (id, url string, err error)
+}
+
+// apply writes body, the asset's definition in the API's format, to a replica
+// in each dataset of planned: it updates the replicas in the datasets of
+// prior, creates those missing, and deletes the replicas in datasets no
+// longer planned. ids and urls are the replicas' identifiers and URLs in
+// state, which are kept for the replicas that already existed and resolved
+// for the new ones (best-effort).
+//
+// The returned datasets are those holding a replica afterwards. They differ
+// from planned only if a write failed, as recorded in diags, in which case
+// they let the state reflect the replicas that do exist.
+func (rs datasetReplicas) apply(ctx context.Context, origin, body string, prior, planned types.Set, ids, urls types.Map, diags *diag.Diagnostics) (types.Set, types.Map, types.Map) {
+	existing := setElements(ctx, prior, diags)
+	wanted := setElements(ctx, planned, diags)
+	priorIDs := mapElements(ctx, ids, diags)
+	priorURLs := mapElements(ctx, urls, diags)
+	if diags.HasError() {
+		return prior, ids, urls
+	}
+
+	written := slices.Clone(existing)
+	newIDs, newURLs := map[string]string{}, map[string]string{}
+	failed := false
+	for _, dataset := range wanted {
+		if slices.Contains(existing, dataset) {
+			if err := rs.update(ctx, replicaOrigin(origin, dataset), body, dataset); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update %s in dataset %q, got error: %s", rs.noun, dataset, err))
+				failed = true
+				break
+			}
+			if id, ok := priorIDs[dataset]; ok {
+				newIDs[dataset] = id
+			}
+			if url, ok := priorURLs[dataset]; ok {
+				newURLs[dataset] = url
+			}
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Creating %s %s in dataset %s", rs.noun, origin, dataset))
+		if err := rs.create(ctx, replicaOrigin(origin, dataset), body, dataset); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to create %s in dataset %q, got error: %s", rs.noun, dataset, err))
+			failed = true
+			break
+		}
+		written = append(written, dataset)
+		id, url, err := rs.resolve(ctx, replicaOrigin(origin, dataset), dataset)
+		if err != nil {
+			diags.AddWarning(
+				fmt.Sprintf("Unable to resolve %s metadata", rs.noun),
+				fmt.Sprintf("The %s was saved successfully in dataset %q, but its id and URL could not be determined: %s", rs.noun, dataset, err),
+			)
+		}
+		if id != "" {
+			newIDs[dataset] = id
+		}
+		if url != "" {
+			newURLs[dataset] = url
+		}
+	}
+
+	if !failed {
+		for _, dataset := range existing {
+			if slices.Contains(wanted, dataset) {
+				continue
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Deleting %s %s in dataset %s", rs.noun, origin, dataset))
+			if err := rs.remove(ctx, replicaOrigin(origin, dataset), dataset); err != nil && !dash0.IsNotFound(err) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s in dataset %q, got error: %s", rs.noun, dataset, err))
+				continue
+			}
+			written = slices.DeleteFunc(written, func(d string) bool { return d == dataset })
+		}
+	}
+
+	// On failure, keep the identifiers of replicas that were not rewritten.
+	for _, dataset := range written {
+		if _, ok := newIDs[dataset]; !ok && priorIDs[dataset] != "" {
+			newIDs[dataset] = priorIDs[dataset]
+		}
+		if _, ok := newURLs[dataset]; !ok && priorURLs[dataset] != "" {
+			newURLs[dataset] = priorURLs[dataset]
+		}
+	}
+	return setOfStrings(ctx, written, diags), mapOfStrings(ctx, newIDs, diags), mapOfStrings(ctx, newURLs, diags)
+}
+
+// read reads the replica in each of datasets and hands it to refresh, which
+// compares it with the configured definition, so that drift is detected in
+// each replica independently. The diagnostics refresh records name the
+// replica's dataset. A replica deleted outside Terraform is dropped, with a
+// warning, from the returned datasets, ids and urls, so that the next apply
+// recreates it.
+func (rs datasetReplicas) read(ctx context.Context, origin string, datasets types.Set, ids, urls types.Map, refresh func(document string, diags *diag.Diagnostics), diags *diag.Diagnostics) (types.Set, types.Map, types.Map) {
+	existing := setElements(ctx, datasets, diags)
+	priorIDs := mapElements(ctx, ids, diags)
+	priorURLs := mapElements(ctx, urls, diags)
+	if diags.HasError() {
+		return datasets, ids, urls
+	}
+
+	var present []string
+	for _, dataset := range existing {
+		document, err := rs.get(ctx, replicaOrigin(origin, dataset), dataset)
+		if err != nil {
+			if dash0.IsNotFound(err) {
+				diags.AddWarning(
+					fmt.Sprintf("Missing %s replica", rs.noun),
+					fmt.Sprintf("The %s no longer exists in dataset %q, so it was removed from `datasets` in state. The next apply recreates it.", rs.noun, dataset),
+				)
+				delete(priorIDs, dataset)
+				delete(priorURLs, dataset)
+				continue
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read %s in dataset %q, got error: %s", rs.noun, dataset, err))
+			return datasets, ids, urls
+		}
+		present = append(present, dataset)
+
+		var replicaDiags diag.Diagnostics
+		refresh(document, &replicaDiags)
+		for _, d := range replicaDiags {
+			detail := fmt.Sprintf("In dataset %q: %s", dataset, d.Detail())
+			if d.Severity() == diag.SeverityError {
+				diags.AddError(d.Summary(), detail)
+			} else {
+				diags.AddWarning(d.Summary(), detail)
+			}
+		}
+	}
+	if ids.IsNull() {
+		priorIDs = nil
+	}
+	if urls.IsNull() {
+		priorURLs = nil
+	}
+	return setOfStrings(ctx, present, diags), mapOfStrings(ctx, priorIDs, diags), mapOfStrings(ctx, priorURLs, diags)
+}
+
+// deleteAll deletes the replica in each of datasets. It tries every dataset
+// even if one fails; a replica that no longer exists counts as deleted.
+func (rs datasetReplicas) deleteAll(ctx context.Context, origin string, datasets types.Set, diags *diag.Diagnostics) {
+	for _, dataset := range setElements(ctx, datasets, diags) {
+		if err := rs.remove(ctx, replicaOrigin(origin, dataset), dataset); err != nil && !dash0.IsNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s in dataset %q, got error: %s", rs.noun, dataset, err))
+		}
+	}
+}
+
+// setElements returns the elements of a set of strings, sorted so that
+// replicas are written in a stable order.
+func setElements(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	var elements []string
+	diags.Append(set.ElementsAs(ctx, &elements, false)...)
+	slices.Sort(elements)
+	return elements
+}
+
+// mapElements returns the elements of a map of strings, which is empty when
+// the map is null.
+func mapElements(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]string {
+	elements := map[string]string{}
+	if m.IsNull() || m.IsUnknown() {
+		return elements
+	}
+	diags.Append(m.ElementsAs(ctx, &elements, false)...)
+	return elements
+}
+
+// setOfStrings returns a set of strings holding elements.
+func setOfStrings(ctx context.Context, elements []string, diags *diag.Diagnostics) types.Set {
+	if elements == nil {
+		elements = []string{}
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, elements)
+	diags.Append(d...)
+	return set
+}
+
+// mapOfStrings returns a map of strings holding elements, or a null map for
+// nil elements.
+func mapOfStrings(ctx context.Context, elements map[string]string, diags *diag.Diagnostics) types.Map {
+	if elements == nil {
+		return types.MapNull(types.StringType)
+	}
+	m, d := types.MapValueFrom(ctx, types.StringType, elements)
+	diags.Append(d...)
+	return m
+}
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// datasetsValue returns a `datasets` value holding datasets.
+func datasetsValue(datasets ...string) tftypes.Value {
+	elements := make([]tftypes.Value, 0, len(datasets))
+	for _, dataset := range datasets {
+		elements = append(elements, tftypes.NewValue(tftypes.String, dataset))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+}
+
+// datasetMapValue returns a `dataset_ids` or `dataset_urls` value holding
+// elements.
+func datasetMapValue(elements map[string]string) tftypes.Value {
+	values := make(map[string]tftypes.Value, len(elements))
+	for key, value := range elements {
+		values[key] = tftypes.NewValue(tftypes.String, value)
+	}
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values)
+}
+
+func TestValidateDatasetReplicas(t *testing.T) {
+	tests := []struct {
+		name          string
+		values        map[string]tftypes.Value
+		expectSummary string
+	}{
+		{
+			name:   "dataset only",
+			values: map[string]tftypes.Value{"dataset": tftypes.NewValue(tftypes.String, "default")},
+		},
+		{
+			name:   "datasets only",
+			values: map[string]tftypes.Value{"datasets": datasetsValue("staging", "production")},
+		},
+		{
+			name: "both",
+			values: map[string]tftypes.Value{
+				"dataset":  tftypes.NewValue(tftypes.String, "default"),
+				"datasets": datasetsValue("staging"),
+			},
+			expectSummary: "Conflicting Dataset Attributes",
+		},
+		{
+			name:          "empty datasets",
+			values:        map[string]tftypes.Value{"datasets": datasetsValue()},
+			expectSummary: "Missing Datasets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := resourcePlan(t, NewViewResource(), tt.values)
+			resp := &resource.ValidateConfigResponse{}
+			NewViewResource().(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Raw: plan.Raw, Schema: plan.Schema},
+			}, resp)
+
+			if tt.expectSummary == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.expectSummary, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
+
+// TestDatasetScopedResources_Datasets checks that the resources that can be
+// replicated into several datasets have datasets, and that switching between
+// dataset and datasets replaces the resource while changing datasets does not.
+func TestDatasetScopedResources_Datasets(t *testing.T) {
+	ctx := context.Background()
+	for name, newResource := range map[string]func() resource.Resource{
+		"dashboard":       NewDashboardResource,
+		"view":            NewViewResource,
+		"check_rule":      NewCheckRuleResource,
+		"synthetic_check": NewSyntheticCheckResource,
+		"recording_rule":  NewRecordingRuleResource,
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := newResource()
+			plan := resourcePlan(t, r, nil)
+			datasetsAttr, ok := plan.Schema.GetAttributes()["datasets"]
+			require.True(t, ok, "missing datasets attribute")
+			_, ok = plan.Schema.GetAttributes()["dataset_ids"]
+			require.True(t, ok, "missing dataset_ids attribute")
+
+			modifiers := datasetsAttr.(schema.SetAttribute).PlanModifiers
+			for _, tt := range []struct {
+				state, plan     types.Set
+				requiresReplace bool
+			}{
+				{state: types.SetNull(types.StringType), plan: setOf("a"), requiresReplace: true},
+				{state: setOf("a"), plan: types.SetNull(types.StringType), requiresReplace: true},
+				{state: setOf("a"), plan: setOf("a", "b"), requiresReplace: false},
+			} {
+				req := planmodifier.SetRequest{
+					State:      tfsdk.State{Raw: nonNullEmptyObject()},
+					Plan:       tfsdk.Plan{Raw: nonNullEmptyObject()},
+					StateValue: tt.state,
+					PlanValue:  tt.plan,
+				}
+				resp := &planmodifier.SetResponse{PlanValue: tt.plan}
+				for _, m := range modifiers {
+					m.PlanModifySet(ctx, req, resp)
+				}
+				assert.Equal(t, tt.requiresReplace, resp.RequiresReplace, "state %v, plan %v", tt.state, tt.plan)
+			}
+		})
+	}
+}
+
+func TestDatasetReplicas_Apply(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	replicas := datasetReplicas{
+		noun: "view",
+		create: func(_ context.Context, origin, _, dataset string) error {
+			calls = append(calls, "create "+origin+" in "+dataset)
+			return nil
+		},
+		update: func(_ context.Context, origin, _, dataset string) error {
+			calls = append(calls, "update "+origin+" in "+dataset)
+			return nil
+		},
+		remove: func(_ context.Context, origin, dataset string) error {
+			calls = append(calls, "delete "+origin+" in "+dataset)
+			return nil
+		},
+		resolve: func(_ context.Context, _, dataset string) (string, string, error) {
+			return "id-" + dataset, "https://app.dash0.com/" + dataset, nil
+		},
+	}
+
+	var diags diag.Diagnostics
+	datasets, ids, urls := replicas.apply(ctx, "tf_1", "{}", setOf("a", "b"), setOf("b", "c"),
+		types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("id-old-a"), "b": types.StringValue("id-old-b")}),
+		types.MapNull(types.StringType), &diags)
+
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	assert.Equal(t, []string{"update tf_1_b in b", "create tf_1_c in c", "delete tf_1_a in a"}, calls)
+	assert.Equal(t, setOf("b", "c"), datasets)
+	assert.Equal(t, map[string]string{"b": "id-old-b", "c": "id-c"}, mapElements(ctx, ids, &diags), "the kept replica keeps its id")
+	assert.Equal(t, map[string]string{"c": "https://app.dash0.com/c"}, mapElements(ctx, urls, &diags))
+}
+
+func TestDatasetReplicas_ApplyCreateFails(t *testing.T) {
+	ctx := context.Background()
+	replicas := datasetReplicas{
+		noun: "view",
+		create: func(_ context.Context, _, _, dataset string) error {
+			if dataset == "c" {
+				return errors.New("dash0 api error: unavailable (status: 503)")
+			}
+			return nil
+		},
+		update: func(context.Context, string, string, string) error { return nil },
+		remove: func(context.Context, string, string) error {
+			t.Fatal("no replica may be deleted after a failed write")
+			return nil
+		},
+		resolve: func(_ context.Context, _, dataset string) (string, string, error) { return "id-" + dataset, "", nil },
+	}
+
+	var diags diag.Diagnostics
+	datasets, ids, _ := replicas.apply(ctx, "tf_1", "{}", setOf("a"), setOf("b", "c"), types.MapNull(types.StringType), types.MapNull(types.StringType), &diags)
+
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), `Unable to create view in dataset "c"`)
+	assert.Equal(t, setOf("a", "b"), datasets, "state keeps the replicas that exist")
+	assert.Equal(t, map[string]string{"b": "id-b"}, mapElements(ctx, ids, &diags))
+}
+
+func TestPlanDatasetReplicas(t *testing.T) {
+	ctx := context.Background()
+	r := NewDashboardResource()
+	ids := datasetMapValue(map[string]string{"a": "id-a"})
+	state := resourcePlan(t, r, map[string]tftypes.Value{
+		"origin":      tftypes.NewValue(tftypes.String, "tf_1"),
+		"datasets":    datasetsValue("a"),
+		"dataset_ids": ids,
+	})
+
+	for _, tt := range []struct {
+		name      string
+		datasets  tftypes.Value
+		expectIDs types.Map
+	}{
+		{name: "unchanged datasets", datasets: datasetsValue("a"), expectIDs: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("id-a")})},
+		{name: "added dataset", datasets: datasetsValue("a", "b"), expectIDs: types.MapUnknown(types.StringType)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := resourcePlan(t, r, map[string]tftypes.Value{
+				"origin":           tftypes.NewValue(tftypes.String, "tf_1"),
+				"dataset":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"datasets":         tt.datasets,
+				"dataset_ids":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"dataset_urls":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"last_modified_at": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			planDatasetReplicas(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: plan.Raw, Schema: plan.Schema},
+				State:  tfsdk.State{Raw: state.Raw, Schema: state.Schema},
+				Plan:   plan,
+			}, resp, "id", "url")
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var planned dashboardModel
+			require.False(t, resp.Plan.Get(ctx, &planned).HasError())
+			assert.True(t, planned.Dataset.IsNull(), "a replicated dashboard has no single dataset")
+			assert.True(t, planned.LastModifiedAt.IsNull())
+			assert.Equal(t, tt.expectIDs, planned.DatasetIDs)
+		})
+	}
+}
+
+func TestDashboardResource_ReadReplicas(t *testing.T) {
+	ctx := context.Background()
+	mockClient := new(MockClient)
+	r := &DashboardResource{client: mockClient}
+	const dashboardYAML = "kind: Dashboard\nmetadata:\n  name: checkout\nspec:\n  display:\n    name: Checkout Overview\n"
+	const driftedJSON = `{"kind":"Dashboard","metadata":{"name":"checkout"},"spec":{"display":{"name":"Checkout (edited)"}}}`
+	state := resourcePlan(t, r, map[string]tftypes.Value{
+		"origin":         tftypes.NewValue(tftypes.String, "tf_1"),
+		"dashboard_yaml": tftypes.NewValue(tftypes.String, dashboardYAML),
+		"datasets":       datasetsValue("production", "staging", "testing"),
+		"dataset_ids":    datasetMapValue(map[string]string{"production": "id-p", "staging": "id-s", "testing": "id-t"}),
+	})
+
+	mockClient.On("GetDashboard", mock.Anything, "tf_1_production", "production").Return(`{"kind":"Dashboard","metadata":{"name":"checkout"},"spec":{"display":{"name":"Checkout Overview"}}}`, nil)
+	mockClient.On("GetDashboard", mock.Anything, "tf_1_staging", "staging").Return(driftedJSON, nil)
+	mockClient.On("GetDashboard", mock.Anything, "tf_1_testing", "testing").Return("", &dash0.APIError{StatusCode: 404, Status: "404 Not Found"})
+
+	resp := &resource.ReadResponse{State: tfsdk.State{Raw: state.Raw, Schema: state.Schema}}
+	r.Read(ctx, resource.ReadRequest{State: tfsdk.State{Raw: state.Raw, Schema: state.Schema}}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+	var result dashboardModel
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, driftedJSON, result.DashboardYaml.ValueString(), "the drifted replica is reported")
+	assert.Equal(t, setOf("production", "staging"), result.Datasets, "the missing replica is dropped so that it is recreated")
+	assert.Equal(t, map[string]string{"production": "id-p", "staging": "id-s"}, mapElements(ctx, result.DatasetIDs, &resp.Diagnostics))
+
+	var details []string
+	for _, warning := range resp.Diagnostics.Warnings() {
+		details = append(details, warning.Detail())
+	}
+	require.Len(t, details, 2, "diagnostics: %v", resp.Diagnostics)
+	assert.Contains(t, details[0], `In dataset "staging": `)
+	assert.Contains(t, details[1], `no longer exists in dataset "testing"`)
+}
+
+func TestRecordingRuleResource_DeleteReplicas(t *testing.T) {
+	mockClient := new(MockClient)
+	r := &RecordingRuleResource{client: mockClient}
+	state := resourcePlan(t, r, map[string]tftypes.Value{
+		"origin":   tftypes.NewValue(tftypes.String, "tf_1"),
+		"datasets": datasetsValue("production", "staging"),
+	})
+
+	mockClient.On("DeleteRecordingRule", mock.Anything, "tf_1_production", "production").Return(nil)
+	// A replica that was already deleted counts as deleted.
+	mockClient.On("DeleteRecordingRule", mock.Anything, "tf_1_staging", "staging").Return(&dash0.APIError{StatusCode: 404, Status: "404 Not Found"})
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: tfsdk.State{Raw: state.Raw, Schema: state.Schema}}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+}
+
+// setOf returns a set of strings holding elements.
+func setOf(elements ...string) types.Set {
+	values := make([]attr.Value, 0, len(elements))
+	for _, element := range elements {
+		values = append(values, types.StringValue(element))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
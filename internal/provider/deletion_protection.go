@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute returns the `deletion_protection` attribute of
+// an asset resource, described by noun (for example "dashboard"). Unlike the
+// `prevent_destroy` lifecycle argument, the value is kept in state, so it
+// still protects the asset after its resource block is removed from the
+// configuration.
+func deletionProtectionAttribute(noun string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether Terraform refuses to delete the %[1]s. While `true`, destroying the resource, or removing it from the configuration, fails instead of deleting the %[1]s; set it to `false` and apply before deleting. Unlike the `prevent_destroy` lifecycle argument, the setting is kept in state and so also protects the %[1]s after its resource block was removed. The protection only applies to Terraform: the Dash0 API has no deletion lock, so the %[1]s can still be deleted in the Dash0 UI. If omitted, the provider-level `deletion_protection` default is used.", noun),
+		Optional:    true,
+		Computed:    true,
+	}
+}
+
+// planDeletionProtection plans the provider-level default for a resource
+// whose configuration omits `deletion_protection`, so that changing the
+// default shows up in the plan of every resource that inherits it.
+func planDeletionProtection(ctx context.Context, defaultValue bool, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.Config.Raw.IsNull() {
+		return
+	}
+	var configured types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), types.BoolValue(defaultValue))...)
+}
+
+// refuseProtectedDeletion reports an error and returns true when an asset
+// about to be deleted has `deletion_protection` set in state.
+func refuseProtectedDeletion(noun string, protected types.Bool, diags *diag.Diagnostics) bool {
+	if !protected.ValueBool() {
+		return false
+	}
+	diags.AddAttributeError(path.Root("deletion_protection"), fmt.Sprintf("%s is protected from deletion", sentenceCase(noun)),
+		fmt.Sprintf("The %[1]s has deletion_protection set, so Terraform does not delete it. "+
+			"To delete the %[1]s, set deletion_protection = false and apply before removing or destroying the resource. "+
+			"To stop managing the %[1]s without deleting it, use a removed block with destroy = false.", noun))
+	return true
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deletionProtectionResources are all resources with a `deletion_protection`
+// attribute.
+var deletionProtectionResources = map[string]func() resource.Resource{
+	"dashboard":            NewDashboardResource,
+	"view":                 NewViewResource,
+	"synthetic_check":      NewSyntheticCheckResource,
+	"check_rule":           NewCheckRuleResource,
+	"recording_rule":       NewRecordingRuleResource,
+	"prometheus_rule":      NewPrometheusRuleResource,
+	"spam_filter":          NewSpamFilterResource,
+	"notification_channel": NewNotificationChannelResource,
+	"team":                 NewTeamResource,
+	"team_membership":      NewTeamMembershipResource,
+}
+
+// deletionProtectionValue builds a raw value of the resource's schema in which
+// every attribute is null except origin, where the resource has one, and
+// deletion_protection.
+func deletionProtectionValue(t *testing.T, r resource.Resource, protection tftypes.Value) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	if _, ok := values["origin"]; ok {
+		values["origin"] = tftypes.NewValue(tftypes.String, "tf_protected")
+	}
+	values["deletion_protection"] = protection
+	return tftypes.NewValue(objectType, values), schemaResp
+}
+
+// TestDeletionProtection_DeleteRefused covers every resource's Delete: while
+// deletion_protection is set in state, nothing is deleted, so the mock client
+// fails the test on any call.
+func TestDeletionProtection_DeleteRefused(t *testing.T) {
+	for name, newResource := range deletionProtectionResources {
+		t.Run(name, func(t *testing.T) {
+			r := newResource()
+			mockClient := &MockClient{}
+			r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{
+				ProviderData: resourceProviderData{client: mockClient},
+			}, &resource.ConfigureResponse{})
+
+			raw, schemaResp := deletionProtectionValue(t, r, tftypes.NewValue(tftypes.Bool, true))
+			resp := &resource.DeleteResponse{State: tfsdk.State{Raw: raw, Schema: schemaResp.Schema}}
+			r.Delete(context.Background(), resource.DeleteRequest{State: resp.State}, resp)
+
+			require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics)
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "is protected from deletion")
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlanDeletionProtection(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured tftypes.Value
+		expected   types.Bool
+	}{
+		{
+			name:       "omitted inherits the provider default",
+			configured: tftypes.NewValue(tftypes.Bool, nil),
+			expected:   types.BoolValue(true),
+		},
+		{
+			name:       "configured value wins",
+			configured: tftypes.NewValue(tftypes.Bool, false),
+			expected:   types.BoolValue(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, schemaResp := deletionProtectionValue(t, NewViewResource(), tt.configured)
+			plan, _ := deletionProtectionValue(t, NewViewResource(), tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue))
+			if !tt.configured.IsNull() {
+				plan = config
+			}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema},
+				Plan:   tfsdk.Plan{Raw: plan, Schema: schemaResp.Schema},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			planDeletionProtection(context.Background(), true, req, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			var planned types.Bool
+			require.False(t, resp.Plan.GetAttribute(context.Background(), path.Root("deletion_protection"), &planned).HasError())
+			assert.Equal(t, tt.expected, planned)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	dash0Profiles "github.com/dash0hq/dash0-api-client-go/profiles"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	// Profile names the dash0 CLI profile to read credentials from when the
+	// environment does not supply them. If empty, the active profile is used.
+	Profile string
+	// Datasets are the datasets whose assets are exported. If empty, the
+	// dataset the provider would default to is used.
+	Datasets []string
+	// OutputDir is the directory the files are written to. It is created if it
+	// does not exist.
+	OutputDir string
+	// Version is the provider version reported to the API.
+	Version string
+}
+
+// Export writes the assets of every kind in the selected datasets, plus the
+// organization-scoped notification channels and teams, as Terraform
+// configuration in opts.OutputDir: a `<kind>.tf` file per asset kind with an
+// `import` block and a resource block per asset, the resource block reading
+// the asset's YAML from a sibling `<kind>_<label>.yaml` file. Progress is
+// reported to log.
+//
+// Credentials are resolved exactly as for a provider block that sets nothing
+// but `profile`: the DASH0_* environment variables first, then the dash0 CLI
+// profile, refreshing its OAuth access token as needed.
+func Export(ctx context.Context, opts ExportOptions, log io.Writer) error {
+	cfg := providerConfigModel{Profile: types.StringValue(opts.Profile)}
+	auth, err := resolveAuthInfo(ctx, &cfg)
+	if errors.Is(err, dash0Profiles.ErrReauthenticationRequired) {
+		return errors.New("the OAuth session for your dash0 CLI profile has expired; run `dash0 auth login` to re-authenticate")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to load credentials from dash0 CLI profile: %w", err)
+	}
+	if auth.url == "" || auth.token == "" {
+		return errors.New("no Dash0 URL and auth token configured; set DASH0_API_URL and DASH0_AUTH_TOKEN, or configure a dash0 CLI profile")
+	}
+
+	datasets := opts.Datasets
+	if len(datasets) == 0 {
+		datasets = []string{resolveDataset(ctx, &cfg, auth.profileCfg)}
+	}
+
+	dash0Client, err := client.NewDash0Client(auth.url, auth.tokenProvider(), auth.isOAuth, opts.Version, 3, "")
+	if err != nil {
+		return fmt.Errorf("unable to create the Dash0 API client: %w", err)
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0o755); err != nil {
+		return err
+	}
+	return exportAssets(ctx, dash0Client, datasets, opts.OutputDir, log)
+}
+
+// exportAssets does the work of Export once the client is set up. The asset
+// kinds are those of the list resources, and each asset is read through its
+// resource's ImportState, so an export holds exactly what importing the
+// generated blocks stores.
+func exportAssets(ctx context.Context, c client.Client, datasets []string, outputDir string, log io.Writer) error {
+	var total int
+	for _, newListResource := range (&dash0Provider{}).ListResources(ctx) {
+		lr := newListResource().(*assetListResource)
+		lr.providerData = resourceProviderData{client: c}
+
+		var schemaResp resource.SchemaResponse
+		lr.newResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		if schemaResp.Diagnostics.HasError() {
+			return diagnosticsError(schemaResp.Diagnostics.Errors())
+		}
+		emptyState := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+
+		scopes := datasets
+		if !lr.datasetScoped {
+			scopes = []string{""}
+		}
+		var config strings.Builder
+		labels := map[string]bool{}
+		var count int
+		for _, dataset := range scopes {
+			assets, err := lr.list(ctx, c, dataset)
+			if err != nil {
+				return fmt.Errorf("unable to list %s%s: %w", lr.plural, exportScope(dataset), err)
+			}
+			for _, asset := range assets {
+				importID := lr.importID(asset, dataset)
+				_, document, diags := lr.importAsset(ctx, importID, emptyState)
+				if diags.HasError() {
+					return fmt.Errorf("unable to read %s %q: %w", strings.ReplaceAll(lr.typeName, "_", " "), importID, diagnosticsError(diags.Errors()))
+				}
+				normalized, err := yamlKinds[lr.typeName].normalizeYAML(document)
+				if err != nil {
+					return fmt.Errorf("unable to normalize %s %q: %w", strings.ReplaceAll(lr.typeName, "_", " "), importID, err)
+				}
+
+				label := uniqueExportLabel(labels, asset, dataset)
+				yamlFile := lr.typeName + "_" + label + ".yaml"
+				if err := os.WriteFile(filepath.Join(outputDir, yamlFile), []byte(normalized+"\n"), 0o644); err != nil {
+					return err
+				}
+				writeExportBlocks(&config, lr, label, importID, dataset, yamlFile)
+				count++
+			}
+		}
+		if count == 0 {
+			continue
+		}
+
+		tfFile := lr.typeName + ".tf"
+		header := "# Generated by `terraform-provider-dash0 export`. Review the configuration,\n# then run `terraform apply` to import the assets.\n"
+		if err := os.WriteFile(filepath.Join(outputDir, tfFile), []byte(header+config.String()), 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(log, "Exported %d %s to %s\n", count, lr.plural, tfFile)
+		if lr.typeName == "notification_channel" {
+			fmt.Fprintln(log, "Note: the credentials of the exported notification channels are redacted; supply them through the write-only `secrets` attribute before you apply.")
+		}
+		total += count
+	}
+	fmt.Fprintf(log, "Exported %d assets to %s\n", total, outputDir)
+	return nil
+}
+
+// writeExportBlocks appends the `import` block and the resource block of one
+// asset to config.
+func writeExportBlocks(config *strings.Builder, lr *assetListResource, label, importID, dataset, yamlFile string) {
+	address := "dash0_" + lr.typeName + "." + label
+	fmt.Fprintf(config, "\nimport {\n  to = %s\n  id = %s\n}\n", address, hclString(importID))
+
+	yamlAttribute := lr.typeName + "_yaml"
+	fmt.Fprintf(config, "\nresource \"dash0_%s\" %q {\n", lr.typeName, label)
+	if lr.datasetScoped {
+		fmt.Fprintf(config, "  %-*s = %s\n", len(yamlAttribute), "dataset", hclString(dataset))
+	}
+	fmt.Fprintf(config, "  %s = file(\"${path.module}/%s\")\n}\n", yamlAttribute, yamlFile)
+}
+
+// uniqueExportLabel returns a resource label for asset that no other asset
+// of the same kind uses yet, derived from its dataset and display name (or
+// origin, when it has none), and records it in labels.
+func uniqueExportLabel(labels map[string]bool, asset client.Asset, dataset string) string {
+	name := asset.Name
+	if name == "" {
+		name = asset.Origin
+	}
+	if dataset != "" {
+		name = dataset + "_" + name
+	}
+
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+		} else if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	base := strings.TrimSuffix(b.String(), "_")
+	// Identifiers must not start with a digit.
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "_" + base
+	}
+
+	label := base
+	for i := 2; labels[label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	labels[label] = true
+	return label
+}
+
+// hclString renders s as an HCL string literal, escaping the template
+// sequences HCL would otherwise interpolate.
+func hclString(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "${", "$${", "%{", "%%{").Replace(s)
+	return `"` + s + `"`
+}
+
+// exportScope describes where the assets of a kind were listed, for error
+// messages; dataset is empty for the organization-scoped kinds.
+func exportScope(dataset string) string {
+	if dataset == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in dataset %q", dataset)
+}
+
+// diagnosticsError joins the summaries and details of diags into an error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	messages := make([]string, 0, len(diags))
+	for _, d := range diags {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
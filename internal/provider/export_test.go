@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// exportMockClient returns a client that lists no assets of any kind.
+func exportMockClient() *MockClient {
+	mockClient := &MockClient{}
+	for _, method := range []string{"ListDashboards", "ListSyntheticChecks", "ListViews", "ListCheckRules", "ListRecordingRules", "ListSpamFilters"} {
+		mockClient.On(method, mock.Anything, mock.Anything).Return([]client.Asset{}, nil).Maybe()
+	}
+	for _, method := range []string{"ListNotificationChannels", "ListTeams"} {
+		mockClient.On(method, mock.Anything).Return([]client.Asset{}, nil).Maybe()
+	}
+	return mockClient
+}
+
+func TestExportAssets(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListDashboards", mock.Anything, "production").Return([]client.Asset{
+		{Origin: "tf_checkout", ID: "11111111-1111-1111-1111-111111111111", Name: "Checkout Service"},
+		{Origin: "22222222-2222-2222-2222-222222222222", ID: "22222222-2222-2222-2222-222222222222", Name: "Checkout service"},
+	}, nil)
+	mockClient.On("GetDashboard", mock.Anything, "tf_checkout", "production").Return(`{"kind":"PersesDashboard","metadata":{"name":"checkout","createdAt":"2024-01-01T00:00:00Z","labels":{"dash0.com/origin":"tf_checkout"}},"spec":{"display":{"name":"Checkout Service"}}}`, nil)
+	mockClient.On("ResolveDashboard", mock.Anything, "tf_checkout", "production").Return("", "", nil)
+	mockClient.On("GetDashboard", mock.Anything, "22222222-2222-2222-2222-222222222222", "production").Return(`{"kind":"PersesDashboard","spec":{"display":{"name":"Checkout service"}}}`, nil)
+	mockClient.On("ResolveDashboard", mock.Anything, "22222222-2222-2222-2222-222222222222", "production").Return("", "", nil)
+	mockClient.On("ListTeams", mock.Anything).Return([]client.Asset{
+		{Origin: "tf_backend", ID: "00000000-0000-0000-0000-000000000001", Name: "Backend Team"},
+	}, nil)
+	mockClient.On("GetTeam", mock.Anything, "tf_backend").Return(`{"kind":"Dash0Team","metadata":{"name":"backend-team"},"spec":{"display":{"name":"Backend Team"}}}`, nil)
+	mockClient.On("ResolveTeam", mock.Anything, "tf_backend").Return("00000000-0000-0000-0000-000000000001", nil)
+	mockClient.ExpectedCalls = append(mockClient.ExpectedCalls, exportMockClient().ExpectedCalls...)
+
+	outputDir := t.TempDir()
+	var log bytes.Buffer
+	require.NoError(t, exportAssets(context.Background(), mockClient, []string{"production"}, outputDir, &log))
+
+	dashboards, err := os.ReadFile(filepath.Join(outputDir, "dashboard.tf"))
+	require.NoError(t, err)
+	assert.Contains(t, string(dashboards), `
+import {
+  to = dash0_dashboard.production_checkout_service
+  id = "production,tf_checkout"
+}
+
+resource "dash0_dashboard" "production_checkout_service" {
+  dataset        = "production"
+  dashboard_yaml = file("${path.module}/dashboard_production_checkout_service.yaml")
+}
+`)
+	// The second dashboard shares the display name, so its label is made unique.
+	assert.Contains(t, string(dashboards), `
+import {
+  to = dash0_dashboard.production_checkout_service_2
+  id = "production,22222222-2222-2222-2222-222222222222"
+}
+`)
+
+	document, err := os.ReadFile(filepath.Join(outputDir, "dashboard_production_checkout_service.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, `metadata:
+  name: checkout
+spec:
+  display:
+    name: Checkout Service
+`, string(document))
+
+	teams, err := os.ReadFile(filepath.Join(outputDir, "team.tf"))
+	require.NoError(t, err)
+	assert.Contains(t, string(teams), `
+resource "dash0_team" "backend_team" {
+  team_yaml = file("${path.module}/team_backend_team.yaml")
+}
+`)
+	assert.FileExists(t, filepath.Join(outputDir, "team_backend_team.yaml"))
+
+	// Kinds without assets get no file.
+	assert.NoFileExists(t, filepath.Join(outputDir, "view.tf"))
+	assert.Contains(t, log.String(), "Exported 3 assets")
+}
+
+func TestExportAssets_ListError(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListDashboards", mock.Anything, "production").Return(nil, errors.New("dash0 api error: forbidden (status: 403)"))
+	mockClient.ExpectedCalls = append(mockClient.ExpectedCalls, exportMockClient().ExpectedCalls...)
+
+	err := exportAssets(context.Background(), mockClient, []string{"production"}, t.TempDir(), &bytes.Buffer{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unable to list dashboards in dataset "production"`)
+}
+
+func TestUniqueExportLabel(t *testing.T) {
+	labels := map[string]bool{}
+	assert.Equal(t, "default_checkout_overview", uniqueExportLabel(labels, client.Asset{Name: "Checkout — Overview!"}, "default"))
+	assert.Equal(t, "default_checkout_overview_2", uniqueExportLabel(labels, client.Asset{Name: "checkout overview"}, "default"))
+	assert.Equal(t, "tf_backend", uniqueExportLabel(labels, client.Asset{Origin: "tf_backend"}, ""))
+	assert.Equal(t, "_42_team", uniqueExportLabel(labels, client.Asset{Name: "42 Team"}, ""))
+}
+
+func TestHCLString(t *testing.T) {
+	assert.Equal(t, `"prod,tf_a"`, hclString("prod,tf_a"))
+	assert.Equal(t, `"a \"b\" $${c} %%{d} \\e"`, hclString(`a "b" ${c} %{d} \e`))
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &GrafanaToDashboardFunction{}
+
+// grafanaConversionAttrTypes is the object type grafana_to_dashboard returns.
+var grafanaConversionAttrTypes = map[string]attr.Type{
+	"yaml":     types.StringType,
+	"warnings": types.ListType{ElemType: types.StringType},
+}
+
+// NewGrafanaToDashboardFunction is a helper function to simplify the provider
+// implementation.
+func NewGrafanaToDashboardFunction() function.Function {
+	return &GrafanaToDashboardFunction{}
+}
+
+// GrafanaToDashboardFunction converts a Grafana dashboard into the Perses
+// dashboard dash0_dashboard accepts.
+type GrafanaToDashboardFunction struct{}
+
+func (f *GrafanaToDashboardFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "grafana_to_dashboard"
+}
+
+func (f *GrafanaToDashboardFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a Grafana dashboard into a Dash0 dashboard",
+		MarkdownDescription: "Converts a Grafana dashboard JSON model, as exported from Grafana or returned by its HTTP API, " +
+			"into the Perses dashboard document `dash0_dashboard` accepts in `dashboard_yaml`. Time series (and legacy " +
+			"graph), stat (and singlestat), gauge, table and text panels are converted together with their PromQL " +
+			"queries; rows become separate, optionally collapsed, grid layouts, and grid positions carry over unchanged. " +
+			"Query variables using `label_values()` or `label_names()`, and custom, interval, textbox and constant " +
+			"variables are converted.\n\n" +
+			"Nothing is dropped silently. The result is an object with the following attributes:\n\n" +
+			"- `yaml` - The PersesDashboard document. Panels of other types are replaced by a Markdown panel at the " +
+			"same position.\n" +
+			"- `warnings` - A list describing every panel, query, variable and setting that could not be converted. " +
+			"Surface it with a `check` block, whose failed assertions Terraform reports as warnings.\n\n" +
+			"Provider-defined functions require Terraform 1.8 or later.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "The Grafana dashboard JSON model, either the dashboard itself or wrapped in a `dashboard` attribute.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: grafanaConversionAttrTypes,
+		},
+	}
+}
+
+func (f *GrafanaToDashboardFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var grafanaJSON string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &grafanaJSON))
+	if resp.Error != nil {
+		return
+	}
+
+	conversion, err := converter.ConvertGrafanaDashboard(grafanaJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to convert the Grafana dashboard: %s", err))
+		return
+	}
+
+	warnings := make([]attr.Value, len(conversion.Warnings))
+	for i, warning := range conversion.Warnings {
+		warnings[i] = types.StringValue(warning)
+	}
+	result, diags := types.ObjectValue(grafanaConversionAttrTypes, map[string]attr.Value{
+		"yaml":     types.StringValue(conversion.YAML),
+		"warnings": types.ListValueMust(types.StringType, warnings),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
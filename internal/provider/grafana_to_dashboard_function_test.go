@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runGrafanaToDashboard(grafanaJSON string) *function.RunResponse {
+	return runFunction(&GrafanaToDashboardFunction{}, types.ObjectUnknown(grafanaConversionAttrTypes), grafanaJSON)
+}
+
+func TestGrafanaToDashboardFunction_Metadata(t *testing.T) {
+	resp := &function.MetadataResponse{}
+	(&GrafanaToDashboardFunction{}).Metadata(context.Background(), function.MetadataRequest{}, resp)
+	assert.Equal(t, "grafana_to_dashboard", resp.Name)
+}
+
+func TestGrafanaToDashboardFunction_Run(t *testing.T) {
+	grafanaJSON, err := os.ReadFile("../converter/testdata/grafana_dashboard.json")
+	require.NoError(t, err)
+
+	resp := runGrafanaToDashboard(string(grafanaJSON))
+	require.Nil(t, resp.Error)
+
+	var result struct {
+		YAML     string   `tfsdk:"yaml"`
+		Warnings []string `tfsdk:"warnings"`
+	}
+	require.False(t, resp.Result.Value().(types.Object).As(context.Background(), &result, basetypes.ObjectAsOptions{}).HasError())
+
+	// The converted dashboard passes the same offline validation as a
+	// hand-written dashboard_yaml.
+	var diags diag.Diagnostics
+	validateDashboardYAML(result.YAML, path.Root("dashboard_yaml"), &diags)
+	assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+
+	assert.Len(t, result.Warnings, 3)
+}
+
+func TestGrafanaToDashboardFunction_Run_NoWarnings(t *testing.T) {
+	resp := runGrafanaToDashboard(`{"title": "Empty", "panels": []}`)
+	require.Nil(t, resp.Error)
+
+	warnings := resp.Result.Value().(types.Object).Attributes()["warnings"]
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{}), warnings)
+}
+
+func TestGrafanaToDashboardFunction_Run_Invalid(t *testing.T) {
+	resp := runGrafanaToDashboard(`{"title": "Overview"}`)
+
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Text, "Unable to convert the Grafana dashboard")
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// datasetAssetIdentityModel is the resource identity of the assets that belong
+// to a dataset: the pair the API addresses them by, and the same pair the
+// legacy 'dataset,origin' import ID spells out.
+type datasetAssetIdentityModel struct {
+	Dataset types.String `tfsdk:"dataset"`
+	Origin  types.String `tfsdk:"origin"`
+}
+
+// organizationAssetIdentityModel is the resource identity of the assets that
+// belong to the organization rather than to a dataset, such as notification
+// channels and teams.
+type organizationAssetIdentityModel struct {
+	Origin types.String `tfsdk:"origin"`
+}
+
+// datasetAssetIdentitySchema returns the identity schema of a dataset-scoped
+// asset, described by noun (for example "dashboard").
+func datasetAssetIdentitySchema(noun string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"dataset": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("The identifier of the dataset the %s belongs to.", noun),
+			},
+			"origin": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("The origin of the %s, or its id when it was created outside of Terraform and carries no origin.", noun),
+			},
+		},
+	}
+}
+
+// organizationAssetIdentitySchema returns the identity schema of an
+// organization-scoped asset, described by noun (for example "team").
+func organizationAssetIdentitySchema(noun string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"origin": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("The origin of the %s, or its id when it was created outside of Terraform and carries no origin.", noun),
+			},
+		},
+	}
+}
+
+// setDatasetAssetIdentity records the identity of a dataset-scoped asset.
+// identity is nil when the request carries no identity, which is the case for
+// Terraform versions predating resource identity.
+func setDatasetAssetIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, dataset, origin types.String, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	diags.Append(identity.Set(ctx, datasetAssetIdentityModel{Dataset: dataset, Origin: origin})...)
+}
+
+// setOrganizationAssetIdentity records the identity of an organization-scoped
+// asset; see setDatasetAssetIdentity.
+func setOrganizationAssetIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, origin types.String, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	diags.Append(identity.Set(ctx, organizationAssetIdentityModel{Origin: origin})...)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testIdentity returns a resource identity of the given schema holding values.
+func testIdentity(schema identityschema.Schema, values map[string]string) *tfsdk.ResourceIdentity {
+	ctx := context.Background()
+	attributes := map[string]tftypes.Value{}
+	for name := range schema.Attributes {
+		var value interface{}
+		if v, ok := values[name]; ok {
+			value = v
+		}
+		attributes[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	return &tfsdk.ResourceIdentity{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), attributes),
+	}
+}
+
+// TestDashboardResource_ImportState_Identity covers an `import` block using
+// `identity`: the asset is fetched by the identity's dataset and origin, and
+// the identity is handed back to Terraform with the imported state.
+func TestDashboardResource_ImportState_Identity(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &DashboardResource{client: mockClient}
+	mockClient.On("GetDashboard", mock.Anything, "tf_checkout", "production").Return(`{"kind":"PersesDashboard"}`, nil)
+	mockClient.On("ResolveDashboard", mock.Anything, "tf_checkout", "production").Return("", "", nil)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	identitySchema := datasetAssetIdentitySchema("dashboard")
+	resp := &resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		Identity: testIdentity(identitySchema, nil),
+	}
+
+	r.ImportState(ctx, resource.ImportStateRequest{
+		Identity: testIdentity(identitySchema, map[string]string{"dataset": "production", "origin": "tf_checkout"}),
+	}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state dashboardModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "production", state.Dataset.ValueString())
+	assert.Equal(t, "tf_checkout", state.Origin.ValueString())
+
+	var identity datasetAssetIdentityModel
+	require.False(t, resp.Identity.Get(ctx, &identity).HasError())
+	assert.Equal(t, "production", identity.Dataset.ValueString())
+	assert.Equal(t, "tf_checkout", identity.Origin.ValueString())
+	mockClient.AssertExpectations(t)
+}
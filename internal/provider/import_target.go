@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Prefixes of the import ID references that are resolved through the list
+// endpoints rather than used as the asset's origin.
+const (
+	importByNamePrefix = "name="
+	importByIDPrefix   = "id="
+)
+
+// datasetImportTarget returns the dataset and origin a dataset-scoped import
+// addresses: the resource identity of an `import` block using `identity`, or
+// else an import ID of the form 'dataset,origin', 'dataset,id=<id>' or
+// 'dataset,name=<display name>'. The latter two are resolved to the asset's
+// origin through list, and noun names the asset kind in error messages. ok is
+// false, with the problem recorded in diags, when no single asset is
+// addressed.
+func datasetImportTarget(ctx context.Context, req resource.ImportStateRequest, noun string, list func(ctx context.Context, dataset string) ([]client.Asset, error), diags *diag.Diagnostics) (dataset, origin string, ok bool) {
+	if req.ID == "" && req.Identity != nil {
+		var identity datasetAssetIdentityModel
+		diags.Append(req.Identity.Get(ctx, &identity)...)
+		if diags.HasError() {
+			return "", "", false
+		}
+		return identity.Dataset.ValueString(), identity.Origin.ValueString(), true
+	}
+
+	// Display names may contain commas, so only the first one separates the
+	// dataset.
+	idParts := strings.SplitN(req.ID, ",", 2)
+	if len(idParts) != 2 || (!strings.HasPrefix(idParts[1], importByNamePrefix) && strings.Contains(idParts[1], ",")) {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'dataset,origin', 'dataset,id=<id>' or 'dataset,name=<display name>'. Got: %s", req.ID),
+		)
+		return "", "", false
+	}
+
+	dataset = idParts[0]
+	origin, ok = resolveImportReference(ctx, idParts[1], fmt.Sprintf("in dataset %q", dataset), noun, func(ctx context.Context) ([]client.Asset, error) {
+		return list(ctx, dataset)
+	}, diags)
+	return dataset, origin, ok
+}
+
+// organizationImportTarget returns the origin an organization-scoped import
+// addresses: the resource identity of an `import` block using `identity`, or
+// else an import ID that is the origin itself, 'id=<id>' or
+// 'name=<display name>'; see datasetImportTarget.
+func organizationImportTarget(ctx context.Context, req resource.ImportStateRequest, noun string, list func(ctx context.Context) ([]client.Asset, error), diags *diag.Diagnostics) (origin string, ok bool) {
+	if req.ID == "" && req.Identity != nil {
+		var identity organizationAssetIdentityModel
+		diags.Append(req.Identity.Get(ctx, &identity)...)
+		if diags.HasError() {
+			return "", false
+		}
+		return identity.Origin.ValueString(), true
+	}
+	return resolveImportReference(ctx, req.ID, "in the organization", noun, list, diags)
+}
+
+// resolveImportReference returns the origin of the asset reference names.
+// References other than 'id=<id>' and 'name=<display name>' are origins and
+// returned as they are; the others are looked up among the assets list
+// returns, scope describing where for error messages.
+func resolveImportReference(ctx context.Context, reference, scope, noun string, list func(ctx context.Context) ([]client.Asset, error), diags *diag.Diagnostics) (string, bool) {
+	id, byID := strings.CutPrefix(reference, importByIDPrefix)
+	name, byName := strings.CutPrefix(reference, importByNamePrefix)
+	if !byID && !byName {
+		return reference, true
+	}
+
+	assets, err := list(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %ss to resolve the import ID %q, got error: %s", noun, reference, err))
+		return "", false
+	}
+
+	if byID {
+		asset, ok := client.AssetByID(assets, id)
+		if !ok {
+			diags.AddError("Invalid Import ID", fmt.Sprintf("No %s with id %q exists %s.", noun, id, scope))
+			return "", false
+		}
+		return asset.Origin, true
+	}
+
+	var candidates []client.Asset
+	for _, asset := range assets {
+		if asset.Name == name {
+			candidates = append(candidates, asset)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		diags.AddError("Invalid Import ID", fmt.Sprintf("No %s named %q exists %s.", noun, name, scope))
+		return "", false
+	case 1:
+		return candidates[0].Origin, true
+	}
+
+	diags.AddError(
+		"Ambiguous Import ID",
+		fmt.Sprintf("%d %ss named %q exist %s. Import one of them by origin or id instead:%s", len(candidates), noun, name, scope, describeAssets(candidates)),
+	)
+	return "", false
+}
+
+// describeAssets lists the origins and ids of assets, one per line, for
+// error messages asking to pick one of them.
+func describeAssets(assets []client.Asset) string {
+	var list strings.Builder
+	for _, asset := range assets {
+		fmt.Fprintf(&list, "\n  - origin %q (id %q)", asset.Origin, asset.ID)
+	}
+	return list.String()
+}
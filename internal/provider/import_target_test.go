@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+func TestDatasetImportTarget(t *testing.T) {
+	ctx := context.Background()
+	identity := testIdentity(datasetAssetIdentitySchema("dashboard"), map[string]string{"dataset": "production", "origin": "tf_checkout"})
+
+	for name, req := range map[string]resource.ImportStateRequest{
+		"identity":  {Identity: identity},
+		"legacy ID": {ID: "production,tf_checkout"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			dataset, origin, ok := datasetImportTarget(ctx, req, "dashboard", nil, &diags)
+
+			require.True(t, ok, "diagnostics: %v", diags)
+			assert.Equal(t, "production", dataset)
+			assert.Equal(t, "tf_checkout", origin)
+		})
+	}
+
+	t.Run("invalid legacy ID", func(t *testing.T) {
+		var diags diag.Diagnostics
+		_, _, ok := datasetImportTarget(ctx, resource.ImportStateRequest{ID: "tf_checkout"}, "dashboard", nil, &diags)
+
+		assert.False(t, ok)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "'dataset,origin'")
+	})
+}
+
+func TestOrganizationImportTarget(t *testing.T) {
+	ctx := context.Background()
+	identity := testIdentity(organizationAssetIdentitySchema("team"), map[string]string{"origin": "tf_backend"})
+
+	for name, req := range map[string]resource.ImportStateRequest{
+		"identity":  {Identity: identity},
+		"legacy ID": {ID: "tf_backend"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			origin, ok := organizationImportTarget(ctx, req, "team", nil, &diags)
+
+			require.True(t, ok, "diagnostics: %v", diags)
+			assert.Equal(t, "tf_backend", origin)
+		})
+	}
+}
+
+// importTargetTestAssets lists the dashboards of the "production" dataset.
+func importTargetTestAssets(_ context.Context, dataset string) ([]client.Asset, error) {
+	if dataset != "production" {
+		return nil, errors.New("dash0 api error: not found (status: 404)")
+	}
+	return []client.Asset{
+		{Origin: "tf_checkout", ID: "11111111-1111-1111-1111-111111111111", Name: "Checkout, EU"},
+		{Origin: "22222222-2222-2222-2222-222222222222", ID: "22222222-2222-2222-2222-222222222222", Name: "Payments"},
+		{Origin: "tf_payments", ID: "33333333-3333-3333-3333-333333333333", Name: "Payments"},
+	}, nil
+}
+
+func TestDatasetImportTarget_Resolved(t *testing.T) {
+	for id, expectedOrigin := range map[string]string{
+		"production,name=Checkout, EU":                       "tf_checkout",
+		"production,id=11111111-1111-1111-1111-111111111111": "tf_checkout",
+		// UI-created assets carry no origin and are addressed by their id.
+		"production,id=22222222-2222-2222-2222-222222222222": "22222222-2222-2222-2222-222222222222",
+	} {
+		t.Run(id, func(t *testing.T) {
+			var diags diag.Diagnostics
+			dataset, origin, ok := datasetImportTarget(context.Background(), resource.ImportStateRequest{ID: id}, "dashboard", importTargetTestAssets, &diags)
+
+			require.True(t, ok, "diagnostics: %v", diags)
+			assert.Equal(t, "production", dataset)
+			assert.Equal(t, expectedOrigin, origin)
+		})
+	}
+}
+
+func TestDatasetImportTarget_Unresolved(t *testing.T) {
+	for id, expectedError := range map[string]string{
+		"production,name=Payments":                           "2 dashboards named \"Payments\" exist in dataset \"production\". Import one of them by origin or id instead:\n  - origin \"22222222-2222-2222-2222-222222222222\" (id \"22222222-2222-2222-2222-222222222222\")\n  - origin \"tf_payments\" (id \"33333333-3333-3333-3333-333333333333\")",
+		"production,name=Inventory":                          "No dashboard named \"Inventory\" exists in dataset \"production\".",
+		"production,id=44444444-4444-4444-4444-444444444444": "No dashboard with id \"44444444-4444-4444-4444-444444444444\" exists in dataset \"production\".",
+		"staging,name=Payments":                              "Unable to list dashboards",
+		"production,tf_a,tf_b":                               "Expected import ID in the format",
+	} {
+		t.Run(id, func(t *testing.T) {
+			var diags diag.Diagnostics
+			_, _, ok := datasetImportTarget(context.Background(), resource.ImportStateRequest{ID: id}, "dashboard", importTargetTestAssets, &diags)
+
+			assert.False(t, ok)
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), expectedError)
+		})
+	}
+}
+
+func TestOrganizationImportTarget_Resolved(t *testing.T) {
+	list := func(ctx context.Context) ([]client.Asset, error) {
+		return []client.Asset{{Origin: "tf_backend", ID: "00000000-0000-0000-0000-000000000001", Name: "Backend Team"}}, nil
+	}
+
+	var diags diag.Diagnostics
+	origin, ok := organizationImportTarget(context.Background(), resource.ImportStateRequest{ID: "name=Backend Team"}, "team", list, &diags)
+
+	require.True(t, ok, "diagnostics: %v", diags)
+	assert.Equal(t, "tf_backend", origin)
+}
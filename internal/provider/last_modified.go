@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// lastModifiedAttributes returns the computed attributes that expose the
+// server's record of the last change to an asset, described by noun (for
+// example "dashboard"). Drift detection ignores these fields in the YAML
+// document, so they are read from the API response separately.
+func lastModifiedAttributes(noun string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"last_modified_at": schema.StringAttribute{
+			Description: fmt.Sprintf("When the %s was last modified, as reported by the Dash0 API (`metadata.updatedAt`). Null if the API does not report it.", noun),
+			Computed:    true,
+		},
+		"last_modified_by": schema.StringAttribute{
+			Description: fmt.Sprintf("Who last modified the %s, in Terraform or in the Dash0 UI, as reported by the Dash0 API. Null if the API does not report it.", noun),
+			Computed:    true,
+		},
+		"server_version": schema.StringAttribute{
+			Description: fmt.Sprintf("The version of the %s the Dash0 API last returned (`metadata.version`), which the server increments on every change. Null if the API does not report it.", noun),
+			Computed:    true,
+		},
+	}
+}
+
+// lastModifiedValues returns the values of the last-modified attributes for
+// an asset as returned by the API.
+func lastModifiedValues(document string) (at, by, version types.String) {
+	modification := converter.LastModification(document)
+	return stringOrNull(modification.At), stringOrNull(modification.By), stringOrNull(modification.Version)
+}
+
+// readLastModified sets the last-modified attributes after a create or
+// update, from the asset as get now returns it, and returns that document.
+// Like the id and URL, they are best-effort metadata: a failed read only warns,
+// leaves them null and returns an empty document.
+func readLastModified(ctx context.Context, noun string, get func(ctx context.Context) (string, error), at, by, version *types.String, diags *diag.Diagnostics) string {
+	document, err := get(ctx)
+	if err != nil {
+		diags.AddWarning(
+			fmt.Sprintf("Unable to read %s modification metadata", noun),
+			fmt.Sprintf("The %s was saved successfully, but when and by whom it was last modified could not be determined: %s", noun, err),
+		)
+		*at, *by, *version = types.StringNull(), types.StringNull(), types.StringNull()
+		return ""
+	}
+	*at, *by, *version = lastModifiedValues(document)
+	return document
+}
+
+// warnChangedOutsideTerraform reports drift found by Read, naming who changed
+// the asset and when as far as the API reports it, so that the reviewer of
+// the plan, which reverts the change, knows whom to talk to first.
+func warnChangedOutsideTerraform(noun, document string, diags *diag.Diagnostics) {
+	detail := fmt.Sprintf("The %s was changed outside of Terraform", noun)
+	if description := converter.LastModification(document).Describe(); description != "" {
+		detail += ", " + description
+	}
+	detail += ". Applying the configuration as it is reverts the change; update the configuration to keep it."
+	diags.AddWarning(sentenceCase(noun)+" changed outside of Terraform", detail)
+}
+
+// sentenceCase capitalizes the first letter of a noun that starts a
+// diagnostic summary, e.g. "check rule" becomes "Check rule".
+func sentenceCase(noun string) string {
+	return strings.ToUpper(noun[:1]) + noun[1:]
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLastModified(t *testing.T) {
+	var diags diag.Diagnostics
+	at, by, version := types.StringUnknown(), types.StringUnknown(), types.StringUnknown()
+	readLastModified(context.Background(), "view", func(context.Context) (string, error) {
+		return "kind: Dash0View\nmetadata:\n  name: errors\n  updatedAt: 2024-05-02T14:02:31Z\n  labels:\n    dash0.com/version: \"4\"\n", nil
+	}, &at, &by, &version, &diags)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "2024-05-02T14:02:31Z", at.ValueString())
+	assert.True(t, by.IsNull(), "an editor the API does not report is null")
+	assert.Equal(t, "4", version.ValueString())
+}
+
+func TestReadLastModified_GetError(t *testing.T) {
+	var diags diag.Diagnostics
+	at, by, version := types.StringUnknown(), types.StringUnknown(), types.StringUnknown()
+	readLastModified(context.Background(), "view", func(context.Context) (string, error) {
+		return "", errors.New("dash0 api error: unavailable (status: 503)")
+	}, &at, &by, &version, &diags)
+
+	assert.False(t, diags.HasError(), "a failed read after a successful write must not fail the apply")
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, "Unable to read view modification metadata", diags.Warnings()[0].Summary())
+	assert.True(t, at.IsNull())
+	assert.True(t, by.IsNull())
+	assert.True(t, version.IsNull())
+}
+
+func TestWarnChangedOutsideTerraform(t *testing.T) {
+	var diags diag.Diagnostics
+	warnChangedOutsideTerraform("check rule", `{"metadata":{"updatedAt":"2024-05-02T14:02:31Z","dash0Extensions":{"updatedBy":"alice@example.com"}}}`, &diags)
+	warnChangedOutsideTerraform("check rule", `{"metadata":{}}`, &diags)
+
+	require.Equal(t, 2, diags.WarningsCount())
+	assert.Equal(t, "Check rule changed outside of Terraform", diags[0].Summary())
+	assert.Equal(t, "The check rule was changed outside of Terraform, by alice@example.com at 2024-05-02 14:02 UTC. Applying the configuration as it is reverts the change; update the configuration to keep it.", diags[0].Detail())
+	assert.Equal(t, "The check rule was changed outside of Terraform. Applying the configuration as it is reverts the change; update the configuration to keep it.", diags[1].Detail())
+}
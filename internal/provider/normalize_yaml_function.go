@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &NormalizeYAMLFunction{}
+
+// NewNormalizeYAMLFunction is a helper function to simplify the provider
+// implementation.
+func NewNormalizeYAMLFunction() function.Function {
+	return &NormalizeYAMLFunction{}
+}
+
+// NormalizeYAMLFunction returns the canonical form of a resource document
+// that drift detection compares.
+type NormalizeYAMLFunction struct{}
+
+func (f *NormalizeYAMLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_yaml"
+}
+
+func (f *NormalizeYAMLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a Dash0 resource YAML the way drift detection does",
+		MarkdownDescription: "Returns the canonical form of a Dash0 resource YAML (or JSON) document: the form the provider " +
+			"compares when it decides whether a resource has drifted. Server-managed fields such as `metadata.createdAt` " +
+			"and `metadata.version` are removed, metadata annotations that do not participate in drift detection are " +
+			"stripped, and keys are emitted in a stable order with two-space indentation.\n\n" +
+			"The result is suitable for hashing, for example as a `replace_triggered_by` input, or for comparing against " +
+			"a golden file. Two documents with the same normalized form are always equivalent; use `yaml_equivalent` to " +
+			"also treat equal durations such as `2m` and `2m0s` and reordered lists as the same.\n\n" +
+			"Provider-defined functions require Terraform 1.8 or later.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "kind",
+				MarkdownDescription: "The resource type without the `dash0_` prefix, one of " + yamlKindList() + ".",
+			},
+			function.StringParameter{
+				Name:                "yaml",
+				MarkdownDescription: "The resource document, in YAML or JSON.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeYAMLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kindName, document string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &kindName, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	kind, funcErr := lookupYAMLKind(kindName, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	normalized, err := kind.normalizeYAML(document)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to normalize the document: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runFunction calls f with the given string arguments and returns the
+// response, whose result is initialized to result.
+func runFunction(f function.Function, result attr.Value, args ...string) *function.RunResponse {
+	values := make([]attr.Value, len(args))
+	for i, arg := range args {
+		values[i] = types.StringValue(arg)
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(values)}, resp)
+	return resp
+}
+
+func TestNormalizeYAMLFunction_Metadata(t *testing.T) {
+	resp := &function.MetadataResponse{}
+	(&NormalizeYAMLFunction{}).Metadata(context.Background(), function.MetadataRequest{}, resp)
+	assert.Equal(t, "normalize_yaml", resp.Name)
+}
+
+func TestNormalizeYAMLFunction_Definition(t *testing.T) {
+	resp := &function.DefinitionResponse{}
+	(&NormalizeYAMLFunction{}).Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	require.Len(t, resp.Definition.Parameters, 2)
+	assert.Contains(t, resp.Definition.Parameters[0].GetMarkdownDescription(), "`synthetic_check`")
+	assert.IsType(t, function.StringReturn{}, resp.Definition.Return)
+}
+
+func TestNormalizeYAMLFunction_Run(t *testing.T) {
+	cases := []struct {
+		name     string
+		kind     string
+		document string
+		expected string
+	}{
+		{
+			name: "server-managed fields and annotations are removed",
+			kind: "recording_rule",
+			document: `kind: Dash0RecordingRule
+metadata:
+  name: cpu
+  version: 3
+  annotations:
+    note: hello
+spec:
+  expression: sum(rate(cpu[5m]))`,
+			expected: "metadata:\n  name: cpu\nspec:\n  expression: sum(rate(cpu[5m]))",
+		},
+		{
+			name:     "preserved annotations are kept",
+			kind:     "view",
+			document: `{"kind": "Dash0View", "metadata": {"name": "errors", "annotations": {"dash0.com/folder-path": "/team", "note": "x"}}, "spec": {"type": "spans"}}`,
+			expected: "metadata:\n  annotations:\n    dash0.com/folder-path: /team\n  name: errors\nspec:\n  type: spans",
+		},
+		{
+			name: "routing assets are ignored on notification channels",
+			kind: "notification_channel",
+			document: `metadata:
+  name: ops
+spec:
+  type: slack
+  routing:
+    assets:
+      - id: abc`,
+			expected: "metadata:\n  name: ops\nspec:\n  type: slack",
+		},
+		{
+			name: "top-level annotations are moved into the rules of a prometheus rule",
+			kind: "prometheus_rule",
+			document: `metadata:
+  name: rules
+  annotations:
+    dash0.com/sharing: team
+spec:
+  groups:
+    - name: g
+      rules:
+        - alert: High`,
+			expected: "metadata:\n  name: rules\nspec:\n  groups:\n    - name: g\n      rules:\n        - alert: High\n          annotations:\n            dash0.com/sharing: team",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := runFunction(&NormalizeYAMLFunction{}, types.StringUnknown(), tc.kind, tc.document)
+
+			require.Nil(t, resp.Error)
+			assert.Equal(t, types.StringValue(tc.expected), resp.Result.Value())
+		})
+	}
+}
+
+func TestNormalizeYAMLFunction_Run_Errors(t *testing.T) {
+	t.Run("unsupported kind", func(t *testing.T) {
+		resp := runFunction(&NormalizeYAMLFunction{}, types.StringUnknown(), "alert", "spec: {}")
+
+		require.NotNil(t, resp.Error)
+		require.NotNil(t, resp.Error.FunctionArgument)
+		assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+		assert.Contains(t, resp.Error.Text, `Unsupported kind "alert"`)
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		resp := runFunction(&NormalizeYAMLFunction{}, types.StringUnknown(), "dashboard", "spec: [")
+
+		require.NotNil(t, resp.Error)
+		require.NotNil(t, resp.Error.FunctionArgument)
+		assert.Equal(t, int64(1), *resp.Error.FunctionArgument)
+	})
+}
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -10,12 +12,12 @@ import (
 
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gopkg.in/yaml.v3"
@@ -79,6 +81,8 @@ var (
 	_ resource.Resource                   = &NotificationChannelResource{}
 	_ resource.ResourceWithConfigure      = &NotificationChannelResource{}
 	_ resource.ResourceWithImportState    = &NotificationChannelResource{}
+	_ resource.ResourceWithIdentity       = &NotificationChannelResource{}
+	_ resource.ResourceWithModifyPlan     = &NotificationChannelResource{}
 	_ resource.ResourceWithValidateConfig = &NotificationChannelResource{}
 )
 
@@ -90,6 +94,16 @@ func NewNotificationChannelResource() resource.Resource {
 // NotificationChannelResource is the resource implementation.
 type NotificationChannelResource struct {
 	client client.Client
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
 }
 
 // notificationChannelModel is the Terraform state model for a notification channel resource.
@@ -98,6 +112,35 @@ type notificationChannelModel struct {
 	ID                      types.String `tfsdk:"id"`
 	NotificationChannelYaml types.String `tfsdk:"notification_channel_yaml"`
 	URL                     types.String `tfsdk:"url"`
+	Secrets                 types.Map    `tfsdk:"secrets"`
+	SecretsVersion          types.Int64  `tfsdk:"secrets_version"`
+	Name                    types.String `tfsdk:"name"`
+	Frequency               types.String `tfsdk:"frequency"`
+	Routing                 types.Object `tfsdk:"routing"`
+	Slack                   types.Object `tfsdk:"slack"`
+	Teams                   types.Object `tfsdk:"teams"`
+	GoogleChat              types.Object `tfsdk:"google_chat"`
+	Discord                 types.Object `tfsdk:"discord"`
+	PagerDuty               types.Object `tfsdk:"pagerduty"`
+	Opsgenie                types.Object `tfsdk:"opsgenie"`
+	Email                   types.Object `tfsdk:"email"`
+	Webhook                 types.Object `tfsdk:"webhook"`
+	DeletionProtection      types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting           types.Bool   `tfsdk:"adopt_existing"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
+}
+
+// channelYAML returns the channel document to send to the API: the
+// notification_channel_yaml attribute, or the document rendered from the
+// typed attributes and blocks.
+func (m *notificationChannelModel) channelYAML(ctx context.Context, diags *diag.Diagnostics) string {
+	if m.usesTypedBlocks() {
+		return notificationChannelTypedDocument(ctx, m, diags)
+	}
+	return m.NotificationChannelYaml.ValueString()
 }
 
 // Configure adds the provider configured client to the resource.
@@ -116,15 +159,26 @@ func (r *NotificationChannelResource) Configure(_ context.Context, req resource.
 	}
 
 	r.client = data.client
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
 }
 
 func (r *NotificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_notification_channel"
 }
 
-// ValidateConfig surfaces warnings about config that the Dash0 API will not
-// honor. Currently this is limited to spec.routing.assets, which is discarded
-// on write and reflects only server-maintained back-references on read.
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the notification channel by.
+func (r *NotificationChannelResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = organizationAssetIdentitySchema("notification channel")
+}
+
+// ValidateConfig checks that the channel is configured either in YAML or
+// through the typed blocks, and surfaces warnings about config that the Dash0
+// API will not honor, currently spec.routing.assets, which is discarded on
+// write and reflects only server-maintained back-references on read. It also
+// rejects secrets whose fields are set in the YAML as well.
 func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var model notificationChannelModel
 	diags := req.Config.Get(ctx, &model)
@@ -132,10 +186,30 @@ func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	validateNotificationChannelTyped(&model, &resp.Diagnostics)
 	if model.NotificationChannelYaml.IsNull() || model.NotificationChannelYaml.IsUnknown() {
 		return
 	}
 	warnIfRoutingAssetsSet(model.NotificationChannelYaml.ValueString(), &resp.Diagnostics)
+
+	if model.Secrets.IsNull() || model.Secrets.IsUnknown() {
+		return
+	}
+	keys := slices.Sorted(maps.Keys(model.Secrets.Elements()))
+	validateNotificationChannelSecrets(model.NotificationChannelYaml.ValueString(), keys, &resp.Diagnostics)
+}
+
+// configuredSecrets reads the write-only `secrets` attribute, which is only
+// available in the configuration, never in the plan or state.
+func configuredSecrets(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) map[string]string {
+	var secrets types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("secrets"), &secrets)...)
+	if diags.HasError() || secrets.IsNull() || secrets.IsUnknown() {
+		return nil
+	}
+	var values map[string]string
+	diags.Append(secrets.ElementsAs(ctx, &values, false)...)
+	return values
 }
 
 func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -143,6 +217,8 @@ func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.Schem
 		Description: "Manages a Dash0 Notification Channel. Notification channels define how alerts are delivered to " +
 			"external systems such as Slack, PagerDuty, email, and webhooks. Notification channels are " +
 			"organization-level resources and are not scoped to a dataset.\n\n" +
+			"A channel is configured either as YAML in `notification_channel_yaml` or through `name` and one of the typed " +
+			"channel blocks, which validate each setting and mark credentials as sensitive.\n\n" +
 			"See [Send Alert Check Notifications](https://www.dash0.com/docs/dash0/monitoring/alerting/send-alert-check-notifications) " +
 			"and [Route Alert Check Notifications](https://www.dash0.com/docs/dash0/monitoring/alerting/route-alert-check-notifications) " +
 			"for more details.\n\n" +
@@ -173,10 +249,12 @@ func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.Schem
 					"synthetic check binds to this channel by id, and is discarded if supplied on write; bind a check rule by " +
 					"setting the `dash0.com/notification-channel-ids` annotation on the rule, or a synthetic check by setting " +
 					"`spec.notifications.channels` on the synthetic check. " +
-					"See [Send Alert Check Notifications](https://www.dash0.com/docs/dash0/monitoring/alerting/send-alert-check-notifications) for the available options.",
-				Required: true,
+					"Credentials are redacted from the channel as read back from the API, including on import: a Slack `webhookURL`, the `url` of Teams, Google Chat and Discord webhooks, a PagerDuty `key`, an Opsgenie `apiKey`, and a webhook's `auth.password` and `headers`. Changes made to them outside Terraform are therefore not detected. " +
+					"See [Send Alert Check Notifications](https://www.dash0.com/docs/dash0/monitoring/alerting/send-alert-check-notifications) for the available options. " +
+					"Conflicts with the typed attributes and blocks.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqualWith(notificationChannelAlwaysIgnoredFields),
+					yamlKinds["notification_channel"].planModifier(),
 				},
 			},
 			"url": schema.StringAttribute{
@@ -186,8 +264,39 @@ func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"secrets": schema.MapAttribute{
+				Description: "Credentials filled into the channel's `spec.config` when it is created or updated, such as a Slack `webhookURL`, a PagerDuty `key`, an Opsgenie `apiKey` or a webhook `auth.password`. " +
+					"Keys are dot-separated paths relative to `spec.config` and must not also be set in `notification_channel_yaml`. " +
+					"This attribute is write-only: its values are never stored in state or shown in plans, and the fields it sets are redacted from the channel as read back from the API. " +
+					"Because Terraform cannot detect changes to write-only values, change `secrets_version` to send updated secrets. Requires Terraform 1.11 or later.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description: "An arbitrary number to change whenever `secrets` changes, so that Terraform updates the channel with the new values.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The display name of the channel (`metadata.name`). Required when the channel is configured through a typed block; conflicts with `notification_channel_yaml`.",
+				Optional:    true,
+			},
+			"frequency": schema.StringAttribute{
+				Description: "How often reminder notifications are sent while an alert is firing (`spec.frequency`), e.g. `10m`. Defaults to `10m` if omitted; set to `0s` to disable reminders. Conflicts with `notification_channel_yaml`.",
+				Optional:    true,
+			},
 		},
+		Blocks: notificationChannelTypedBlocks(),
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("notification channel"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("notification channel")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("notification channel", "`metadata.name`", "in the organization")
+}
+
+// ModifyPlan plans the provider-level deletion protection default.
+func (r *NotificationChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
 }
 
 // resolveNotificationChannel populates the channel's server-assigned id and
@@ -209,6 +318,16 @@ func (r *NotificationChannelResource) resolveNotificationChannel(ctx context.Con
 	model.URL = stringOrNull(channelURL)
 }
 
+// resolveLastModified populates the notification channel's last-modified
+// attributes after a create or update (best-effort), and records the revision
+// the write produced for conflict detection.
+func (r *NotificationChannelResource) resolveLastModified(ctx context.Context, model *notificationChannelModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "notification channel", func(ctx context.Context) (string, error) {
+		return r.client.GetNotificationChannel(ctx, model.Origin.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model notificationChannelModel
 	diags := req.Plan.Get(ctx, &model)
@@ -220,8 +339,12 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 	model.Origin = types.StringValue("tf_" + uuid.New().String())
 
 	// Validate YAML format
+	channelDocument := model.channelYAML(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var channelYaml interface{}
-	err := yaml.Unmarshal([]byte(model.NotificationChannelYaml.ValueString()), &channelYaml)
+	err := yaml.Unmarshal([]byte(channelDocument), &channelYaml)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid YAML",
@@ -230,27 +353,52 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.NotificationChannelYaml.ValueString())
+	secrets := configuredSecrets(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert YAML to JSON for the API, filling in the secrets
+	jsonBody, err := notificationChannelJSON(channelDocument, secrets)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert notification channel YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateNotificationChannel(ctx, model.Origin.ValueString(), jsonBody)
+	// Take over an existing notification channel with the same name instead of
+	// creating a second one, if adopt_existing is set.
+	createNotificationChannel := r.client.CreateNotificationChannel
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "notification channel", "in the organization", jsonBody, r.client.ListNotificationChannels, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createNotificationChannel = r.client.UpdateNotificationChannel
+		}
+	}
+
+	err = createNotificationChannel(ctx, model.Origin.ValueString(), jsonBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification channel, got error: %s", err))
 		return
 	}
 
+	if len(secrets) > 0 {
+		writeNotificationChannelSecretPaths(ctx, resp.Private, notificationChannelSecretPaths(secrets), &resp.Diagnostics)
+	}
+
 	// Resolve the id and web app URL for the newly created channel (best-effort).
 	r.resolveNotificationChannel(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a notification channel resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, model.Origin, &resp.Diagnostics)
 }
 
 func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -269,12 +417,25 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	tflog.Trace(ctx, "read a notification channel resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseJSON, &resp.Diagnostics)
+
+	// Redact the credentials, whether or not they were filled in from secrets,
+	// before they can reach state, and leave them out of the comparison.
+	secretPaths := readNotificationChannelSecretPaths(ctx, req.Private, &resp.Diagnostics)
+	apiResponseJSON, secretPaths, err = redactNotificationChannel(apiResponseJSON, secretPaths)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redact secrets from notification channel, got error: %s", err))
+		return
+	}
 
 	// Compare the current state with the retrieved notification channel
-	if state.NotificationChannelYaml.ValueString() != "" {
+	if state.usesTypedBlocks() {
+		readTypedNotificationChannel(ctx, &state, apiResponseJSON, secretPaths, &resp.Diagnostics)
+	} else if state.NotificationChannelYaml.ValueString() != "" {
 		stateYAML := state.NotificationChannelYaml.ValueString()
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, notificationChannelConditionallyIgnoredFields)
 		additionalIgnored = append(additionalIgnored, notificationChannelAlwaysIgnoredFields...)
+		additionalIgnored = append(additionalIgnored, secretPaths...)
 		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, nil)
 		if err != nil {
 			resp.Diagnostics.AddWarning(
@@ -284,6 +445,7 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 			state.NotificationChannelYaml = types.StringValue(apiResponseJSON)
 		} else if !equivalent {
 			tflog.Debug(ctx, "Notification channel has changed, updating state")
+			warnChangedOutsideTerraform("notification channel", apiResponseJSON, &resp.Diagnostics)
 			state.NotificationChannelYaml = types.StringValue(apiResponseJSON)
 		} else {
 			tflog.Debug(ctx, "Notification channel is equivalent, ignoring changes in metadata fields")
@@ -292,9 +454,54 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 		state.NotificationChannelYaml = types.StringValue(apiResponseJSON)
 	}
 
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseJSON)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, state.Origin, &resp.Diagnostics)
+}
+
+// readTypedNotificationChannel refreshes a channel configured through the typed
+// blocks, updating them from the API response only when it differs from the
+// document they render to.
+func readTypedNotificationChannel(ctx context.Context, state *notificationChannelModel, apiResponseJSON string, secretPaths []string, diags *diag.Diagnostics) {
+	stateDocument := notificationChannelTypedDocument(ctx, state, diags)
+	if diags.HasError() {
+		return
+	}
+	additionalIgnored := converter.FieldsAbsentFromYAML(stateDocument, notificationChannelConditionallyIgnoredFields)
+	additionalIgnored = append(additionalIgnored, notificationChannelAlwaysIgnoredFields...)
+	additionalIgnored = append(additionalIgnored, secretPaths...)
+	equivalent, err := converter.ResourceYAMLEquivalent(stateDocument, apiResponseJSON, additionalIgnored, nil)
+	if err != nil {
+		diags.AddWarning(
+			"Notification Channel Comparison Error",
+			fmt.Sprintf("Error comparing notification channels: %s. Keeping the current state.", err),
+		)
+		return
+	}
+	if equivalent {
+		tflog.Debug(ctx, "Notification channel is equivalent, ignoring changes in metadata fields")
+		return
+	}
+
+	tflog.Debug(ctx, "Notification channel has changed, updating state")
+	warnChangedOutsideTerraform("notification channel", apiResponseJSON, diags)
+	supported, err := applyNotificationChannelDocument(state, apiResponseJSON)
+	if err != nil {
+		diags.AddWarning(
+			"Notification Channel Comparison Error",
+			fmt.Sprintf("Error reading the notification channel: %s. Keeping the current state.", err),
+		)
+		return
+	}
+	if !supported {
+		diags.AddWarning(
+			"Notification channel type changed outside of Terraform",
+			"The channel's type was changed to one without a typed block. Configure it through notification_channel_yaml to manage it with Terraform.",
+		)
+	}
 }
 
 func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -315,8 +522,12 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Validate YAML format
+	channelDocument := plan.channelYAML(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var channelYaml interface{}
-	err := yaml.Unmarshal([]byte(plan.NotificationChannelYaml.ValueString()), &channelYaml)
+	err := yaml.Unmarshal([]byte(channelDocument), &channelYaml)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid YAML",
@@ -325,8 +536,13 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.NotificationChannelYaml.ValueString())
+	secrets := configuredSecrets(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert YAML to JSON for the API, filling in the secrets
+	jsonBody, err := notificationChannelJSON(channelDocument, secrets)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert notification channel YAML to JSON: %s", err))
 		return
@@ -339,17 +555,36 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 	// re-resolving them via the API.
 	plan.ID = state.ID
 	plan.URL = state.URL
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "notification channel", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetNotificationChannel(ctx, plan.Origin.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err = r.client.UpdateNotificationChannel(ctx, plan.Origin.ValueString(), jsonBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification channel, got error: %s", err))
 		return
 	}
 
+	// Record the fields filled in from secrets, clearing the record when the
+	// secrets were removed.
+	previousSecretPaths := readNotificationChannelSecretPaths(ctx, req.Private, &resp.Diagnostics)
+	if len(secrets) > 0 || len(previousSecretPaths) > 0 {
+		writeNotificationChannelSecretPaths(ctx, resp.Private, notificationChannelSecretPaths(secrets), &resp.Diagnostics)
+	}
+
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a notification channel resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -359,6 +594,9 @@ func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.D
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("notification channel", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
 
 	err := r.client.DeleteNotificationChannel(ctx, state.Origin.ValueString())
 	if err != nil {
@@ -371,7 +609,10 @@ func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.D
 
 // ImportState function is required for resources that support import
 func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	origin := req.ID
+	origin, ok := organizationImportTarget(ctx, req, "notification channel", r.client.ListNotificationChannels, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
 	apiResponseJSON, err := r.client.GetNotificationChannel(ctx, origin)
 	if err != nil {
@@ -381,6 +622,14 @@ func (r *NotificationChannelResource) ImportState(ctx context.Context, req resou
 		)
 		return
 	}
+	apiResponseJSON, _, err = redactNotificationChannel(apiResponseJSON, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Notification Channel",
+			fmt.Sprintf("Could not redact secrets from notification channel with origin=%s: %s", origin, err),
+		)
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), origin)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("notification_channel_yaml"), apiResponseJSON)...)
@@ -390,4 +639,5 @@ func (r *NotificationChannelResource) ImportState(ctx context.Context, req resou
 	r.resolveNotificationChannel(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), model.URL)...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, types.StringValue(origin), &resp.Diagnostics)
 }
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
@@ -77,7 +76,7 @@ spec:
     url: https://example.com/webhook/different
 `,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			name:              "invalid YAML response - should update and warn",
@@ -97,28 +96,13 @@ spec:
     url: https://example.com/webhook/test
 `,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			testSchema := schema.Schema{
-				Attributes: map[string]schema.Attribute{
-					"origin": schema.StringAttribute{
-						Computed: true,
-					},
-					"id": schema.StringAttribute{
-						Computed: true,
-					},
-					"notification_channel_yaml": schema.StringAttribute{
-						Required: true,
-					},
-					"url": schema.StringAttribute{
-						Computed: true,
-					},
-				},
-			}
+			testSchema := notificationChannelTestSchema()
 
 			testClient := &testNotificationChannelClient{
 				getResponse: tc.apiResponseYaml,
@@ -126,22 +110,17 @@ spec:
 
 			r := &NotificationChannelResource{client: testClient}
 
-			raw := tftypes.NewValue(
-				tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"origin":                    tftypes.String,
-						"id":                        tftypes.String,
-						"notification_channel_yaml": tftypes.String,
-						"url":                       tftypes.String,
-					},
-				},
-				map[string]tftypes.Value{
-					"origin":                    tftypes.NewValue(tftypes.String, testOrigin),
-					"id":                        tftypes.NewValue(tftypes.String, nil),
-					"notification_channel_yaml": tftypes.NewValue(tftypes.String, originalYaml),
-					"url":                       tftypes.NewValue(tftypes.String, nil),
-				},
-			)
+			raw := notificationChannelTestValue(map[string]tftypes.Value{
+				"last_modified_at":          tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":          tftypes.NewValue(tftypes.String, nil),
+				"server_version":            tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection":       tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":            tftypes.NewValue(tftypes.Bool, nil),
+				"origin":                    tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                        tftypes.NewValue(tftypes.String, nil),
+				"notification_channel_yaml": tftypes.NewValue(tftypes.String, originalYaml),
+				"url":                       tftypes.NewValue(tftypes.String, nil),
+			})
 
 			state := tfsdk.State{
 				Raw:    raw,
@@ -223,34 +202,22 @@ spec:
           value: platform
 `
 
-	testSchema := schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":                    schema.StringAttribute{Computed: true},
-			"id":                        schema.StringAttribute{Computed: true},
-			"notification_channel_yaml": schema.StringAttribute{Required: true},
-			"url":                       schema.StringAttribute{Computed: true},
-		},
-	}
+	testSchema := notificationChannelTestSchema()
 
 	testClient := &testNotificationChannelClient{getResponse: apiResponseYaml}
 	r := &NotificationChannelResource{client: testClient}
 
-	raw := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":                    tftypes.String,
-				"id":                        tftypes.String,
-				"notification_channel_yaml": tftypes.String,
-				"url":                       tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":                    tftypes.NewValue(tftypes.String, testOrigin),
-			"id":                        tftypes.NewValue(tftypes.String, nil),
-			"notification_channel_yaml": tftypes.NewValue(tftypes.String, stateYaml),
-			"url":                       tftypes.NewValue(tftypes.String, nil),
-		},
-	)
+	raw := notificationChannelTestValue(map[string]tftypes.Value{
+		"last_modified_at":          tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":          tftypes.NewValue(tftypes.String, nil),
+		"server_version":            tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection":       tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":            tftypes.NewValue(tftypes.Bool, nil),
+		"origin":                    tftypes.NewValue(tftypes.String, testOrigin),
+		"id":                        tftypes.NewValue(tftypes.String, nil),
+		"notification_channel_yaml": tftypes.NewValue(tftypes.String, stateYaml),
+		"url":                       tftypes.NewValue(tftypes.String, nil),
+	})
 
 	state := tfsdk.State{Raw: raw, Schema: testSchema}
 	req := resource.ReadRequest{State: state}
@@ -15,6 +15,29 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// notificationChannelTestSchema returns the schema of the notification channel
+// resource.
+func notificationChannelTestSchema() schema.Schema {
+	resp := &resource.SchemaResponse{}
+	(&NotificationChannelResource{}).Schema(context.Background(), resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// notificationChannelTestValue builds a notification channel object value
+// from the given attributes, setting all others to null.
+func notificationChannelTestValue(values map[string]tftypes.Value) tftypes.Value {
+	objectType := notificationChannelTestSchema().Type().TerraformType(context.Background()).(tftypes.Object)
+	all := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			all[name] = v
+		} else {
+			all[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, all)
+}
+
 func TestNotificationChannelResourceModel(t *testing.T) {
 	origin := "test-origin"
 	notificationChannelYaml := `kind: Dash0NotificationChannel
@@ -74,10 +97,21 @@ func TestNotificationChannelResource_Schema(t *testing.T) {
 	assert.True(t, originAttr.IsComputed())
 	assert.False(t, originAttr.IsRequired())
 
-	// Verify notification_channel_yaml is required
+	// Verify notification_channel_yaml is optional, as the typed blocks are
+	// the alternative
 	yamlAttr := resp.Schema.Attributes["notification_channel_yaml"]
-	assert.True(t, yamlAttr.IsRequired())
+	assert.True(t, yamlAttr.IsOptional())
 	assert.False(t, yamlAttr.IsComputed())
+
+	// Verify the typed channel blocks and their sensitive credentials
+	for _, block := range []string{"slack", "teams", "google_chat", "discord", "pagerduty", "opsgenie", "email", "webhook", "routing"} {
+		assert.Contains(t, resp.Schema.Blocks, block)
+	}
+	assert.True(t, resp.Schema.Blocks["slack"].(schema.SingleNestedBlock).Attributes["webhook_url"].IsSensitive())
+	assert.True(t, resp.Schema.Blocks["pagerduty"].(schema.SingleNestedBlock).Attributes["routing_key"].IsSensitive())
+	assert.True(t, resp.Schema.Blocks["opsgenie"].(schema.SingleNestedBlock).Attributes["api_key"].IsSensitive())
+	assert.True(t, resp.Schema.Blocks["webhook"].(schema.SingleNestedBlock).Attributes["headers"].IsSensitive())
+	assert.False(t, resp.Schema.Blocks["webhook"].(schema.SingleNestedBlock).Attributes["url"].IsSensitive())
 }
 
 func TestNotificationChannelResource_Configure(t *testing.T) {
@@ -137,38 +171,18 @@ func TestNotificationChannelResource_Create_InvalidYAML(t *testing.T) {
 
 	// Set up the request state with invalid YAML
 	req.Plan = tfsdk.Plan{
-		Raw: tftypes.NewValue(
-			tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"origin":                    tftypes.String,
-					"id":                        tftypes.String,
-					"notification_channel_yaml": tftypes.String,
-					"url":                       tftypes.String,
-				},
-			},
-			map[string]tftypes.Value{
-				"origin":                    tftypes.NewValue(tftypes.String, "test-origin"),
-				"id":                        tftypes.NewValue(tftypes.String, nil),
-				"notification_channel_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
-				"url":                       tftypes.NewValue(tftypes.String, nil),
-			},
-		),
-		Schema: schema.Schema{
-			Attributes: map[string]schema.Attribute{
-				"origin": schema.StringAttribute{
-					Computed: true,
-				},
-				"id": schema.StringAttribute{
-					Computed: true,
-				},
-				"notification_channel_yaml": schema.StringAttribute{
-					Required: true,
-				},
-				"url": schema.StringAttribute{
-					Computed: true,
-				},
-			},
-		},
+		Raw: notificationChannelTestValue(map[string]tftypes.Value{
+			"last_modified_at":          tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":          tftypes.NewValue(tftypes.String, nil),
+			"server_version":            tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection":       tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":            tftypes.NewValue(tftypes.Bool, nil),
+			"origin":                    tftypes.NewValue(tftypes.String, "test-origin"),
+			"id":                        tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+			"url":                       tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: notificationChannelTestSchema(),
 	}
 
 	r.Create(context.Background(), req, resp)
@@ -191,38 +205,18 @@ func TestNotificationChannelResource_ReadError(t *testing.T) {
 
 	// Create mock state
 	req.State = tfsdk.State{
-		Raw: tftypes.NewValue(
-			tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"origin":                    tftypes.String,
-					"id":                        tftypes.String,
-					"notification_channel_yaml": tftypes.String,
-					"url":                       tftypes.String,
-				},
-			},
-			map[string]tftypes.Value{
-				"origin":                    tftypes.NewValue(tftypes.String, "test-origin"),
-				"id":                        tftypes.NewValue(tftypes.String, nil),
-				"notification_channel_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
-				"url":                       tftypes.NewValue(tftypes.String, nil),
-			},
-		),
-		Schema: schema.Schema{
-			Attributes: map[string]schema.Attribute{
-				"origin": schema.StringAttribute{
-					Computed: true,
-				},
-				"id": schema.StringAttribute{
-					Computed: true,
-				},
-				"notification_channel_yaml": schema.StringAttribute{
-					Required: true,
-				},
-				"url": schema.StringAttribute{
-					Computed: true,
-				},
-			},
-		},
+		Raw: notificationChannelTestValue(map[string]tftypes.Value{
+			"last_modified_at":          tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":          tftypes.NewValue(tftypes.String, nil),
+			"server_version":            tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection":       tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":            tftypes.NewValue(tftypes.Bool, nil),
+			"origin":                    tftypes.NewValue(tftypes.String, "test-origin"),
+			"id":                        tftypes.NewValue(tftypes.String, nil),
+			"notification_channel_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
+			"url":                       tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: notificationChannelTestSchema(),
 	}
 
 	r.Read(context.Background(), req, resp)
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// notificationChannelSecretPathsKey is the private state key under which the
+// field paths filled in from the write-only `secrets` attribute are recorded.
+// Only the paths are stored, never the values, so that Read knows which
+// fields to redact from the API response.
+const notificationChannelSecretPathsKey = "secret_paths"
+
+// privateStateGetter and privateStateSetter are the subsets of the framework's
+// private state that resources use.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// notificationChannelSecretPaths returns the document field paths the given
+// secrets are substituted into, sorted. Secret keys are dot-separated paths
+// relative to spec.config.
+func notificationChannelSecretPaths(secrets map[string]string) []string {
+	paths := make([]string, 0, len(secrets))
+	for _, key := range slices.Sorted(maps.Keys(secrets)) {
+		paths = append(paths, "spec.config."+key)
+	}
+	return paths
+}
+
+// validateNotificationChannelSecrets checks that every secret key is a
+// well-formed path and that the channel YAML does not also set the field, which
+// would store the credential in plaintext in state.
+func validateNotificationChannelSecrets(channelYaml string, keys []string, diags *diag.Diagnostics) {
+	var parsed map[string]interface{}
+	yamlErr := yaml.Unmarshal([]byte(channelYaml), &parsed)
+
+	for _, key := range keys {
+		if slices.Contains(strings.Split(key, "."), "") {
+			diags.AddAttributeError(
+				path.Root("secrets"),
+				"Invalid secret key",
+				fmt.Sprintf("Secret keys are dot-separated paths relative to spec.config, such as \"webhookURL\" or \"auth.password\", got: %q.", key),
+			)
+			continue
+		}
+		if yamlErr != nil {
+			continue
+		}
+		if _, ok := lookupFieldPath(parsed, "spec.config."+key); ok {
+			diags.AddAttributeError(
+				path.Root("notification_channel_yaml"),
+				"Secret also set in notification_channel_yaml",
+				fmt.Sprintf("spec.config.%s is supplied through the write-only secrets attribute and must be removed from notification_channel_yaml, where it would be stored in plaintext in state.", key),
+			)
+		}
+	}
+}
+
+// notificationChannelJSON converts the channel YAML to the JSON body sent to
+// the API, filling in the given secrets under spec.config.
+func notificationChannelJSON(channelYaml string, secrets map[string]string) (string, error) {
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(channelYaml), &parsed); err != nil {
+		return "", fmt.Errorf("error parsing YAML: %w", err)
+	}
+	if parsed == nil {
+		parsed = map[string]interface{}{}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(secrets)) {
+		if err := setFieldPath(parsed, "spec.config."+key, secrets[key]); err != nil {
+			return "", fmt.Errorf("cannot set spec.config.%s: %w", key, err)
+		}
+	}
+
+	jsonBytes, err := json.Marshal(parsed)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling to JSON: %w", err)
+	}
+	return string(jsonBytes), nil
+}
+
+// redactNotificationChannel removes the credentials from a channel read back
+// from the API: the fields that hold credentials for its channel type, and
+// secretPaths, the fields filled in from `secrets`. It returns the redacted
+// document and the removed paths, which must be left out of comparisons.
+func redactNotificationChannel(apiResponseJSON string, secretPaths []string) (string, []string, error) {
+	paths := converter.NotificationChannelSecretFields(apiResponseJSON)
+	for _, secretPath := range secretPaths {
+		if !slices.Contains(paths, secretPath) {
+			paths = append(paths, secretPath)
+		}
+	}
+	if len(paths) == 0 {
+		return apiResponseJSON, nil, nil
+	}
+	redacted, err := converter.RemoveFieldPaths(apiResponseJSON, paths)
+	if err != nil {
+		return "", nil, err
+	}
+	return redacted, paths, nil
+}
+
+// readNotificationChannelSecretPaths returns the secret paths recorded in
+// private state, or nil when the channel has no secrets.
+func readNotificationChannelSecretPaths(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) []string {
+	raw, d := private.GetKey(ctx, notificationChannelSecretPathsKey)
+	diags.Append(d...)
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var paths []string
+	if err := json.Unmarshal(raw, &paths); err != nil {
+		diags.AddWarning(
+			"Unable to read notification channel secret paths",
+			fmt.Sprintf("The fields filled in from secrets could not be determined, so they are not redacted: %s", err),
+		)
+		return nil
+	}
+	return paths
+}
+
+// writeNotificationChannelSecretPaths records the secret paths in private
+// state. An empty list clears a previously recorded one.
+func writeNotificationChannelSecretPaths(ctx context.Context, private privateStateSetter, paths []string, diags *diag.Diagnostics) {
+	var raw []byte
+	if len(paths) > 0 {
+		var err error
+		raw, err = json.Marshal(paths)
+		if err != nil {
+			diags.AddError("Unable to record notification channel secret paths", err.Error())
+			return
+		}
+	}
+	diags.Append(private.SetKey(ctx, notificationChannelSecretPathsKey, raw)...)
+}
+
+// lookupFieldPath returns the value at a dot-separated path in a nested map.
+func lookupFieldPath(data map[string]interface{}, fieldPath string) (interface{}, bool) {
+	parts := strings.SplitN(fieldPath, ".", 2)
+	val, exists := data[parts[0]]
+	if !exists || val == nil {
+		return nil, false
+	}
+	if len(parts) == 1 {
+		return val, true
+	}
+	nested, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	return lookupFieldPath(nested, parts[1])
+}
+
+// setFieldPath sets the value at a dot-separated path in a nested map,
+// creating intermediate maps as needed.
+func setFieldPath(data map[string]interface{}, fieldPath string, value interface{}) error {
+	parts := strings.SplitN(fieldPath, ".", 2)
+	if len(parts) == 1 {
+		data[parts[0]] = value
+		return nil
+	}
+	next, exists := data[parts[0]]
+	if !exists || next == nil {
+		next = map[string]interface{}{}
+		data[parts[0]] = next
+	}
+	nested, ok := next.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s is not a mapping", parts[0])
+	}
+	return setFieldPath(nested, parts[1], value)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testPrivateState is an in-memory stand-in for the framework's private state.
+type testPrivateState map[string][]byte
+
+func (s testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return s[key], nil
+}
+
+func (s testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	s[key] = value
+	return nil
+}
+
+const secretsTestChannelYaml = `kind: Dash0NotificationChannel
+metadata:
+  name: Webhook Alerts
+spec:
+  type: webhook
+  config:
+    url: https://example.com/webhook/alerts
+    auth:
+      username: dash0
+`
+
+func TestNotificationChannelJSON(t *testing.T) {
+	body, err := notificationChannelJSON(secretsTestChannelYaml, map[string]string{
+		"auth.password": "hunter2",
+		"headers.token": "t0ken",
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+  "kind": "Dash0NotificationChannel",
+  "metadata": {"name": "Webhook Alerts"},
+  "spec": {
+    "type": "webhook",
+    "config": {
+      "url": "https://example.com/webhook/alerts",
+      "auth": {"username": "dash0", "password": "hunter2"},
+      "headers": {"token": "t0ken"}
+    }
+  }
+}`, body)
+}
+
+func TestNotificationChannelJSON_WithoutSecrets(t *testing.T) {
+	body, err := notificationChannelJSON(secretsTestChannelYaml, nil)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+  "kind": "Dash0NotificationChannel",
+  "metadata": {"name": "Webhook Alerts"},
+  "spec": {"type": "webhook", "config": {"url": "https://example.com/webhook/alerts", "auth": {"username": "dash0"}}}
+}`, body)
+}
+
+func TestNotificationChannelJSON_PathThroughScalar(t *testing.T) {
+	_, err := notificationChannelJSON(secretsTestChannelYaml, map[string]string{"url.token": "t0ken"})
+	assert.ErrorContains(t, err, "cannot set spec.config.url.token: url is not a mapping")
+}
+
+func TestValidateNotificationChannelSecrets(t *testing.T) {
+	cases := []struct {
+		name          string
+		keys          []string
+		expectSummary string
+		expectPath    path.Path
+	}{
+		{name: "new fields", keys: []string{"auth.password", "apiKey"}},
+		{name: "field also set in YAML", keys: []string{"url"}, expectSummary: "Secret also set in notification_channel_yaml", expectPath: path.Root("notification_channel_yaml")},
+		{name: "nested field also set in YAML", keys: []string{"auth.username"}, expectSummary: "Secret also set in notification_channel_yaml", expectPath: path.Root("notification_channel_yaml")},
+		{name: "empty path segment", keys: []string{"auth..password"}, expectSummary: "Invalid secret key", expectPath: path.Root("secrets")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateNotificationChannelSecrets(secretsTestChannelYaml, tc.keys, &diags)
+
+			if tc.expectSummary == "" {
+				assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+				return
+			}
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Equal(t, tc.expectSummary, diags.Errors()[0].Summary())
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectPath, withPath.Path())
+		})
+	}
+}
+
+func TestNotificationChannelSecretPaths_PrivateState(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+	var diags diag.Diagnostics
+
+	assert.Nil(t, readNotificationChannelSecretPaths(ctx, private, &diags))
+
+	paths := notificationChannelSecretPaths(map[string]string{"webhookURL": "https://hooks.slack.com/x", "auth.password": "hunter2"})
+	assert.Equal(t, []string{"spec.config.auth.password", "spec.config.webhookURL"}, paths)
+
+	writeNotificationChannelSecretPaths(ctx, private, paths, &diags)
+	assert.NotContains(t, string(private[notificationChannelSecretPathsKey]), "hunter2", "secret values must never be recorded")
+	assert.Equal(t, paths, readNotificationChannelSecretPaths(ctx, private, &diags))
+
+	writeNotificationChannelSecretPaths(ctx, private, nil, &diags)
+	assert.Nil(t, readNotificationChannelSecretPaths(ctx, private, &diags))
+	assert.False(t, diags.HasError())
+}
+
+func TestNotificationChannelResource_ValidateConfig_Secrets(t *testing.T) {
+	r := &NotificationChannelResource{}
+	stringMap := tftypes.Map{ElementType: tftypes.String}
+	config := tfsdk.Config{
+		Raw: notificationChannelTestValue(map[string]tftypes.Value{
+			"notification_channel_yaml": tftypes.NewValue(tftypes.String, secretsTestChannelYaml),
+			"secrets": tftypes.NewValue(stringMap, map[string]tftypes.Value{
+				"url":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"auth.password": tftypes.NewValue(tftypes.String, "hunter2"),
+			}),
+			"secrets_version": tftypes.NewValue(tftypes.Number, 1),
+		}),
+		Schema: notificationChannelTestSchema(),
+	}
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, "Secret also set in notification_channel_yaml", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "spec.config.url")
+}
+
+// TestNotificationChannelResource_Read_RedactsCredentialsWithoutSecrets covers
+// a channel whose credentials were not set through `secrets`, as after an
+// import: they are redacted from the refreshed state and ignored when
+// comparing, even though no secret paths are recorded in private state.
+func TestNotificationChannelResource_Read_RedactsCredentialsWithoutSecrets(t *testing.T) {
+	stateJSON := `{"kind":"Dash0NotificationChannel","metadata":{"name":"Slack Alerts"},"spec":{"config":{"channel":"#alerts"},"type":"slack"}}`
+
+	tests := []struct {
+		name        string
+		apiResponse string
+		expected    string
+	}{
+		{
+			name:        "only the credential differs",
+			apiResponse: `{"kind":"Dash0NotificationChannel","metadata":{"name":"Slack Alerts"},"spec":{"type":"slack","config":{"webhookURL":"https://hooks.slack.com/services/T0/B0/X","channel":"#alerts"}}}`,
+			expected:    stateJSON,
+		},
+		{
+			name:        "changed outside Terraform",
+			apiResponse: `{"kind":"Dash0NotificationChannel","metadata":{"name":"Slack Alerts"},"spec":{"type":"slack","config":{"webhookURL":"https://hooks.slack.com/services/T0/B0/X","channel":"#incidents"}}}`,
+			expected:    `{"kind":"Dash0NotificationChannel","metadata":{"name":"Slack Alerts"},"spec":{"config":{"channel":"#incidents"},"type":"slack"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &NotificationChannelResource{client: &testNotificationChannelClient{getResponse: tt.apiResponse}}
+			state := tfsdk.State{Schema: notificationChannelTestSchema(), Raw: notificationChannelTestValue(map[string]tftypes.Value{
+				"origin":                    tftypes.NewValue(tftypes.String, "tf_slack"),
+				"notification_channel_yaml": tftypes.NewValue(tftypes.String, stateJSON),
+			})}
+			resp := resource.ReadResponse{State: state}
+
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			var result notificationChannelModel
+			require.False(t, resp.State.Get(context.Background(), &result).HasError())
+			assert.Equal(t, tt.expected, result.NotificationChannelYaml.ValueString())
+			assert.NotContains(t, result.NotificationChannelYaml.ValueString(), "hooks.slack.com")
+		})
+	}
+}
+
+func TestNotificationChannelResource_ImportState_RedactsCredentials(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &MockClient{}
+	r := &NotificationChannelResource{client: mockClient}
+	mockClient.On("GetNotificationChannel", mock.Anything, "tf_pagerduty").Return(
+		`{"kind":"Dash0NotificationChannel","metadata":{"name":"PagerDuty"},"spec":{"type":"pagerduty","config":{"key":"routing-key","url":"https://events.pagerduty.com/v2/enqueue"}}}`, nil)
+	mockClient.On("ResolveNotificationChannel", mock.Anything, "tf_pagerduty").Return("", "", nil)
+
+	testSchema := notificationChannelTestSchema()
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "tf_pagerduty"}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var channelYAML string
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("notification_channel_yaml"), &channelYAML).HasError())
+	assert.Equal(t, `{"kind":"Dash0NotificationChannel","metadata":{"name":"PagerDuty"},"spec":{"config":{"url":"https://events.pagerduty.com/v2/enqueue"},"type":"pagerduty"}}`, channelYAML)
+	mockClient.AssertExpectations(t)
+}
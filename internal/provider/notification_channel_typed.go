@@ -0,0 +1,493 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"gopkg.in/yaml.v3"
+)
+
+// notificationChannelFieldKind is the Terraform type of a typed channel field.
+type notificationChannelFieldKind int
+
+const (
+	notificationChannelFieldString notificationChannelFieldKind = iota
+	notificationChannelFieldBool
+	notificationChannelFieldStringList
+	notificationChannelFieldStringMap
+)
+
+// notificationChannelField maps an attribute of a typed channel block to a
+// field of the channel's spec.config.
+type notificationChannelField struct {
+	attribute   string
+	configKey   string
+	kind        notificationChannelFieldKind
+	required    bool
+	sensitive   bool
+	description string
+}
+
+// notificationChannelKind describes a channel type that can be configured
+// through a typed block instead of notification_channel_yaml.
+type notificationChannelKind struct {
+	block       string
+	channelType string
+	description string
+	fields      []notificationChannelField
+}
+
+// notificationChannelKinds are the channel types with a typed block. The block
+// schemas, the conversion to the channel document and the mapping of the API
+// response back onto the blocks are all derived from this table.
+var notificationChannelKinds = []notificationChannelKind{
+	{
+		block:       "slack",
+		channelType: "slack",
+		description: "Delivers alerts to a Slack channel through an incoming webhook.",
+		fields: []notificationChannelField{
+			{attribute: "webhook_url", configKey: "webhookURL", required: true, sensitive: true, description: "The Slack incoming webhook URL."},
+			{attribute: "channel", configKey: "channel", description: "The Slack channel to post to, e.g. `#alerts`. Defaults to the channel the webhook was created for."},
+		},
+	},
+	{
+		block:       "teams",
+		channelType: "teams_webhook",
+		description: "Delivers alerts to Microsoft Teams through an incoming webhook.",
+		fields: []notificationChannelField{
+			{attribute: "webhook_url", configKey: "url", required: true, sensitive: true, description: "The Microsoft Teams incoming webhook URL."},
+		},
+	},
+	{
+		block:       "google_chat",
+		channelType: "google_chat_webhook",
+		description: "Delivers alerts to a Google Chat space through a webhook.",
+		fields: []notificationChannelField{
+			{attribute: "webhook_url", configKey: "url", required: true, sensitive: true, description: "The Google Chat webhook URL, including its `key` and `token` query parameters."},
+		},
+	},
+	{
+		block:       "discord",
+		channelType: "discord_webhook",
+		description: "Delivers alerts to a Discord channel through a webhook.",
+		fields: []notificationChannelField{
+			{attribute: "webhook_url", configKey: "url", required: true, sensitive: true, description: "The Discord webhook URL."},
+		},
+	},
+	{
+		block:       "pagerduty",
+		channelType: "pagerduty",
+		description: "Creates PagerDuty incidents through the Events API v2.",
+		fields: []notificationChannelField{
+			{attribute: "routing_key", configKey: "key", required: true, sensitive: true, description: "The integration (routing) key of the PagerDuty service."},
+			{attribute: "url", configKey: "url", description: "The PagerDuty Events API endpoint, e.g. `https://events.pagerduty.com/v2/enqueue` or `https://events.eu.pagerduty.com/v2/enqueue`."},
+		},
+	},
+	{
+		block:       "opsgenie",
+		channelType: "opsgenie",
+		description: "Creates Opsgenie alerts.",
+		fields: []notificationChannelField{
+			{attribute: "api_key", configKey: "apiKey", required: true, sensitive: true, description: "The API key of the Opsgenie integration."},
+			{attribute: "instance", configKey: "instance", description: "The Opsgenie instance the account is hosted in: `us` or `eu`."},
+		},
+	},
+	{
+		block:       "email",
+		channelType: "email_v2",
+		description: "Delivers alerts by email.",
+		fields: []notificationChannelField{
+			{attribute: "recipients", configKey: "recipients", kind: notificationChannelFieldStringList, required: true, description: "The email addresses to notify."},
+			{attribute: "plaintext", configKey: "plaintext", kind: notificationChannelFieldBool, description: "Whether to send plain-text instead of HTML emails."},
+		},
+	},
+	{
+		block:       "webhook",
+		channelType: "webhook",
+		description: "Posts alerts as JSON to an HTTP endpoint.",
+		fields: []notificationChannelField{
+			{attribute: "url", configKey: "url", required: true, description: "The URL alerts are posted to."},
+			{attribute: "headers", configKey: "headers", kind: notificationChannelFieldStringMap, sensitive: true, description: "Additional HTTP headers sent with every request, such as an `Authorization` header."},
+		},
+	},
+}
+
+// notificationChannelRoutingFilterType is the type of a single routing filter
+// condition.
+var notificationChannelRoutingFilterType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"key":      types.StringType,
+	"operator": types.StringType,
+	"value":    types.StringType,
+}}
+
+// notificationChannelRoutingType is the type of the `routing` block.
+var notificationChannelRoutingType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"filters": types.ListType{ElemType: types.ListType{ElemType: notificationChannelRoutingFilterType}},
+}}
+
+func (f notificationChannelField) attrType() attr.Type {
+	switch f.kind {
+	case notificationChannelFieldBool:
+		return types.BoolType
+	case notificationChannelFieldStringList:
+		return types.ListType{ElemType: types.StringType}
+	case notificationChannelFieldStringMap:
+		return types.MapType{ElemType: types.StringType}
+	default:
+		return types.StringType
+	}
+}
+
+func (f notificationChannelField) schemaAttribute() schema.Attribute {
+	optional := !f.required
+	switch f.kind {
+	case notificationChannelFieldBool:
+		return schema.BoolAttribute{Description: f.description, Required: f.required, Optional: optional, Sensitive: f.sensitive}
+	case notificationChannelFieldStringList:
+		return schema.ListAttribute{Description: f.description, ElementType: types.StringType, Required: f.required, Optional: optional, Sensitive: f.sensitive}
+	case notificationChannelFieldStringMap:
+		return schema.MapAttribute{Description: f.description, ElementType: types.StringType, Required: f.required, Optional: optional, Sensitive: f.sensitive}
+	default:
+		return schema.StringAttribute{Description: f.description, Required: f.required, Optional: optional, Sensitive: f.sensitive}
+	}
+}
+
+func (k notificationChannelKind) objectType() types.ObjectType {
+	attrTypes := make(map[string]attr.Type, len(k.fields))
+	for _, f := range k.fields {
+		attrTypes[f.attribute] = f.attrType()
+	}
+	return types.ObjectType{AttrTypes: attrTypes}
+}
+
+// notificationChannelTypedBlocks returns the schema of the typed channel blocks
+// and the `routing` block.
+func notificationChannelTypedBlocks() map[string]schema.Block {
+	blocks := make(map[string]schema.Block, len(notificationChannelKinds)+1)
+	for _, k := range notificationChannelKinds {
+		attributes := make(map[string]schema.Attribute, len(k.fields))
+		for _, f := range k.fields {
+			attributes[f.attribute] = f.schemaAttribute()
+		}
+		blocks[k.block] = schema.SingleNestedBlock{
+			Description: fmt.Sprintf("%s Sets `spec.type` to `%s`. Conflicts with `notification_channel_yaml` and the other channel blocks.", k.description, k.channelType),
+			Attributes:  attributes,
+		}
+	}
+	blocks["routing"] = schema.SingleNestedBlock{
+		Description: "Restricts which alerts are delivered to the channel. Conflicts with `notification_channel_yaml`.",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.ListAttribute{
+				Description: "Alternative groups of conditions: an alert is delivered when all conditions of at least one group match. Each condition has a `key` (an attribute such as `service.name`), an `operator` (such as `is`) and a `value`.",
+				ElementType: types.ListType{ElemType: notificationChannelRoutingFilterType},
+				Optional:    true,
+			},
+		},
+	}
+	return blocks
+}
+
+// channelBlocks returns the typed channel blocks of the model,
+// keyed by block name.
+func (m *notificationChannelModel) channelBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"slack":       &m.Slack,
+		"teams":       &m.Teams,
+		"google_chat": &m.GoogleChat,
+		"discord":     &m.Discord,
+		"pagerduty":   &m.PagerDuty,
+		"opsgenie":    &m.Opsgenie,
+		"email":       &m.Email,
+		"webhook":     &m.Webhook,
+	}
+}
+
+// usesTypedBlocks reports whether the channel is configured through the typed
+// attributes and blocks rather than notification_channel_yaml.
+func (m *notificationChannelModel) usesTypedBlocks() bool {
+	if !m.Name.IsNull() {
+		return true
+	}
+	for _, block := range m.channelBlocks() {
+		if !block.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNotificationChannelTyped checks that the channel is configured either
+// through notification_channel_yaml or through exactly one channel block.
+func validateNotificationChannelTyped(m *notificationChannelModel, diags *diag.Diagnostics) {
+	var configured []string
+	for _, k := range notificationChannelKinds {
+		if !m.channelBlocks()[k.block].IsNull() {
+			configured = append(configured, k.block)
+		}
+	}
+
+	typedOnly := map[string]bool{
+		"name":      !m.Name.IsNull(),
+		"frequency": !m.Frequency.IsNull(),
+		"routing":   !m.Routing.IsNull(),
+	}
+	for _, block := range configured {
+		typedOnly[block] = true
+	}
+
+	if !m.NotificationChannelYaml.IsNull() {
+		for _, name := range []string{"name", "frequency", "routing", "slack", "teams", "google_chat", "discord", "pagerduty", "opsgenie", "email", "webhook"} {
+			if typedOnly[name] {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Conflicting notification channel configuration",
+					fmt.Sprintf("%s cannot be combined with notification_channel_yaml. Configure the channel either in YAML or through the typed attributes and blocks.", name),
+				)
+			}
+		}
+		return
+	}
+
+	switch {
+	case len(configured) == 0:
+		diags.AddError(
+			"Missing notification channel configuration",
+			"Set notification_channel_yaml, or set name and exactly one of the slack, teams, google_chat, discord, pagerduty, opsgenie, email or webhook blocks.",
+		)
+		return
+	case len(configured) > 1:
+		diags.AddAttributeError(
+			path.Root(configured[1]),
+			"Conflicting notification channel configuration",
+			fmt.Sprintf("Only one channel block can be set, got: %v.", configured),
+		)
+	}
+	if m.Name.IsNull() {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Missing notification channel name",
+			"name is required when the channel is configured through a typed block.",
+		)
+	}
+	if !m.Secrets.IsNull() {
+		diags.AddAttributeError(
+			path.Root("secrets"),
+			"Secrets require notification_channel_yaml",
+			"secrets are only filled into notification_channel_yaml. Set the credentials in the channel block instead, where they are marked sensitive.",
+		)
+	}
+	if !m.Frequency.IsNull() && !m.Frequency.IsUnknown() {
+		if _, err := time.ParseDuration(m.Frequency.ValueString()); err != nil {
+			diags.AddAttributeError(
+				path.Root("frequency"),
+				"Invalid frequency",
+				fmt.Sprintf("frequency must be a duration such as \"10m\" or \"0s\", got: %q.", m.Frequency.ValueString()),
+			)
+		}
+	}
+}
+
+// notificationChannelTypedDocument renders the typed attributes and blocks as a
+// Dash0NotificationChannel document in JSON, which is also valid YAML and is
+// handled exactly like notification_channel_yaml from then on.
+func notificationChannelTypedDocument(ctx context.Context, m *notificationChannelModel, diags *diag.Diagnostics) string {
+	spec := map[string]interface{}{}
+	for _, k := range notificationChannelKinds {
+		block := m.channelBlocks()[k.block]
+		if block.IsNull() {
+			continue
+		}
+		spec["type"] = k.channelType
+		config := map[string]interface{}{}
+		attributes := block.Attributes()
+		for _, f := range k.fields {
+			if v := notificationChannelFieldValue(ctx, f, attributes[f.attribute], diags); v != nil {
+				config[f.configKey] = v
+			}
+		}
+		spec["config"] = config
+	}
+	if !m.Frequency.IsNull() {
+		spec["frequency"] = m.Frequency.ValueString()
+	}
+	if !m.Routing.IsNull() {
+		var routing struct {
+			Filters [][]struct {
+				Key      string `tfsdk:"key" json:"key"`
+				Operator string `tfsdk:"operator" json:"operator"`
+				Value    string `tfsdk:"value" json:"value"`
+			} `tfsdk:"filters"`
+		}
+		diags.Append(m.Routing.As(ctx, &routing, basetypes.ObjectAsOptions{})...)
+		if routing.Filters != nil {
+			spec["routing"] = map[string]interface{}{"filters": routing.Filters}
+		}
+	}
+
+	document := map[string]interface{}{
+		"kind":     "Dash0NotificationChannel",
+		"metadata": map[string]interface{}{"name": m.Name.ValueString()},
+		"spec":     spec,
+	}
+	jsonBytes, err := json.Marshal(document)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to render notification channel: %s", err))
+		return ""
+	}
+	return string(jsonBytes)
+}
+
+// notificationChannelFieldValue converts a typed block attribute to its
+// spec.config value, or nil when it is null.
+func notificationChannelFieldValue(ctx context.Context, f notificationChannelField, value attr.Value, diags *diag.Diagnostics) interface{} {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	switch f.kind {
+	case notificationChannelFieldBool:
+		return value.(types.Bool).ValueBool()
+	case notificationChannelFieldStringList:
+		var list []string
+		diags.Append(value.(types.List).ElementsAs(ctx, &list, false)...)
+		return list
+	case notificationChannelFieldStringMap:
+		var m map[string]string
+		diags.Append(value.(types.Map).ElementsAs(ctx, &m, false)...)
+		return m
+	default:
+		return value.(types.String).ValueString()
+	}
+}
+
+// applyNotificationChannelDocument sets the typed attributes and blocks of the
+// model from a channel document returned by the API. frequency and routing are
+// only set when the model already manages them, so that server defaults do not
+// surface as changes. Credentials absent from the document, which Read
+// redacts, keep their current value. It reports false when the channel type
+// has no typed block.
+func applyNotificationChannelDocument(m *notificationChannelModel, document string) (bool, error) {
+	var parsed struct {
+		Metadata struct {
+			Name string `yaml:"name"`
+		} `yaml:"metadata"`
+		Spec struct {
+			Type      string                 `yaml:"type"`
+			Config    map[string]interface{} `yaml:"config"`
+			Frequency string                 `yaml:"frequency"`
+			Routing   struct {
+				Filters [][]map[string]string `yaml:"filters"`
+			} `yaml:"routing"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(document), &parsed); err != nil {
+		return false, fmt.Errorf("error parsing notification channel: %w", err)
+	}
+
+	var kind *notificationChannelKind
+	for i := range notificationChannelKinds {
+		if notificationChannelKinds[i].channelType == parsed.Spec.Type {
+			kind = &notificationChannelKinds[i]
+			break
+		}
+	}
+	if kind == nil {
+		return false, nil
+	}
+
+	m.Name = types.StringValue(parsed.Metadata.Name)
+
+	if !m.Frequency.IsNull() {
+		current, errCurrent := time.ParseDuration(m.Frequency.ValueString())
+		remote, errRemote := time.ParseDuration(parsed.Spec.Frequency)
+		if errCurrent != nil || errRemote != nil || current != remote {
+			m.Frequency = stringOrNull(parsed.Spec.Frequency)
+		}
+	}
+
+	if !m.Routing.IsNull() || len(parsed.Spec.Routing.Filters) > 0 {
+		groups := make([]attr.Value, len(parsed.Spec.Routing.Filters))
+		for i, group := range parsed.Spec.Routing.Filters {
+			conditions := make([]attr.Value, len(group))
+			for j, condition := range group {
+				conditions[j] = types.ObjectValueMust(notificationChannelRoutingFilterType.AttrTypes, map[string]attr.Value{
+					"key":      types.StringValue(condition["key"]),
+					"operator": types.StringValue(condition["operator"]),
+					"value":    types.StringValue(condition["value"]),
+				})
+			}
+			groups[i] = types.ListValueMust(notificationChannelRoutingFilterType, conditions)
+		}
+		filters := types.ListNull(types.ListType{ElemType: notificationChannelRoutingFilterType})
+		if len(groups) > 0 {
+			filters = types.ListValueMust(types.ListType{ElemType: notificationChannelRoutingFilterType}, groups)
+		}
+		m.Routing = types.ObjectValueMust(notificationChannelRoutingType.AttrTypes, map[string]attr.Value{"filters": filters})
+	}
+
+	for _, k := range notificationChannelKinds {
+		block := m.channelBlocks()[k.block]
+		if k.block != kind.block {
+			*block = types.ObjectNull(k.objectType().AttrTypes)
+			continue
+		}
+		current := *block
+		values := make(map[string]attr.Value, len(k.fields))
+		for _, f := range k.fields {
+			value, present := parsed.Spec.Config[f.configKey]
+			if !present && f.sensitive && !current.IsNull() && !current.IsUnknown() {
+				// Credentials are redacted from the API response, so keep the
+				// configured value.
+				values[f.attribute] = current.Attributes()[f.attribute]
+				continue
+			}
+			values[f.attribute] = notificationChannelAttrValue(f, value)
+		}
+		*block = types.ObjectValueMust(k.objectType().AttrTypes, values)
+	}
+	return true, nil
+}
+
+// notificationChannelAttrValue converts a spec.config value to the typed block
+// attribute, null when absent or of an unexpected type.
+func notificationChannelAttrValue(f notificationChannelField, value interface{}) attr.Value {
+	switch f.kind {
+	case notificationChannelFieldBool:
+		if b, ok := value.(bool); ok {
+			return types.BoolValue(b)
+		}
+		return types.BoolNull()
+	case notificationChannelFieldStringList:
+		items, ok := value.([]interface{})
+		if !ok {
+			return types.ListNull(types.StringType)
+		}
+		elements := make([]attr.Value, 0, len(items))
+		for _, item := range items {
+			elements = append(elements, types.StringValue(fmt.Sprint(item)))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	case notificationChannelFieldStringMap:
+		entries, ok := value.(map[string]interface{})
+		if !ok || len(entries) == 0 {
+			return types.MapNull(types.StringType)
+		}
+		elements := make(map[string]attr.Value, len(entries))
+		for key, item := range entries {
+			elements[key] = types.StringValue(fmt.Sprint(item))
+		}
+		return types.MapValueMust(types.StringType, elements)
+	default:
+		if s, ok := value.(string); ok {
+			return types.StringValue(s)
+		}
+		return types.StringNull()
+	}
+}
@@ -0,0 +1,392 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// typedTestChannelModel returns a model with every attribute and block null.
+func typedTestChannelModel() notificationChannelModel {
+	m := notificationChannelModel{
+		Origin:                  types.StringNull(),
+		ID:                      types.StringNull(),
+		NotificationChannelYaml: types.StringNull(),
+		URL:                     types.StringNull(),
+		Secrets:                 types.MapNull(types.StringType),
+		SecretsVersion:          types.Int64Null(),
+		Name:                    types.StringNull(),
+		Frequency:               types.StringNull(),
+		Routing:                 types.ObjectNull(notificationChannelRoutingType.AttrTypes),
+	}
+	for _, k := range notificationChannelKinds {
+		*m.channelBlocks()[k.block] = types.ObjectNull(k.objectType().AttrTypes)
+	}
+	return m
+}
+
+func typedTestSlackBlock(webhookURL, channel string) types.Object {
+	return types.ObjectValueMust(notificationChannelKinds[0].objectType().AttrTypes, map[string]attr.Value{
+		"webhook_url": types.StringValue(webhookURL),
+		"channel":     stringOrNull(channel),
+	})
+}
+
+func typedTestRouting(key, operator, value string) types.Object {
+	condition := types.ObjectValueMust(notificationChannelRoutingFilterType.AttrTypes, map[string]attr.Value{
+		"key":      types.StringValue(key),
+		"operator": types.StringValue(operator),
+		"value":    types.StringValue(value),
+	})
+	return types.ObjectValueMust(notificationChannelRoutingType.AttrTypes, map[string]attr.Value{
+		"filters": types.ListValueMust(types.ListType{ElemType: notificationChannelRoutingFilterType}, []attr.Value{
+			types.ListValueMust(notificationChannelRoutingFilterType, []attr.Value{condition}),
+		}),
+	})
+}
+
+func TestNotificationChannelTypedDocument(t *testing.T) {
+	m := typedTestChannelModel()
+	m.Name = types.StringValue("Slack Alerts")
+	m.Frequency = types.StringValue("5m")
+	m.Slack = typedTestSlackBlock("https://hooks.slack.com/services/T0/B0/X", "#alerts")
+	m.Routing = typedTestRouting("service.severity", "is", "critical")
+
+	var diags diag.Diagnostics
+	document := notificationChannelTypedDocument(context.Background(), &m, &diags)
+
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	assert.JSONEq(t, `{
+  "kind": "Dash0NotificationChannel",
+  "metadata": {"name": "Slack Alerts"},
+  "spec": {
+    "type": "slack",
+    "config": {"webhookURL": "https://hooks.slack.com/services/T0/B0/X", "channel": "#alerts"},
+    "frequency": "5m",
+    "routing": {"filters": [[{"key": "service.severity", "operator": "is", "value": "critical"}]]}
+  }
+}`, document)
+}
+
+func TestNotificationChannelTypedDocument_AllKinds(t *testing.T) {
+	cases := []struct {
+		block        string
+		values       map[string]attr.Value
+		expectType   string
+		expectConfig string
+	}{
+		{block: "teams", values: map[string]attr.Value{"webhook_url": types.StringValue("https://example.webhook.office.com/x")}, expectType: "teams_webhook", expectConfig: `{"url": "https://example.webhook.office.com/x"}`},
+		{block: "google_chat", values: map[string]attr.Value{"webhook_url": types.StringValue("https://chat.googleapis.com/x")}, expectType: "google_chat_webhook", expectConfig: `{"url": "https://chat.googleapis.com/x"}`},
+		{block: "discord", values: map[string]attr.Value{"webhook_url": types.StringValue("https://discord.com/api/webhooks/x")}, expectType: "discord_webhook", expectConfig: `{"url": "https://discord.com/api/webhooks/x"}`},
+		{block: "pagerduty", values: map[string]attr.Value{"routing_key": types.StringValue("r0uting"), "url": types.StringNull()}, expectType: "pagerduty", expectConfig: `{"key": "r0uting"}`},
+		{block: "opsgenie", values: map[string]attr.Value{"api_key": types.StringValue("k3y"), "instance": types.StringValue("eu")}, expectType: "opsgenie", expectConfig: `{"apiKey": "k3y", "instance": "eu"}`},
+		{
+			block: "email",
+			values: map[string]attr.Value{
+				"recipients": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("oncall@example.com")}),
+				"plaintext":  types.BoolValue(true),
+			},
+			expectType:   "email_v2",
+			expectConfig: `{"recipients": ["oncall@example.com"], "plaintext": true}`,
+		},
+		{
+			block: "webhook",
+			values: map[string]attr.Value{
+				"url":     types.StringValue("https://example.com/alerts"),
+				"headers": types.MapValueMust(types.StringType, map[string]attr.Value{"Authorization": types.StringValue("Bearer t0ken")}),
+			},
+			expectType:   "webhook",
+			expectConfig: `{"url": "https://example.com/alerts", "headers": {"Authorization": "Bearer t0ken"}}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.block, func(t *testing.T) {
+			m := typedTestChannelModel()
+			m.Name = types.StringValue("Alerts")
+			block := m.channelBlocks()[tc.block]
+			*block = types.ObjectValueMust(block.AttributeTypes(context.Background()), tc.values)
+
+			var diags diag.Diagnostics
+			document := notificationChannelTypedDocument(context.Background(), &m, &diags)
+
+			require.False(t, diags.HasError(), "diagnostics: %v", diags)
+			assert.JSONEq(t, `{"kind": "Dash0NotificationChannel", "metadata": {"name": "Alerts"}, "spec": {"type": "`+tc.expectType+`", "config": `+tc.expectConfig+`}}`, document)
+
+			// The API response maps back onto the same block.
+			roundTrip := typedTestChannelModel()
+			supported, err := applyNotificationChannelDocument(&roundTrip, document)
+			require.NoError(t, err)
+			assert.True(t, supported)
+			assert.Equal(t, *block, *roundTrip.channelBlocks()[tc.block])
+		})
+	}
+}
+
+func TestValidateNotificationChannelTyped(t *testing.T) {
+	cases := []struct {
+		name          string
+		modify        func(*notificationChannelModel)
+		expectSummary string
+		expectPath    path.Path
+	}{
+		{
+			name: "yaml only",
+			modify: func(m *notificationChannelModel) {
+				m.NotificationChannelYaml = types.StringValue("kind: Dash0NotificationChannel")
+			},
+		},
+		{
+			name: "typed only",
+			modify: func(m *notificationChannelModel) {
+				m.Name = types.StringValue("Slack Alerts")
+				m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+			},
+		},
+		{
+			name:          "neither",
+			modify:        func(m *notificationChannelModel) {},
+			expectSummary: "Missing notification channel configuration",
+		},
+		{
+			name: "yaml and a channel block",
+			modify: func(m *notificationChannelModel) {
+				m.NotificationChannelYaml = types.StringValue("kind: Dash0NotificationChannel")
+				m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+			},
+			expectSummary: "Conflicting notification channel configuration",
+			expectPath:    path.Root("slack"),
+		},
+		{
+			name: "yaml and frequency",
+			modify: func(m *notificationChannelModel) {
+				m.NotificationChannelYaml = types.StringValue("kind: Dash0NotificationChannel")
+				m.Frequency = types.StringValue("10m")
+			},
+			expectSummary: "Conflicting notification channel configuration",
+			expectPath:    path.Root("frequency"),
+		},
+		{
+			name: "two channel blocks",
+			modify: func(m *notificationChannelModel) {
+				m.Name = types.StringValue("Alerts")
+				m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+				m.Webhook = types.ObjectValueMust(m.Webhook.AttributeTypes(context.Background()), map[string]attr.Value{
+					"url":     types.StringValue("https://example.com"),
+					"headers": types.MapNull(types.StringType),
+				})
+			},
+			expectSummary: "Conflicting notification channel configuration",
+			expectPath:    path.Root("webhook"),
+		},
+		{
+			name: "channel block without name",
+			modify: func(m *notificationChannelModel) {
+				m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+			},
+			expectSummary: "Missing notification channel name",
+			expectPath:    path.Root("name"),
+		},
+		{
+			name: "channel block with secrets",
+			modify: func(m *notificationChannelModel) {
+				m.Name = types.StringValue("Slack Alerts")
+				m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+				m.Secrets = types.MapValueMust(types.StringType, map[string]attr.Value{"webhookURL": types.StringValue("x")})
+			},
+			expectSummary: "Secrets require notification_channel_yaml",
+			expectPath:    path.Root("secrets"),
+		},
+		{
+			name: "invalid frequency",
+			modify: func(m *notificationChannelModel) {
+				m.Name = types.StringValue("Slack Alerts")
+				m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+				m.Frequency = types.StringValue("hourly")
+			},
+			expectSummary: "Invalid frequency",
+			expectPath:    path.Root("frequency"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := typedTestChannelModel()
+			tc.modify(&m)
+
+			var diags diag.Diagnostics
+			validateNotificationChannelTyped(&m, &diags)
+
+			if tc.expectSummary == "" {
+				assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+				return
+			}
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Equal(t, tc.expectSummary, diags.Errors()[0].Summary())
+			if len(tc.expectPath.Steps()) == 0 {
+				return
+			}
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectPath, withPath.Path())
+		})
+	}
+}
+
+func TestApplyNotificationChannelDocument(t *testing.T) {
+	t.Run("server defaults are not adopted", func(t *testing.T) {
+		m := typedTestChannelModel()
+		m.Frequency = types.StringValue("10m")
+		supported, err := applyNotificationChannelDocument(&m, `{
+  "kind": "Dash0NotificationChannel",
+  "metadata": {"name": "Renamed"},
+  "spec": {"type": "slack", "config": {"webhookURL": "https://hooks.slack.com/x"}, "frequency": "10m0s", "routing": {"assets": [], "filters": []}}
+}`)
+
+		require.NoError(t, err)
+		assert.True(t, supported)
+		assert.Equal(t, "Renamed", m.Name.ValueString())
+		assert.Equal(t, "10m", m.Frequency.ValueString(), "an equivalent duration keeps the configured spelling")
+		assert.True(t, m.Routing.IsNull(), "empty server-side routing must not surface as a routing block")
+		assert.Equal(t, typedTestSlackBlock("https://hooks.slack.com/x", ""), m.Slack)
+	})
+
+	t.Run("channel type changed to another typed block", func(t *testing.T) {
+		m := typedTestChannelModel()
+		m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+		supported, err := applyNotificationChannelDocument(&m, `{"metadata": {"name": "Alerts"}, "spec": {"type": "discord_webhook", "config": {"url": "https://discord.com/x"}}}`)
+
+		require.NoError(t, err)
+		assert.True(t, supported)
+		assert.True(t, m.Slack.IsNull())
+		assert.Equal(t, "https://discord.com/x", m.Discord.Attributes()["webhook_url"].(types.String).ValueString())
+	})
+
+	t.Run("redacted credentials keep the configured value", func(t *testing.T) {
+		m := typedTestChannelModel()
+		m.Slack = typedTestSlackBlock("https://hooks.slack.com/x", "")
+		supported, err := applyNotificationChannelDocument(&m, `{"metadata": {"name": "Alerts"}, "spec": {"type": "slack", "config": {"channel": "#alerts"}}}`)
+
+		require.NoError(t, err)
+		assert.True(t, supported)
+		assert.Equal(t, typedTestSlackBlock("https://hooks.slack.com/x", "#alerts"), m.Slack)
+	})
+
+	t.Run("channel type without typed block", func(t *testing.T) {
+		m := typedTestChannelModel()
+		supported, err := applyNotificationChannelDocument(&m, `{"spec": {"type": "slack_bot", "config": {"teamId": "T0"}}}`)
+
+		require.NoError(t, err)
+		assert.False(t, supported)
+	})
+}
+
+func TestNotificationChannelResource_Create_Typed(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &NotificationChannelResource{client: mockClient}
+
+	raw := notificationChannelTestValue(map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "Webhook Alerts"),
+		"webhook": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"url":     tftypes.String,
+			"headers": tftypes.Map{ElementType: tftypes.String},
+		}}, map[string]tftypes.Value{
+			"url":     tftypes.NewValue(tftypes.String, "https://example.com/alerts"),
+			"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+	})
+	req := resource.CreateRequest{
+		Plan:   tfsdk.Plan{Raw: raw, Schema: notificationChannelTestSchema()},
+		Config: tfsdk.Config{Raw: raw, Schema: notificationChannelTestSchema()},
+	}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: notificationChannelTestSchema()}}
+
+	mockClient.On("CreateNotificationChannel", mock.Anything, mock.Anything,
+		`{"kind":"Dash0NotificationChannel","metadata":{"name":"Webhook Alerts"},"spec":{"config":{"url":"https://example.com/alerts"},"type":"webhook"}}`,
+	).Return(nil)
+	mockClient.On("ResolveNotificationChannel", mock.Anything, mock.Anything).Return("channel-id", "https://app.dash0.com/x", nil)
+	mockClient.On("GetNotificationChannel", mock.Anything, mock.Anything).Return(`{"kind": "Dash0NotificationChannel", "metadata": {"name": "Webhook Alerts", "updatedAt": "2024-05-02T14:02:31Z", "version": 1, "dash0Extensions": {"updatedBy": "terraform@example.com"}}, "spec": {"type": "webhook", "config": {"url": "https://example.com/alerts"}}}`, nil)
+
+	r.Create(context.Background(), req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+
+	var state notificationChannelModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.True(t, state.NotificationChannelYaml.IsNull())
+	assert.Equal(t, "Webhook Alerts", state.Name.ValueString())
+	assert.Equal(t, "https://example.com/alerts", state.Webhook.Attributes()["url"].(types.String).ValueString())
+	assert.Equal(t, "2024-05-02T14:02:31Z", state.LastModifiedAt.ValueString())
+	assert.Equal(t, "terraform@example.com", state.LastModifiedBy.ValueString())
+	assert.Equal(t, "1", state.ServerVersion.ValueString())
+}
+
+func TestNotificationChannelResource_Read_Typed(t *testing.T) {
+	slack := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"webhook_url": tftypes.String,
+		"channel":     tftypes.String,
+	}}, map[string]tftypes.Value{
+		"webhook_url": tftypes.NewValue(tftypes.String, "https://hooks.slack.com/x"),
+		"channel":     tftypes.NewValue(tftypes.String, "#alerts"),
+	})
+	state := tfsdk.State{
+		Raw: notificationChannelTestValue(map[string]tftypes.Value{
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"origin":              tftypes.NewValue(tftypes.String, "test-origin"),
+			"name":                tftypes.NewValue(tftypes.String, "Slack Alerts"),
+			"slack":               slack,
+		}),
+		Schema: notificationChannelTestSchema(),
+	}
+
+	cases := []struct {
+		name           string
+		apiResponse    string
+		expectChannel  string
+		expectWarnings int
+	}{
+		{
+			name:          "server enrichment only",
+			apiResponse:   `{"kind":"Dash0NotificationChannel","metadata":{"name":"Slack Alerts","labels":{"dash0.com/origin":"test-origin"}},"spec":{"type":"slack","config":{"webhookURL":"https://hooks.slack.com/x","channel":"#alerts"},"frequency":"10m0s","routing":{"assets":[]}}}`,
+			expectChannel: "#alerts",
+		},
+		{
+			name:           "channel changed outside of Terraform",
+			apiResponse:    `{"kind":"Dash0NotificationChannel","metadata":{"name":"Slack Alerts","updatedAt":"2024-05-02T14:02:31Z","dash0Extensions":{"updatedBy":"alice@example.com"}},"spec":{"type":"slack","config":{"webhookURL":"https://hooks.slack.com/x","channel":"#incidents"}}}`,
+			expectChannel:  "#incidents",
+			expectWarnings: 1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &NotificationChannelResource{client: &testNotificationChannelClient{getResponse: tc.apiResponse}}
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			require.Equal(t, tc.expectWarnings, resp.Diagnostics.WarningsCount())
+			if tc.expectWarnings > 0 {
+				assert.Equal(t, "Notification channel changed outside of Terraform", resp.Diagnostics.Warnings()[0].Summary())
+				assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "by alice@example.com at 2024-05-02 14:02 UTC")
+			}
+			var result notificationChannelModel
+			require.False(t, resp.State.Get(context.Background(), &result).HasError())
+			assert.True(t, result.NotificationChannelYaml.IsNull())
+			assert.True(t, result.Frequency.IsNull())
+			assert.Equal(t, tc.expectChannel, result.Slack.Attributes()["channel"].(types.String).ValueString())
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// planValidation is the provider's `plan_validation` setting: whether planned
+// documents are checked against the assets that exist in the organization,
+// and whether problems fail the plan or only warn. The zero value checks
+// nothing.
+//
+// The Dash0 API has no dry-run or validation endpoint, so only what can be
+// checked through the read endpoints or offline is checked: that every
+// notification channel a check rule or synthetic check routes to exists, and
+// that a spam filter's conditions and signal contexts are well-formed. Other
+// problems the API rejects, such as an invalid synthetic check location, still
+// surface at apply time.
+type planValidation string
+
+const (
+	planValidationOff   planValidation = "off"
+	planValidationWarn  planValidation = "warn"
+	planValidationError planValidation = "error"
+)
+
+// parsePlanValidation resolves the `plan_validation` setting: the
+// DASH0_PLAN_VALIDATION environment variable, then the provider attribute,
+// then "off". An invalid value is reported as an error on the attribute.
+func parsePlanValidation(envValue string, attr types.String, diags *diag.Diagnostics) planValidation {
+	value, source := envValue, "DASH0_PLAN_VALIDATION environment variable"
+	if value == "" && !attr.IsNull() && !attr.IsUnknown() {
+		value, source = attr.ValueString(), "plan_validation provider attribute"
+	}
+	if value == "" {
+		return planValidationOff
+	}
+	mode := planValidation(value)
+	if !slices.Contains([]planValidation{planValidationOff, planValidationWarn, planValidationError}, mode) {
+		diags.AddAttributeError(path.Root("plan_validation"), "Invalid plan_validation",
+			fmt.Sprintf("plan_validation must be %q, %q or %q, got: %q (from %s)", planValidationOff, planValidationWarn, planValidationError, value, source))
+		return planValidationOff
+	}
+	return mode
+}
+
+// validatePlan validates the YAML attribute attr of a planned resource. It
+// does nothing on destroy or while the attribute is unknown, which includes
+// documents that reference notification channels created in the same run.
+func (v planValidation) validatePlan(ctx context.Context, c client.Client, plan tfsdk.Plan, resourceType string, attr path.Path, diags *diag.Diagnostics) {
+	if !v.enabled() || plan.Raw.IsNull() {
+		return
+	}
+	var value types.String
+	diags.Append(plan.GetAttribute(ctx, attr, &value)...)
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return
+	}
+	v.validate(ctx, c, resourceType, value.ValueString(), attr, diags)
+}
+
+// validate checks the YAML definition of a resource of the given type against
+// the organization's assets, reporting problems on attr. A definition that
+// cannot be parsed is left to the resource's own validation, and one that
+// cannot be checked because the assets cannot be listed only warns.
+func (v planValidation) validate(ctx context.Context, c client.Client, resourceType, yamlStr string, attr path.Path, diags *diag.Diagnostics) {
+	if !v.enabled() {
+		return
+	}
+	if resourceType == "dash0_spam_filter" {
+		for _, problem := range spamFilterProblems(yamlStr) {
+			v.report(attr, "Invalid spam filter", problem+", so the API would reject the resource at apply time.", diags)
+		}
+		return
+	}
+	if c == nil {
+		return
+	}
+	subjects, err := policySubjects(resourceType, yamlStr)
+	if err != nil || !slices.ContainsFunc(subjects, func(s policySubject) bool { return len(s.notificationChannels) > 0 }) {
+		return
+	}
+
+	channels, err := c.ListNotificationChannels(ctx)
+	if err != nil {
+		diags.AddAttributeWarning(attr, "Unable to validate notification channels",
+			fmt.Sprintf("The organization's notification channels could not be listed, so the channels this resource routes to are not checked before apply: %s", err))
+		return
+	}
+	for _, subject := range subjects {
+		var unknown []string
+		for _, id := range subject.notificationChannels {
+			if _, ok := client.AssetByID(channels, id); !ok {
+				unknown = append(unknown, fmt.Sprintf("%q", id))
+			}
+		}
+		if len(unknown) == 0 {
+			continue
+		}
+		v.report(attr, "Unknown notification channel", fmt.Sprintf("%s: no notification channel with id %s exists in the organization, so the API would reject the resource at apply time.",
+			subject.location, strings.Join(unknown, ", ")), diags)
+	}
+}
+
+// report adds a problem found in a planned document on attr, as an error or a
+// warning depending on the setting.
+func (v planValidation) report(attr path.Path, summary, detail string, diags *diag.Diagnostics) {
+	if v == planValidationError {
+		diags.AddAttributeError(attr, summary, detail)
+	} else {
+		diags.AddAttributeWarning(attr, summary, detail)
+	}
+}
+
+// spamFilterContexts are the signal types a spam filter can apply to.
+var spamFilterContexts = []string{"log", "span", "datapoint", "web_event"}
+
+// spamFilterProblems checks a spam filter definition offline: every condition
+// in `spec.filter` needs a key and an operator, and the signal types in
+// `spec.contexts` (v1alpha1) or `spec.context` (v1alpha2) must be known. The
+// operators themselves are left to the API. A definition that cannot be
+// parsed, or has an apiVersion other than these two, is not checked.
+func spamFilterProblems(yamlStr string) []string {
+	var doc struct {
+		APIVersion string `yaml:"apiVersion"`
+		Spec       struct {
+			Filter   []map[string]interface{} `yaml:"filter"`
+			Contexts []string                 `yaml:"contexts"`
+			Context  string                   `yaml:"context"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return nil
+	}
+	var contexts []string
+	switch doc.APIVersion {
+	case "v1alpha1":
+		contexts = doc.Spec.Contexts
+		if len(contexts) == 0 {
+			return []string{"spec.contexts must list at least one signal type"}
+		}
+	case "v1alpha2":
+		contexts = []string{doc.Spec.Context}
+	default:
+		return nil
+	}
+
+	var problems []string
+	for _, signal := range contexts {
+		if !slices.Contains(spamFilterContexts, signal) {
+			problems = append(problems, fmt.Sprintf("%q is not a signal type a spam filter applies to; use one of %s",
+				signal, strings.Join(spamFilterContexts, ", ")))
+		}
+	}
+	if len(doc.Spec.Filter) == 0 {
+		problems = append(problems, "spec.filter must contain at least one condition")
+	}
+	for i, condition := range doc.Spec.Filter {
+		for _, field := range []string{"key", "operator"} {
+			if value, _ := condition[field].(string); value == "" {
+				problems = append(problems, fmt.Sprintf("spec.filter[%d] has no %s", i, field))
+			}
+		}
+	}
+	return problems
+}
+
+// enabled reports whether planned documents are checked at all.
+func (v planValidation) enabled() bool {
+	return v == planValidationWarn || v == planValidationError
+}
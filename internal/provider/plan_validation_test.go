@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+const planValidationCheckRule = `spec:
+  groups:
+    - name: Alerting
+      rules:
+        - alert: Up
+          expr: up == 0
+          annotations:
+            dash0.com/notification-channel-ids: 2c5a3f1e-0000-0000-0000-000000000000, tf_missing
+        - alert: Down
+          expr: up == 1
+`
+
+func TestParsePlanValidation(t *testing.T) {
+	var diags diag.Diagnostics
+	assert.Equal(t, planValidationOff, parsePlanValidation("", types.StringNull(), &diags))
+	assert.Equal(t, planValidationWarn, parsePlanValidation("", types.StringValue("warn"), &diags))
+	// The environment variable takes precedence over the attribute.
+	assert.Equal(t, planValidationError, parsePlanValidation("error", types.StringValue("off"), &diags))
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+
+	parsePlanValidation("", types.StringValue("strict"), &diags)
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), `got: "strict" (from plan_validation provider attribute)`)
+}
+
+func TestPlanValidation_Validate(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListNotificationChannels", mock.Anything).Return([]client.Asset{
+		{Origin: "tf_oncall", ID: "2c5a3f1e-0000-0000-0000-000000000000", Name: "On-call"},
+	}, nil)
+
+	var diags diag.Diagnostics
+	planValidationError.validate(context.Background(), mockClient, "dash0_check_rule", planValidationCheckRule, path.Root("check_rule_yaml"), &diags)
+
+	require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+	assert.Equal(t, `spec.groups[0] ("Alerting").rules[0] ("Up"): no notification channel with id "tf_missing" exists in the organization, so the API would reject the resource at apply time.`, diags.Errors()[0].Detail())
+
+	diags = nil
+	planValidationWarn.validate(context.Background(), mockClient, "dash0_check_rule", planValidationCheckRule, path.Root("check_rule_yaml"), &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+}
+
+func TestPlanValidation_Validate_SyntheticCheck(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListNotificationChannels", mock.Anything).Return([]client.Asset{}, nil)
+
+	var diags diag.Diagnostics
+	planValidationError.validate(context.Background(), mockClient, "dash0_synthetic_check", `kind: Dash0SyntheticCheck
+metadata:
+  name: checkout
+spec:
+  notifications:
+    channels:
+      - 2c5a3f1e-0000-0000-0000-000000000000
+`, path.Root("synthetic_check_yaml"), &diags)
+
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), `"checkout": no notification channel with id "2c5a3f1e-0000-0000-0000-000000000000"`)
+}
+
+func TestPlanValidation_Validate_NothingToCheck(t *testing.T) {
+	mockClient := &MockClient{}
+
+	var diags diag.Diagnostics
+	planValidationError.validate(context.Background(), mockClient, "dash0_dashboard", "kind: PersesDashboard\n", path.Root("dashboard_yaml"), &diags)
+	planValidationOff.validate(context.Background(), mockClient, "dash0_check_rule", planValidationCheckRule, path.Root("check_rule_yaml"), &diags)
+
+	assert.Empty(t, diags)
+	mockClient.AssertNotCalled(t, "ListNotificationChannels", mock.Anything)
+}
+
+func TestPlanValidation_Validate_ListError(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListNotificationChannels", mock.Anything).Return(nil, errors.New("dash0 api error: forbidden (status: 403)"))
+
+	var diags diag.Diagnostics
+	planValidationError.validate(context.Background(), mockClient, "dash0_check_rule", planValidationCheckRule, path.Root("check_rule_yaml"), &diags)
+
+	assert.False(t, diags.HasError())
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, "Unable to validate notification channels", diags.Warnings()[0].Summary())
+}
+
+func TestPlanValidation_Validate_SpamFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		problems []string
+	}{
+		{
+			name: "valid v1alpha1",
+			yaml: `apiVersion: v1alpha1
+spec:
+  contexts: [log, span]
+  filter:
+    - key: k8s.namespace.name
+      operator: is
+      value: kube-system
+`,
+		},
+		{
+			name: "valid v1alpha2",
+			yaml: `apiVersion: v1alpha2
+spec:
+  context: web_event
+  filter:
+    - key: severity_text
+      operator: is
+      value: DEBUG
+`,
+		},
+		{
+			name: "condition without key or operator",
+			yaml: `apiVersion: v1alpha2
+spec:
+  context: log
+  filter:
+    - value: DEBUG
+`,
+			problems: []string{"spec.filter[0] has no key", "spec.filter[0] has no operator"},
+		},
+		{
+			name: "unknown signal type and no conditions",
+			yaml: `apiVersion: v1alpha1
+spec:
+  contexts: [logs]
+`,
+			problems: []string{`"logs" is not a signal type a spam filter applies to; use one of log, span, datapoint, web_event`, "spec.filter must contain at least one condition"},
+		},
+		{
+			name:     "no contexts",
+			yaml:     "apiVersion: v1alpha1\nspec:\n  filter: [{key: a, operator: is}]\n",
+			problems: []string{"spec.contexts must list at least one signal type"},
+		},
+		{
+			name: "unknown apiVersion is not checked",
+			yaml: "apiVersion: v2\nspec: {}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			// Spam filters are checked offline, without a client.
+			planValidationError.validate(context.Background(), nil, "dash0_spam_filter", tt.yaml, path.Root("spam_filter_yaml"), &diags)
+
+			require.Equal(t, len(tt.problems), diags.ErrorsCount(), "diagnostics: %v", diags)
+			for i, problem := range tt.problems {
+				assert.Equal(t, "Invalid spam filter", diags.Errors()[i].Summary())
+				assert.Equal(t, problem+", so the API would reject the resource at apply time.", diags.Errors()[i].Detail())
+			}
+		})
+	}
+}
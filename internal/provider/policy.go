@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+const (
+	policyEnforcementWarn  = "warn"
+	policyEnforcementError = "error"
+
+	// annotationNotificationChannelIDs routes a check rule's alerts to the
+	// notification channels whose ids it lists, comma-separated.
+	annotationNotificationChannelIDs = "dash0.com/notification-channel-ids"
+)
+
+// policyResourceTypes are the resource types a policy rule can target. A
+// dash0_prometheus_rule is checked against the dash0_check_rule and
+// dash0_recording_rule rules, one per alerting and recording rule it contains,
+// so a convention holds no matter which resource manages a rule.
+var policyResourceTypes = []string{
+	"dash0_check_rule",
+	"dash0_dashboard",
+	"dash0_recording_rule",
+	"dash0_synthetic_check",
+	"dash0_view",
+}
+
+// policyBlockModel is the provider-level `policy` block.
+type policyBlockModel struct {
+	Rules []policyRuleModel `tfsdk:"rule"`
+}
+
+// policyRuleModel is a single `rule` block inside `policy`.
+type policyRuleModel struct {
+	ResourceType               types.String `tfsdk:"resource_type"`
+	RequiredAnnotations        types.List   `tfsdk:"required_annotations"`
+	RequiredLabels             types.List   `tfsdk:"required_labels"`
+	AllowedFolderPathPrefixes  types.List   `tfsdk:"allowed_folder_path_prefixes"`
+	RequireNotificationChannel types.Bool   `tfsdk:"require_notification_channel"`
+	Enforcement                types.String `tfsdk:"enforcement"`
+}
+
+// policyRule is a validated policy rule.
+type policyRule struct {
+	resourceType               string
+	requiredAnnotations        []string
+	requiredLabels             []string
+	allowedFolderPathPrefixes  []string
+	requireNotificationChannel bool
+	enforcement                string
+}
+
+// policy is the set of conventions configured in the provider's `policy`
+// block, checked by each resource's ModifyPlan. Terraform does not configure
+// the provider for `terraform validate`, so policy is only enforced at plan
+// time. The zero value enforces nothing.
+type policy struct {
+	rules []policyRule
+}
+
+// policySubject is a unit a policy rule is checked against: the asset itself
+// for dashboards, views and synthetic checks, or a single rule of a
+// PrometheusRule document.
+type policySubject struct {
+	// resourceType selects the policy rules that apply.
+	resourceType string
+	// location names the subject within the document, e.g.
+	// `spec.groups[0] ("Alerting").rules[1] ("HighErrorRate")`.
+	location             string
+	annotations          map[string]string
+	labels               map[string]string
+	notificationChannels []string
+}
+
+// parsePolicy validates the provider's `policy` block. Problems are reported
+// as errors on the offending attribute of the block.
+func parsePolicy(ctx context.Context, block *policyBlockModel, diags *diag.Diagnostics) policy {
+	var p policy
+	if block == nil {
+		return p
+	}
+	for i, model := range block.Rules {
+		rulePath := path.Root("policy").AtName("rule").AtListIndex(i)
+		rule := policyRule{
+			resourceType:               model.ResourceType.ValueString(),
+			requireNotificationChannel: model.RequireNotificationChannel.ValueBool(),
+			enforcement:                policyEnforcementError,
+		}
+		if !slices.Contains(policyResourceTypes, rule.resourceType) {
+			diags.AddAttributeError(rulePath.AtName("resource_type"), "Invalid policy rule",
+				fmt.Sprintf("resource_type must be one of %s, got: %q", strings.Join(policyResourceTypes, ", "), rule.resourceType))
+		}
+		if !model.Enforcement.IsNull() && !model.Enforcement.IsUnknown() {
+			rule.enforcement = model.Enforcement.ValueString()
+		}
+		if rule.enforcement != policyEnforcementWarn && rule.enforcement != policyEnforcementError {
+			diags.AddAttributeError(rulePath.AtName("enforcement"), "Invalid policy rule",
+				fmt.Sprintf("enforcement must be %q or %q, got: %q", policyEnforcementWarn, policyEnforcementError, rule.enforcement))
+		}
+		diags.Append(model.RequiredAnnotations.ElementsAs(ctx, &rule.requiredAnnotations, true)...)
+		diags.Append(model.RequiredLabels.ElementsAs(ctx, &rule.requiredLabels, true)...)
+		diags.Append(model.AllowedFolderPathPrefixes.ElementsAs(ctx, &rule.allowedFolderPathPrefixes, true)...)
+		if len(rule.allowedFolderPathPrefixes) > 0 && rule.resourceType != "dash0_dashboard" && rule.resourceType != "dash0_view" {
+			diags.AddAttributeError(rulePath.AtName("allowed_folder_path_prefixes"), "Invalid policy rule",
+				fmt.Sprintf("allowed_folder_path_prefixes only applies to dash0_dashboard and dash0_view, the resources with a folder path, not to %s", rule.resourceType))
+		}
+		if rule.requireNotificationChannel && rule.resourceType != "dash0_check_rule" && rule.resourceType != "dash0_synthetic_check" {
+			diags.AddAttributeError(rulePath.AtName("require_notification_channel"), "Invalid policy rule",
+				fmt.Sprintf("require_notification_channel only applies to dash0_check_rule and dash0_synthetic_check, not to %s", rule.resourceType))
+		}
+		p.rules = append(p.rules, rule)
+	}
+	return p
+}
+
+// enforce checks the YAML definition of a resource of the given type against
+// the policy. Violations of a rule with enforcement "error" are reported as
+// errors on attr, all others as warnings. A definition that cannot be parsed
+// is left to the resource's own validation.
+func (p policy) enforce(resourceType, yamlStr string, attr path.Path, diags *diag.Diagnostics) {
+	if len(p.rules) == 0 {
+		return
+	}
+	subjects, err := policySubjects(resourceType, yamlStr)
+	if err != nil {
+		return
+	}
+	for _, subject := range subjects {
+		for _, rule := range p.rules {
+			if rule.resourceType != subject.resourceType {
+				continue
+			}
+			for _, violation := range rule.violations(subject) {
+				detail := fmt.Sprintf("%s: %s (policy for %s, configured in the provider's policy block).", subject.location, violation, rule.resourceType)
+				if rule.enforcement == policyEnforcementError {
+					diags.AddAttributeError(attr, "Policy violation", detail)
+				} else {
+					diags.AddAttributeWarning(attr, "Policy violation", detail)
+				}
+			}
+		}
+	}
+}
+
+// enforcePlan enforces the policy on the YAML attribute attr of a planned
+// resource. It does nothing on destroy or while the attribute is unknown.
+func (p policy) enforcePlan(ctx context.Context, plan tfsdk.Plan, resourceType string, attr path.Path, diags *diag.Diagnostics) {
+	if len(p.rules) == 0 || plan.Raw.IsNull() {
+		return
+	}
+	var value types.String
+	diags.Append(plan.GetAttribute(ctx, attr, &value)...)
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return
+	}
+	p.enforce(resourceType, value.ValueString(), attr, diags)
+}
+
+// violations describes every way subject breaks the rule.
+func (r policyRule) violations(subject policySubject) []string {
+	var violations []string
+	for _, key := range r.requiredAnnotations {
+		if subject.annotations[key] == "" {
+			violations = append(violations, fmt.Sprintf("missing required annotation %q", key))
+		}
+	}
+	for _, key := range r.requiredLabels {
+		if subject.labels[key] == "" {
+			violations = append(violations, fmt.Sprintf("missing required label %q", key))
+		}
+	}
+	if len(r.allowedFolderPathPrefixes) > 0 {
+		folderPath := subject.annotations[converter.AnnotationFolderPath]
+		allowed := slices.ContainsFunc(r.allowedFolderPathPrefixes, func(prefix string) bool {
+			return folderPath != "" && folderPathHasPrefix(folderPath, prefix)
+		})
+		switch {
+		case folderPath == "":
+			violations = append(violations, fmt.Sprintf("missing the %q annotation; it must start with one of: %s",
+				converter.AnnotationFolderPath, strings.Join(r.allowedFolderPathPrefixes, ", ")))
+		case !allowed:
+			violations = append(violations, fmt.Sprintf("folder path %q is not within any of the allowed prefixes: %s",
+				folderPath, strings.Join(r.allowedFolderPathPrefixes, ", ")))
+		}
+	}
+	if r.requireNotificationChannel && len(subject.notificationChannels) == 0 {
+		if subject.resourceType == "dash0_synthetic_check" {
+			violations = append(violations, "no notification channel in spec.notifications.channels")
+		} else {
+			violations = append(violations, fmt.Sprintf("no notification channel in the %q annotation", annotationNotificationChannelIDs))
+		}
+	}
+	return violations
+}
+
+// folderPathHasPrefix reports whether folderPath is prefix or lies below it.
+// The match is on whole path segments, so "/teams" covers "/teams/checkout"
+// but not "/teams-archive"; a trailing slash on prefix is ignored.
+func folderPathHasPrefix(folderPath, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return folderPath == prefix || strings.HasPrefix(folderPath, prefix+"/")
+}
+
+// policySubjects extracts what the policy is checked against from a resource
+// definition, applying the same Operator envelope unwrapping and annotation
+// merge as the resource itself.
+func policySubjects(resourceType, yamlStr string) ([]policySubject, error) {
+	switch resourceType {
+	case "dash0_dashboard":
+		return metadataPolicySubject(resourceType, converter.UnwrapOperatorDashboard(yamlStr), nil)
+	case "dash0_view":
+		return metadataPolicySubject(resourceType, converter.UnwrapOperatorView(yamlStr), nil)
+	case "dash0_synthetic_check":
+		return metadataPolicySubject(resourceType, converter.UnwrapOperatorSyntheticCheck(yamlStr), func(doc map[string]interface{}) []string {
+			spec, _ := doc["spec"].(map[string]interface{})
+			notifications, _ := spec["notifications"].(map[string]interface{})
+			channels, _ := notifications["channels"].([]interface{})
+			var ids []string
+			for _, channel := range channels {
+				if id := fmt.Sprint(channel); id != "" {
+					ids = append(ids, id)
+				}
+			}
+			return ids
+		})
+	case "dash0_check_rule", "dash0_recording_rule", "dash0_prometheus_rule":
+		return prometheusRulePolicySubjects(yamlStr)
+	}
+	return nil, nil
+}
+
+// metadataPolicySubject returns the document itself as the single subject,
+// with its metadata labels and annotations.
+func metadataPolicySubject(resourceType, yamlStr string, channels func(map[string]interface{}) []string) ([]policySubject, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return nil, err
+	}
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	subject := policySubject{
+		resourceType: resourceType,
+		location:     "metadata",
+		annotations:  yamlStringMap(metadata["annotations"]),
+		labels:       yamlStringMap(metadata["labels"]),
+	}
+	if name, _ := metadata["name"].(string); name != "" {
+		subject.location = fmt.Sprintf("%q", name)
+	}
+	if channels != nil {
+		subject.notificationChannels = channels(doc)
+	}
+	return []policySubject{subject}, nil
+}
+
+// prometheusRulePolicySubjects returns one subject per rule of a
+// PrometheusRule document: alerting rules are checked as dash0_check_rule,
+// recording rules as dash0_recording_rule. An alerting rule's annotations are
+// the document's top-level annotations overlaid with its own, the way Dash0
+// stores them.
+func prometheusRulePolicySubjects(yamlStr string) ([]policySubject, error) {
+	var doc prometheusRuleDocument
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		return nil, err
+	}
+	topLevel := yamlStringMap(doc.Metadata.Annotations)
+
+	var subjects []policySubject
+	for gi, group := range doc.Spec.Groups {
+		for ri := range group.Rules {
+			var rule prometheusRuleEntry
+			if err := group.Rules[ri].Decode(&rule); err != nil {
+				return nil, err
+			}
+			subject := policySubject{
+				resourceType: "dash0_check_rule",
+				location:     fmt.Sprintf("spec.groups[%d] (%q).rules[%d] (%q)", gi, group.Name, ri, ruleName(rule)),
+				labels:       yamlStringMap(rule.Labels),
+				annotations:  map[string]string{},
+			}
+			for k, v := range topLevel {
+				subject.annotations[k] = v
+			}
+			if rule.Record != "" {
+				subject.resourceType = "dash0_recording_rule"
+			} else {
+				for k, v := range yamlStringMap(rule.Annotations) {
+					subject.annotations[k] = v
+				}
+			}
+			for _, id := range strings.Split(subject.annotations[annotationNotificationChannelIDs], ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					subject.notificationChannels = append(subject.notificationChannels, id)
+				}
+			}
+			subjects = append(subjects, subject)
+		}
+	}
+	return subjects, nil
+}
+
+// yamlStringMap converts a decoded YAML mapping into a map of strings, rendering
+// non-string values (such as `dash0-enabled: true`) the way they were written.
+func yamlStringMap(v interface{}) map[string]string {
+	m, _ := v.(map[string]interface{})
+	out := make(map[string]string, len(m))
+	for k, val := range m {
+		if val != nil {
+			out[k] = fmt.Sprint(val)
+		}
+	}
+	return out
+}
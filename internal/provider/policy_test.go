@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stringListValue builds a types.List of strings for policy rule models.
+func stringListValue(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// testPolicyRuleModel returns a rule model for resourceType with every
+// optional attribute unset.
+func testPolicyRuleModel(resourceType string) policyRuleModel {
+	return policyRuleModel{
+		ResourceType:               types.StringValue(resourceType),
+		RequiredAnnotations:        types.ListNull(types.StringType),
+		RequiredLabels:             types.ListNull(types.StringType),
+		AllowedFolderPathPrefixes:  types.ListNull(types.StringType),
+		RequireNotificationChannel: types.BoolNull(),
+		Enforcement:                types.StringNull(),
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	checkRule := testPolicyRuleModel("dash0_check_rule")
+	checkRule.RequiredAnnotations = stringListValue("severity", "runbook_url")
+	checkRule.Enforcement = types.StringValue("warn")
+
+	var diags diag.Diagnostics
+	p := parsePolicy(context.Background(), &policyBlockModel{Rules: []policyRuleModel{checkRule}}, &diags)
+
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	require.Len(t, p.rules, 1)
+	assert.Equal(t, policyRule{
+		resourceType:        "dash0_check_rule",
+		requiredAnnotations: []string{"severity", "runbook_url"},
+		enforcement:         "warn",
+	}, p.rules[0])
+}
+
+func TestParsePolicy_DefaultsToError(t *testing.T) {
+	var diags diag.Diagnostics
+	p := parsePolicy(context.Background(), &policyBlockModel{Rules: []policyRuleModel{testPolicyRuleModel("dash0_view")}}, &diags)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, policyEnforcementError, p.rules[0].enforcement)
+}
+
+func TestParsePolicy_ViewFolderPath(t *testing.T) {
+	view := testPolicyRuleModel("dash0_view")
+	view.AllowedFolderPathPrefixes = stringListValue("/teams")
+
+	var diags diag.Diagnostics
+	p := parsePolicy(context.Background(), &policyBlockModel{Rules: []policyRuleModel{view}}, &diags)
+
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	assert.Equal(t, []string{"/teams"}, p.rules[0].allowedFolderPathPrefixes)
+}
+
+func TestParsePolicy_NoBlock(t *testing.T) {
+	var diags diag.Diagnostics
+	p := parsePolicy(context.Background(), nil, &diags)
+
+	assert.False(t, diags.HasError())
+	assert.Empty(t, p.rules)
+}
+
+func TestParsePolicy_Invalid(t *testing.T) {
+	cases := []struct {
+		name         string
+		modify       func(*policyRuleModel)
+		expectPath   path.Path
+		expectDetail string
+	}{
+		{
+			name:         "unknown resource type",
+			modify:       func(m *policyRuleModel) { m.ResourceType = types.StringValue("dash0_team") },
+			expectPath:   path.Root("policy").AtName("rule").AtListIndex(0).AtName("resource_type"),
+			expectDetail: `got: "dash0_team"`,
+		},
+		{
+			name:         "unknown enforcement",
+			modify:       func(m *policyRuleModel) { m.Enforcement = types.StringValue("fail") },
+			expectPath:   path.Root("policy").AtName("rule").AtListIndex(0).AtName("enforcement"),
+			expectDetail: `enforcement must be "warn" or "error", got: "fail"`,
+		},
+		{
+			name:         "folder path prefixes on a check rule",
+			modify:       func(m *policyRuleModel) { m.AllowedFolderPathPrefixes = stringListValue("/teams/") },
+			expectPath:   path.Root("policy").AtName("rule").AtListIndex(0).AtName("allowed_folder_path_prefixes"),
+			expectDetail: "only applies to dash0_dashboard and dash0_view",
+		},
+		{
+			name: "notification channel on a recording rule",
+			modify: func(m *policyRuleModel) {
+				m.ResourceType = types.StringValue("dash0_recording_rule")
+				m.RequireNotificationChannel = types.BoolValue(true)
+			},
+			expectPath:   path.Root("policy").AtName("rule").AtListIndex(0).AtName("require_notification_channel"),
+			expectDetail: "not to dash0_recording_rule",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			model := testPolicyRuleModel("dash0_check_rule")
+			tc.modify(&model)
+
+			var diags diag.Diagnostics
+			parsePolicy(context.Background(), &policyBlockModel{Rules: []policyRuleModel{model}}, &diags)
+
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Equal(t, "Invalid policy rule", diags.Errors()[0].Summary())
+			assert.Contains(t, diags.Errors()[0].Detail(), tc.expectDetail)
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectPath, withPath.Path())
+		})
+	}
+}
+
+const policyTestPrometheusRule = `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+  annotations:
+    team: checkout
+spec:
+  groups:
+    - name: Alerting
+      rules:
+        - alert: HighErrorRate
+          expr: up == 0
+          labels:
+            severity: critical
+          annotations:
+            runbook_url: https://runbooks.example.com/checkout
+        - alert: HighLatency
+          expr: up == 0
+        - record: checkout:requests:rate5m
+          expr: sum(rate(requests_total[5m]))
+`
+
+func TestPolicy_Enforce_CheckRules(t *testing.T) {
+	p := policy{rules: []policyRule{{
+		resourceType:        "dash0_check_rule",
+		requiredAnnotations: []string{"runbook_url", "team"},
+		requiredLabels:      []string{"severity"},
+		enforcement:         policyEnforcementError,
+	}}}
+
+	var diags diag.Diagnostics
+	p.enforce("dash0_prometheus_rule", policyTestPrometheusRule, path.Root("prometheus_rule_yaml"), &diags)
+
+	// HighErrorRate inherits `team` from the document and sets the rest
+	// itself; HighLatency lacks its own annotation and label; the recording
+	// rule is not a check rule.
+	require.Equal(t, 2, diags.ErrorsCount(), "diagnostics: %v", diags)
+	assert.Equal(t, "Policy violation", diags.Errors()[0].Summary())
+	assert.Equal(t, `spec.groups[0] ("Alerting").rules[1] ("HighLatency"): missing required annotation "runbook_url" (policy for dash0_check_rule, configured in the provider's policy block).`, diags.Errors()[0].Detail())
+	assert.Contains(t, diags.Errors()[1].Detail(), `rules[1] ("HighLatency"): missing required label "severity"`)
+}
+
+func TestPolicy_Enforce_RecordingRulesInPrometheusRule(t *testing.T) {
+	p := policy{rules: []policyRule{{
+		resourceType:   "dash0_recording_rule",
+		requiredLabels: []string{"team"},
+		enforcement:    policyEnforcementWarn,
+	}}}
+
+	var diags diag.Diagnostics
+	p.enforce("dash0_prometheus_rule", policyTestPrometheusRule, path.Root("prometheus_rule_yaml"), &diags)
+
+	assert.False(t, diags.HasError())
+	require.Equal(t, 1, diags.WarningsCount(), "diagnostics: %v", diags)
+	assert.Contains(t, diags.Warnings()[0].Detail(), `rules[2] ("checkout:requests:rate5m"): missing required label "team"`)
+}
+
+func TestPolicy_Enforce_CheckRuleNotificationChannel(t *testing.T) {
+	p := policy{rules: []policyRule{{
+		resourceType:               "dash0_check_rule",
+		requireNotificationChannel: true,
+		enforcement:                policyEnforcementError,
+	}}}
+
+	withChannel := `spec:
+  groups:
+    - name: Alerting
+      rules:
+        - alert: Up
+          expr: up == 0
+          annotations:
+            dash0.com/notification-channel-ids: 2c5a3f1e-0000-0000-0000-000000000000
+`
+	var diags diag.Diagnostics
+	p.enforce("dash0_check_rule", withChannel, path.Root("check_rule_yaml"), &diags)
+	assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+
+	withoutChannel := `spec:
+  groups:
+    - name: Alerting
+      rules:
+        - alert: Up
+          expr: up == 0
+`
+	diags = nil
+	p.enforce("dash0_check_rule", withoutChannel, path.Root("check_rule_yaml"), &diags)
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), `no notification channel in the "dash0.com/notification-channel-ids" annotation`)
+}
+
+func TestPolicy_Enforce_DashboardFolderPath(t *testing.T) {
+	p := policy{rules: []policyRule{{
+		resourceType:              "dash0_dashboard",
+		allowedFolderPathPrefixes: []string{"/teams/", "/platform/"},
+		enforcement:               policyEnforcementError,
+	}}}
+
+	cases := []struct {
+		name         string
+		annotations  string
+		expectDetail string
+	}{
+		{name: "allowed prefix", annotations: "\n    dash0.com/folder-path: /teams/checkout"},
+		{name: "no folder path", annotations: " {}", expectDetail: `"checkout": missing the "dash0.com/folder-path" annotation; it must start with one of: /teams/, /platform/`},
+		{name: "prefix itself", annotations: "\n    dash0.com/folder-path: /platform"},
+		{name: "other prefix", annotations: "\n    dash0.com/folder-path: /scratch", expectDetail: `folder path "/scratch" is not within any of the allowed prefixes`},
+		{name: "prefix without segment boundary", annotations: "\n    dash0.com/folder-path: /teams-archive/checkout", expectDetail: `folder path "/teams-archive/checkout" is not within any of the allowed prefixes`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			p.enforce("dash0_dashboard", `kind: PersesDashboard
+metadata:
+  name: checkout
+  annotations:`+tc.annotations+`
+spec: {}
+`, path.Root("dashboard_yaml"), &diags)
+
+			if tc.expectDetail == "" {
+				assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+				return
+			}
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Contains(t, diags.Errors()[0].Detail(), tc.expectDetail)
+		})
+	}
+}
+
+func TestPolicy_Enforce_SyntheticCheckNotificationChannel(t *testing.T) {
+	p := policy{rules: []policyRule{{
+		resourceType:               "dash0_synthetic_check",
+		requireNotificationChannel: true,
+		requiredLabels:             []string{"team"},
+		enforcement:                policyEnforcementWarn,
+	}}}
+
+	var diags diag.Diagnostics
+	p.enforce("dash0_synthetic_check", `kind: Dash0SyntheticCheck
+metadata:
+  name: examplecom
+  labels:
+    team: web
+spec:
+  notifications:
+    channels: []
+`, path.Root("synthetic_check_yaml"), &diags)
+
+	assert.False(t, diags.HasError())
+	require.Equal(t, 1, diags.WarningsCount(), "diagnostics: %v", diags)
+	assert.Contains(t, diags.Warnings()[0].Detail(), `"examplecom": no notification channel in spec.notifications.channels`)
+}
+
+func TestPolicy_Enforce_OtherResourceTypeIgnored(t *testing.T) {
+	p := policy{rules: []policyRule{{
+		resourceType:        "dash0_view",
+		requiredAnnotations: []string{"team"},
+		enforcement:         policyEnforcementError,
+	}}}
+
+	var diags diag.Diagnostics
+	p.enforce("dash0_dashboard", "kind: PersesDashboard\nmetadata:\n  name: home\nspec: {}\n", path.Root("dashboard_yaml"), &diags)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, diags.WarningsCount())
+}
+
+func TestPolicy_Enforce_ViewFolderPath(t *testing.T) {
+	p := policy{rules: []policyRule{{
+		resourceType:              "dash0_view",
+		allowedFolderPathPrefixes: []string{"/teams"},
+		enforcement:               policyEnforcementError,
+	}}}
+
+	var diags diag.Diagnostics
+	p.enforce("dash0_view", `kind: Dash0View
+metadata:
+  name: errors
+  annotations:
+    dash0.com/folder-path: /teams/checkout
+spec: {}
+`, path.Root("view_yaml"), &diags)
+	assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+
+	p.enforce("dash0_view", `kind: Dash0View
+metadata:
+  name: errors
+  annotations:
+    dash0.com/folder-path: /scratch
+spec: {}
+`, path.Root("view_yaml"), &diags)
+	require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+	assert.Contains(t, diags.Errors()[0].Detail(), `"errors": folder path "/scratch" is not within any of the allowed prefixes: /teams`)
+}
@@ -0,0 +1,530 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &PrometheusRuleResource{}
+	_ resource.ResourceWithConfigure      = &PrometheusRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &PrometheusRuleResource{}
+	_ resource.ResourceWithValidateConfig = &PrometheusRuleResource{}
+)
+
+// NewPrometheusRuleResource is a helper function to simplify the provider implementation.
+func NewPrometheusRuleResource() resource.Resource {
+	return &PrometheusRuleResource{}
+}
+
+// PrometheusRuleResource manages a whole PrometheusRule document as a unit.
+// Every alerting rule in the document becomes a Dash0 check rule and every
+// recording rule a Dash0 recording rule (the "members"), each under an origin
+// derived from the resource's own origin and the rule's "<group>/<name>" key
+// (see prometheusRuleMemberOrigin).
+type PrometheusRuleResource struct {
+	client client.Client
+	// defaultDataset is the provider-level default dataset, inherited by this
+	// resource's `dataset` attribute when it is omitted from configuration.
+	defaultDataset string
+	// policy is the provider-level policy, enforced in ModifyPlan.
+	policy policy
+	// planValidation is the provider's `plan_validation` setting, applied in
+	// ModifyPlan.
+	planValidation planValidation
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+}
+
+// prometheusRuleModel is the Terraform state model for a PrometheusRule resource.
+type prometheusRuleModel struct {
+	Origin             types.String `tfsdk:"origin"`
+	Dataset            types.String `tfsdk:"dataset"`
+	PrometheusRuleYaml types.String `tfsdk:"prometheus_rule_yaml"`
+	RuleIDs            types.Map    `tfsdk:"rule_ids"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PrometheusRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.resourceProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+	r.defaultDataset = data.defaultDataset
+	r.policy = data.policy
+	r.planValidation = data.planValidation
+	r.deletionProtection = data.deletionProtection
+}
+
+func (r *PrometheusRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prometheus_rule"
+}
+
+// ValidateConfig checks prometheus_rule_yaml offline, so `terraform validate` reports an
+// invalid PromQL expression, duration, or label or annotation key without
+// credentials and before any rule is written (see validatePrometheusRuleYAML).
+func (r *PrometheusRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model prometheusRuleModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.PrometheusRuleYaml.IsNull() || model.PrometheusRuleYaml.IsUnknown() {
+		return
+	}
+	validatePrometheusRuleYAML(model.PrometheusRuleYaml.ValueString(), path.Root("prometheus_rule_yaml"), &resp.Diagnostics)
+}
+
+func (r *PrometheusRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manages a whole [PrometheusRule](https://prometheus-operator.dev/docs/api-reference/api/#monitoring.coreos.com/v1.PrometheusRule) document, with any number of groups and rules, as a unit. Every alerting rule in the document is created as a Dash0 check rule and every recording rule as a Dash0 recording rule, the same way ` + "`dash0_check_rule`" + ` and ` + "`dash0_recording_rule`" + ` would create them. Adding, changing, or removing a rule in the document creates, updates, or deletes the corresponding Dash0 asset on the next apply.
+
+Use this resource instead of one ` + "`dash0_check_rule`" + ` per rule when a PrometheusRule file written for Kubernetes should be managed verbatim. Import is not supported.`,
+
+		Attributes: map[string]schema.Attribute{
+			"origin": schema.StringAttribute{
+				Description: "A unique identifier for the document, automatically generated on creation. The origin of every rule created from the document is derived from it and from the rule's group and alert (or record) name, so a rule keeps its Dash0 identity across edits of the document as long as its group and name do not change.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the rules belong to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prometheus_rule_yaml": schema.StringAttribute{
+				Description: "The PrometheusRule document in YAML format. May contain any number of groups, each with any number of [alerting](https://prometheus.io/docs/prometheus/latest/configuration/alerting_rules/) and [recording](https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/) rules. Every rule is identified by its group name and its `alert` or `record` name; when the same name appears more than once in a group, later occurrences are told apart by position (`<group>/<name>#2`, `#3`, and so on). The document's top-level `metadata.annotations` are merged into every alerting rule's own annotations, the rule's own annotations taking precedence, as for `dash0_check_rule`. Each rule's `expr` is parsed as PromQL, and its `for` and `keep_firing_for` durations and label and annotation keys are checked, at plan time.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					yamlKinds["prometheus_rule"].planModifier(),
+				},
+			},
+			"rule_ids": schema.MapAttribute{
+				Description: "The server-assigned identifier of every rule created from the document, keyed by `<group>/<alert>` for alerting rules and `<group>/<record>` for recording rules. Check rule ids equal their origin; recording rule ids have the form `recording_rule_group_<ulid>`. Resolved by the provider on a best-effort basis; an entry is null when its id could not be determined.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("Prometheus rule")
+}
+
+// ModifyPlan plans the provider-level deletion protection default, enforces
+// the provider-level policy and plan validation on the planned document, keeps
+// rule_ids from state while the document still contains the same set of rules,
+// and marks it unknown when rules are added or removed.
+func (r *PrometheusRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	r.policy.enforcePlan(ctx, req.Plan, "dash0_prometheus_rule", path.Root("prometheus_rule_yaml"), &resp.Diagnostics)
+	r.planValidation.validatePlan(ctx, r.client, req.Plan, "dash0_prometheus_rule", path.Root("prometheus_rule_yaml"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing more to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state prometheusRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.PrometheusRuleYaml.IsUnknown() {
+		return
+	}
+
+	members, err := converter.SplitPrometheusRules(plan.PrometheusRuleYaml.ValueString())
+	if err != nil {
+		// Surfaced by Create/Update; the plan keeps rule_ids unknown.
+		return
+	}
+	stateIDs := state.RuleIDs.Elements()
+	sameRules := len(members) == len(stateIDs)
+	for _, member := range members {
+		if _, ok := stateIDs[member.Key]; !ok {
+			sameRules = false
+			break
+		}
+	}
+	if sameRules {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rule_ids"), state.RuleIDs)...)
+	}
+}
+
+// memberOriginSanitizer matches runs of characters that are not safe to use
+// in an origin.
+var memberOriginSanitizer = regexp.MustCompile(`[^a-z0-9]+`)
+
+// prometheusRuleMemberOrigin derives the origin of a rule created from a
+// PrometheusRule document from the document's origin and the rule's key. The
+// readable part is a sanitized, truncated copy of the key; the hash of the
+// exact key keeps origins unique when two keys sanitize to the same string.
+func prometheusRuleMemberOrigin(origin, key string) string {
+	slug := strings.Trim(memberOriginSanitizer.ReplaceAllString(strings.ToLower(key), "-"), "-")
+	if len(slug) > 48 {
+		slug = strings.TrimRight(slug[:48], "-")
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return fmt.Sprintf("%s_%s_%08x", origin, slug, h.Sum32())
+}
+
+// splitPrometheusRules splits yamlStr into its members, reporting a failure
+// as an "Invalid PrometheusRule" error.
+func splitPrometheusRules(yamlStr string, diags *diag.Diagnostics) []converter.PrometheusRuleMember {
+	members, err := converter.SplitPrometheusRules(yamlStr)
+	if err != nil {
+		diags.AddError("Invalid PrometheusRule", fmt.Sprintf("PrometheusRule definition is not valid: %s", err))
+		return nil
+	}
+	return members
+}
+
+// upsertMember creates or updates a single member. Both the check-rule and
+// the recording-rule endpoints upsert by origin, so the same call serves
+// Create and Update.
+func (r *PrometheusRuleResource) upsertMember(ctx context.Context, origin string, member converter.PrometheusRuleMember, dataset string) error {
+	memberOrigin := prometheusRuleMemberOrigin(origin, member.Key)
+	if member.IsRecording {
+		jsonBody, err := converter.ConvertYAMLToJSON(member.YAML)
+		if err != nil {
+			return fmt.Errorf("unable to convert recording rule YAML to JSON: %w", err)
+		}
+		return r.client.UpdateRecordingRule(ctx, memberOrigin, jsonBody, dataset)
+	}
+	return r.client.UpdateCheckRule(ctx, memberOrigin, member.YAML, dataset)
+}
+
+// deleteMember deletes a single member. A member that no longer exists counts
+// as deleted, so a retried apply does not fail on members removed by an
+// earlier, partially failed one.
+func (r *PrometheusRuleResource) deleteMember(ctx context.Context, origin string, member converter.PrometheusRuleMember, dataset string) error {
+	memberOrigin := prometheusRuleMemberOrigin(origin, member.Key)
+	var err error
+	if member.IsRecording {
+		err = r.client.DeleteRecordingRule(ctx, memberOrigin, dataset)
+	} else {
+		err = r.client.DeleteCheckRule(ctx, memberOrigin, dataset)
+	}
+	if err != nil && !dash0.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// resolveRuleIDs populates rule_ids by resolving every member's server-assigned
+// id. The ids are best-effort metadata: failures are surfaced as a single
+// warning and leave the affected entries null rather than failing the
+// operation.
+func (r *PrometheusRuleResource) resolveRuleIDs(ctx context.Context, model *prometheusRuleModel, members []converter.PrometheusRuleMember, diags *diag.Diagnostics) {
+	dataset := model.Dataset.ValueString()
+	ids := make(map[string]attr.Value, len(members))
+	var failed []string
+	for _, member := range members {
+		memberOrigin := prometheusRuleMemberOrigin(model.Origin.ValueString(), member.Key)
+		var id string
+		var err error
+		if member.IsRecording {
+			id, err = r.client.ResolveRecordingRule(ctx, memberOrigin, dataset)
+		} else {
+			id, _, err = r.client.ResolveCheckRule(ctx, memberOrigin, dataset)
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", member.Key, err))
+			ids[member.Key] = types.StringNull()
+			continue
+		}
+		ids[member.Key] = stringOrNull(id)
+	}
+	if len(failed) > 0 {
+		diags.AddWarning(
+			"Unable to resolve rule metadata",
+			fmt.Sprintf("The rules were saved successfully, but the ids of some of them could not be determined:\n%s", strings.Join(failed, "\n")),
+		)
+	}
+	model.RuleIDs = types.MapValueMust(types.StringType, ids)
+}
+
+func (r *PrometheusRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model prometheusRuleModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Origin = types.StringValue("tf_" + uuid.New().String())
+	if model.Dataset.IsNull() || model.Dataset.IsUnknown() {
+		model.Dataset = types.StringValue(r.defaultDataset)
+	}
+
+	members := splitPrometheusRules(model.PrometheusRuleYaml.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataset := model.Dataset.ValueString()
+	for i, member := range members {
+		if err := r.upsertMember(ctx, model.Origin.ValueString(), member, dataset); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create rule %s, got error: %s", member.Key, err))
+			// Roll back the members created so far, so a failed create leaves
+			// nothing behind that Terraform does not know about.
+			for _, created := range members[:i] {
+				if err := r.deleteMember(ctx, model.Origin.ValueString(), created, dataset); err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll back rule %s, got error: %s", created.Key, err))
+				}
+			}
+			return
+		}
+	}
+
+	// Resolve the ids of the newly created rules (best-effort).
+	r.resolveRuleIDs(ctx, &model, members, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created a prometheus rule resource")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PrometheusRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state prometheusRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := splitPrometheusRules(state.PrometheusRuleYaml.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compare every member against the API. The API response of a member
+	// that has drifted replaces it, and a member that no longer exists is
+	// dropped, so the reassembled document shows the server-side state.
+	dataset := state.Dataset.ValueString()
+	current := make([]converter.PrometheusRuleMember, 0, len(members))
+	drifted := false
+	for _, member := range members {
+		memberOrigin := prometheusRuleMemberOrigin(state.Origin.ValueString(), member.Key)
+		var apiResponse string
+		var err error
+		var preservedKeys []string
+		if member.IsRecording {
+			apiResponse, err = r.client.GetRecordingRule(ctx, memberOrigin, dataset)
+		} else {
+			apiResponse, err = r.client.GetCheckRule(ctx, memberOrigin, dataset)
+			preservedKeys = []string{converter.AnnotationSharing}
+		}
+		if err != nil {
+			if dash0.IsNotFound(err) {
+				tflog.Debug(ctx, fmt.Sprintf("Rule %s no longer exists, removing it from the document", member.Key))
+				drifted = true
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rule %s, got error: %s", member.Key, err))
+			return
+		}
+		if !member.IsRecording {
+			// See CheckRuleResource.Read: the API does not preserve metadata.name.
+			apiResponse = injectMetadataName(member.YAML, apiResponse)
+		}
+
+		additionalIgnored := converter.FieldsAbsentFromYAML(member.YAML, converter.ConditionallyIgnoredFields)
+		equivalent, err := converter.ResourceYAMLEquivalent(member.YAML, apiResponse, additionalIgnored, preservedKeys)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Prometheus Rule Comparison Error",
+				fmt.Sprintf("Error comparing rule %s: %s. Using API response as source of truth.", member.Key, err),
+			)
+			equivalent = false
+		}
+		if !equivalent {
+			tflog.Debug(ctx, fmt.Sprintf("Rule %s has changed, updating state", member.Key))
+			member.YAML = apiResponse
+			drifted = true
+		}
+		current = append(current, member)
+	}
+
+	tflog.Trace(ctx, "read a prometheus rule resource")
+
+	// With every member gone, clear state so the next plan re-creates the
+	// resource; a document without rules could not be split again.
+	if len(current) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("No rule of Prometheus rule %s exists on the server any more; removing from state", state.Origin.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if drifted {
+		joined, err := converter.JoinPrometheusRules(prometheusRuleName(state.PrometheusRuleYaml.ValueString()), current)
+		if err != nil {
+			resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to reassemble PrometheusRule document, got error: %s", err))
+			return
+		}
+		state.PrometheusRuleYaml = types.StringValue(joined)
+	} else {
+		tflog.Debug(ctx, "Prometheus rule is equivalent, ignoring changes in metadata fields")
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PrometheusRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get current state
+	var state prometheusRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan prometheusRuleModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := splitPrometheusRules(plan.PrometheusRuleYaml.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The state's document was valid when it was written; if it no longer
+	// splits, there is nothing to clean up that we know of.
+	previous, _ := converter.SplitPrometheusRules(state.PrometheusRuleYaml.ValueString())
+
+	// Update the existing rules (dataset changes force recreation via RequiresReplace)
+	plan.Origin = state.Origin
+	origin := plan.Origin.ValueString()
+	dataset := plan.Dataset.ValueString()
+
+	// Member origins are derived from their keys, so upserting every member
+	// and deleting the ones that are gone is safe to repeat: if this apply
+	// fails part-way, the prior state is kept and the next apply converges.
+	wanted := make(map[string]bool, len(members))
+	for _, member := range members {
+		wanted[member.Key] = true
+		if err := r.upsertMember(ctx, origin, member, dataset); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update rule %s, got error: %s", member.Key, err))
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+	for _, member := range previous {
+		if wanted[member.Key] {
+			continue
+		}
+		if err := r.deleteMember(ctx, origin, member, dataset); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete rule %s, got error: %s", member.Key, err))
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+
+	// Resolve the ids, which may have changed as rules were added or removed (best-effort).
+	r.resolveRuleIDs(ctx, &plan, members, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated a prometheus rule resource")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PrometheusRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state prometheusRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if refuseProtectedDeletion("Prometheus rule", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+
+	// A document that cannot be split, such as one left without rules after
+	// every member was deleted outside Terraform, has nothing left to delete.
+	var splitDiags diag.Diagnostics
+	members := splitPrometheusRules(state.PrometheusRuleYaml.ValueString(), &splitDiags)
+	if splitDiags.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Prometheus rule %s holds no rules to delete", state.Origin.ValueString()))
+		return
+	}
+
+	// Attempt every member even if one fails, so a single failure does not
+	// leave the rest behind; the errors are reported together.
+	for _, member := range members {
+		if err := r.deleteMember(ctx, state.Origin.ValueString(), member, state.Dataset.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete rule %s, got error: %s", member.Key, err))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a prometheus rule resource")
+}
+
+// prometheusRuleName returns the metadata.name of a PrometheusRule document,
+// or an empty string when it has none or cannot be parsed.
+func prometheusRuleName(yamlStr string) string {
+	var doc struct {
+		Metadata struct {
+			Name string `yaml:"name"`
+		} `yaml:"metadata"`
+	}
+	if yaml.Unmarshal([]byte(yamlStr), &doc) != nil {
+		return ""
+	}
+	return doc.Metadata.Name
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+const testPrometheusRuleYaml = `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: shop
+spec:
+  groups:
+    - name: Availability
+      interval: 1m
+      rules:
+        - alert: ServiceDown
+          expr: up == 0
+          for: 5m
+        - record: job:up:sum
+          expr: sum by (job) (up)
+    - name: Latency
+      rules:
+        - alert: SlowRequests
+          expr: histogram_quantile(0.99, rate(http_request_duration_seconds_bucket[5m])) > 1
+`
+
+var prometheusRuleObjectType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"origin":               tftypes.String,
+		"dataset":              tftypes.String,
+		"prometheus_rule_yaml": tftypes.String,
+		"rule_ids":             tftypes.Map{ElementType: tftypes.String},
+		"deletion_protection":  tftypes.Bool,
+	},
+}
+
+func prometheusRuleSchema(t *testing.T) resource.SchemaResponse {
+	t.Helper()
+	var resp resource.SchemaResponse
+	(&PrometheusRuleResource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+	return resp
+}
+
+// prometheusRuleValue builds a raw object for the resource. A nil ruleIDs
+// produces a null rule_ids map.
+func prometheusRuleValue(origin, yamlStr string, ruleIDs map[string]string) tftypes.Value {
+	ids := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+	if ruleIDs != nil {
+		elems := map[string]tftypes.Value{}
+		for k, v := range ruleIDs {
+			elems[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		ids = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elems)
+	}
+	originValue := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	if origin != "" {
+		originValue = tftypes.NewValue(tftypes.String, origin)
+	}
+	return tftypes.NewValue(prometheusRuleObjectType, map[string]tftypes.Value{
+		"origin":               originValue,
+		"deletion_protection":  tftypes.NewValue(tftypes.Bool, nil),
+		"dataset":              tftypes.NewValue(tftypes.String, "test-dataset"),
+		"prometheus_rule_yaml": tftypes.NewValue(tftypes.String, yamlStr),
+		"rule_ids":             ids,
+	})
+}
+
+func TestPrometheusRuleResource_Metadata(t *testing.T) {
+	r := &PrometheusRuleResource{}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, resp)
+	assert.Equal(t, "dash0_prometheus_rule", resp.TypeName)
+}
+
+func TestPrometheusRuleMemberOrigin(t *testing.T) {
+	origin := prometheusRuleMemberOrigin("tf_abc", "Availability/ServiceDown")
+	assert.Regexp(t, `^tf_abc_availability-servicedown_[0-9a-f]{8}$`, origin)
+	assert.Equal(t, origin, prometheusRuleMemberOrigin("tf_abc", "Availability/ServiceDown"), "origins must be stable")
+	assert.NotEqual(t, origin, prometheusRuleMemberOrigin("tf_abc", "availability/servicedown"),
+		"keys that sanitize to the same slug must still get distinct origins")
+}
+
+func TestPrometheusRuleResource_Create_FansOutIntoMembers(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &PrometheusRuleResource{client: mockClient}
+	s := prometheusRuleSchema(t)
+
+	mockClient.On("UpdateCheckRule", mock.Anything, mock.MatchedBy(func(o string) bool { return o != "" }), mock.Anything, "test-dataset").Return(nil).Twice()
+	mockClient.On("UpdateRecordingRule", mock.Anything, mock.Anything, mock.Anything, "test-dataset").Return(nil).Once()
+	mockClient.On("ResolveCheckRule", mock.Anything, mock.Anything, "test-dataset").Return("check-id", "", nil)
+	mockClient.On("ResolveRecordingRule", mock.Anything, mock.Anything, "test-dataset").Return("recording_rule_group_01", nil)
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s.Schema, Raw: prometheusRuleValue("", testPrometheusRuleYaml, nil)}}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: s.Schema}}
+	r.Create(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+
+	var model prometheusRuleModel
+	resp.State.Get(context.Background(), &model)
+	ids := map[string]string{}
+	model.RuleIDs.ElementsAs(context.Background(), &ids, false)
+	assert.Equal(t, map[string]string{
+		"Availability/ServiceDown": "check-id",
+		"Availability/job:up:sum":  "recording_rule_group_01",
+		"Latency/SlowRequests":     "check-id",
+	}, ids)
+}
+
+func TestPrometheusRuleResource_Create_RollsBackOnFailure(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &PrometheusRuleResource{client: mockClient}
+	s := prometheusRuleSchema(t)
+
+	// The first check rule and the recording rule succeed; the last check
+	// rule fails, so the first two must be deleted again.
+	mockClient.On("UpdateCheckRule", mock.Anything, mock.MatchedBy(func(o string) bool { return !strings.Contains(o, "_latency-") }), mock.Anything, "test-dataset").Return(nil).Once()
+	mockClient.On("UpdateRecordingRule", mock.Anything, mock.Anything, mock.Anything, "test-dataset").Return(nil).Once()
+	mockClient.On("UpdateCheckRule", mock.Anything, mock.MatchedBy(func(o string) bool { return strings.Contains(o, "_latency-") }), mock.Anything, "test-dataset").Return(errors.New("boom")).Once()
+	mockClient.On("DeleteCheckRule", mock.Anything, mock.Anything, "test-dataset").Return(nil).Once()
+	mockClient.On("DeleteRecordingRule", mock.Anything, mock.Anything, "test-dataset").Return(nil).Once()
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s.Schema, Raw: prometheusRuleValue("", testPrometheusRuleYaml, nil)}}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: s.Schema}}
+	r.Create(context.Background(), req, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "Latency/SlowRequests")
+	mockClient.AssertExpectations(t)
+}
+
+func TestPrometheusRuleResource_Update_DeletesRemovedRules(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &PrometheusRuleResource{client: mockClient}
+	s := prometheusRuleSchema(t)
+
+	updated := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: shop
+spec:
+  groups:
+    - name: Availability
+      rules:
+        - alert: ServiceDown
+          expr: up == 0
+          for: 10m
+`
+	origin := "tf_doc"
+	mockClient.On("UpdateCheckRule", mock.Anything, prometheusRuleMemberOrigin(origin, "Availability/ServiceDown"), mock.Anything, "test-dataset").Return(nil).Once()
+	mockClient.On("DeleteRecordingRule", mock.Anything, prometheusRuleMemberOrigin(origin, "Availability/job:up:sum"), "test-dataset").Return(nil).Once()
+	mockClient.On("DeleteCheckRule", mock.Anything, prometheusRuleMemberOrigin(origin, "Latency/SlowRequests"), "test-dataset").Return(nil).Once()
+	mockClient.On("ResolveCheckRule", mock.Anything, mock.Anything, "test-dataset").Return("check-id", "", nil)
+
+	prior := map[string]string{"Availability/ServiceDown": "a", "Availability/job:up:sum": "b", "Latency/SlowRequests": "c"}
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: s.Schema, Raw: prometheusRuleValue(origin, testPrometheusRuleYaml, prior)},
+		Plan:  tfsdk.Plan{Schema: s.Schema, Raw: prometheusRuleValue(origin, updated, nil)},
+	}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: s.Schema}}
+	r.Update(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+
+	var model prometheusRuleModel
+	resp.State.Get(context.Background(), &model)
+	assert.Equal(t, origin, model.Origin.ValueString())
+	assert.Len(t, model.RuleIDs.Elements(), 1)
+}
+
+func TestPrometheusRuleResource_Read_ReportsDriftedMember(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &PrometheusRuleResource{client: mockClient}
+	s := prometheusRuleSchema(t)
+	origin := "tf_doc"
+
+	members, err := converter.SplitPrometheusRules(testPrometheusRuleYaml)
+	require.NoError(t, err)
+	for _, member := range members {
+		memberOrigin := prometheusRuleMemberOrigin(origin, member.Key)
+		switch member.Key {
+		case "Latency/SlowRequests":
+			// Changed out of band.
+			mockClient.On("GetCheckRule", mock.Anything, memberOrigin, "test-dataset").Return(`apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+spec:
+  groups:
+    - name: Latency
+      rules:
+        - alert: SlowRequests
+          expr: vector(1) > 0
+`, nil)
+		case "Availability/job:up:sum":
+			mockClient.On("GetRecordingRule", mock.Anything, memberOrigin, "test-dataset").Return(member.YAML, nil)
+		default:
+			mockClient.On("GetCheckRule", mock.Anything, memberOrigin, "test-dataset").Return(member.YAML, nil)
+		}
+	}
+
+	state := tfsdk.State{Schema: s.Schema, Raw: prometheusRuleValue(origin, testPrometheusRuleYaml, map[string]string{})}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var model prometheusRuleModel
+	resp.State.Get(context.Background(), &model)
+	assert.NotEqual(t, testPrometheusRuleYaml, model.PrometheusRuleYaml.ValueString())
+	assert.Contains(t, model.PrometheusRuleYaml.ValueString(), "vector(1) > 0")
+	assert.Contains(t, model.PrometheusRuleYaml.ValueString(), "ServiceDown")
+}
+
+func TestPrometheusRuleResource_Read_NoDrift(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &PrometheusRuleResource{client: mockClient}
+	s := prometheusRuleSchema(t)
+
+	members, err := converter.SplitPrometheusRules(testPrometheusRuleYaml)
+	require.NoError(t, err)
+	for _, member := range members {
+		method := "GetCheckRule"
+		if member.IsRecording {
+			method = "GetRecordingRule"
+		}
+		mockClient.On(method, mock.Anything, prometheusRuleMemberOrigin("tf_doc", member.Key), "test-dataset").Return(member.YAML, nil)
+	}
+
+	state := tfsdk.State{Schema: s.Schema, Raw: prometheusRuleValue("tf_doc", testPrometheusRuleYaml, map[string]string{})}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var yamlValue types.String
+	resp.State.GetAttribute(context.Background(), path.Root("prometheus_rule_yaml"), &yamlValue)
+	assert.Equal(t, testPrometheusRuleYaml, yamlValue.ValueString())
+}
+
+func TestPrometheusRuleResource_Read_AllMembersDeleted(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &PrometheusRuleResource{client: mockClient}
+	s := prometheusRuleSchema(t)
+
+	members, err := converter.SplitPrometheusRules(testPrometheusRuleYaml)
+	require.NoError(t, err)
+	notFound := &dash0.APIError{StatusCode: 404, Status: "404 Not Found"}
+	for _, member := range members {
+		method := "GetCheckRule"
+		if member.IsRecording {
+			method = "GetRecordingRule"
+		}
+		mockClient.On(method, mock.Anything, prometheusRuleMemberOrigin("tf_doc", member.Key), "test-dataset").Return("", notFound)
+	}
+
+	state := tfsdk.State{Schema: s.Schema, Raw: prometheusRuleValue("tf_doc", testPrometheusRuleYaml, map[string]string{})}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull(), "the resource must be removed from state")
+}
+
+// TestPrometheusRuleResource_Delete_NoRules covers state holding a document
+// without rules: there is nothing left to delete, so Delete succeeds without
+// calling the API.
+func TestPrometheusRuleResource_Delete_NoRules(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &PrometheusRuleResource{client: mockClient}
+	s := prometheusRuleSchema(t)
+
+	emptyYaml := "apiVersion: monitoring.coreos.com/v1\nkind: PrometheusRule\nmetadata:\n  name: shop\nspec:\n  groups: []\n"
+	state := tfsdk.State{Schema: s.Schema, Raw: prometheusRuleValue("tf_doc", emptyYaml, map[string]string{})}
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+}
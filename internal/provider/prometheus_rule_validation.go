@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// promqlFunctions are the functions rule expressions are parsed with: all of
+// Prometheus' functions, with the experimental ones such as sort_by_label
+// enabled, because plan-time validation must not reject a rule the Dash0 API
+// accepts. They are handed to each parser instead of setting the process-wide
+// parser.EnableExperimentalFunctions. The experimental aggregators limitk and
+// limit_ratio are only enabled by that switch and are therefore rejected.
+var promqlFunctions = func() map[string]*parser.Function {
+	functions := make(map[string]*parser.Function, len(parser.Functions))
+	for name, function := range parser.Functions {
+		enabled := *function
+		enabled.Experimental = false
+		functions[name] = &enabled
+	}
+	return functions
+}()
+
+// parsePromQL parses a PromQL expression with promqlFunctions.
+func parsePromQL(expr string) (parser.Expr, error) {
+	p := parser.NewParser(expr, parser.WithFunctions(promqlFunctions))
+	defer p.Close()
+	return p.ParseExpr()
+}
+
+// thresholdPlaceholder is the Dash0 check rule placeholder that the API
+// substitutes with the critical and degraded thresholds before evaluating
+// the expression. It is not PromQL, so it is replaced by a number literal
+// before parsing.
+const thresholdPlaceholder = "$__threshold"
+
+// annotationKeyPattern matches annotation keys: an optional DNS-style prefix
+// followed by a slash, then a name of alphanumerics, '-', '_' and '.' that
+// starts and ends with an alphanumeric. This covers both Prometheus-style
+// keys (summary) and Dash0 keys (dash0-threshold-critical, dash0.com/sharing).
+var annotationKeyPattern = regexp.MustCompile(`^([a-z0-9]([-a-z0-9.]*[a-z0-9])?/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+
+// prometheusRuleDocument is the subset of a PrometheusRule document checked
+// by validatePrometheusRuleYAML. Rules are kept as nodes so diagnostics can
+// name the line they start on.
+type prometheusRuleDocument struct {
+	Metadata struct {
+		Annotations map[string]interface{} `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Groups []struct {
+			Name     string      `yaml:"name"`
+			Interval string      `yaml:"interval"`
+			Rules    []yaml.Node `yaml:"rules"`
+		} `yaml:"groups"`
+	} `yaml:"spec"`
+}
+
+// prometheusRuleEntry is a single alerting or recording rule.
+type prometheusRuleEntry struct {
+	Alert         string                 `yaml:"alert"`
+	Record        string                 `yaml:"record"`
+	Expr          string                 `yaml:"expr"`
+	For           string                 `yaml:"for"`
+	KeepFiringFor string                 `yaml:"keep_firing_for"`
+	Labels        map[string]interface{} `yaml:"labels"`
+	Annotations   map[string]interface{} `yaml:"annotations"`
+}
+
+// validatePrometheusRuleYAML checks a PrometheusRule document offline, so
+// `terraform validate` catches mistakes without credentials and before any
+// rule is written:
+//   - every rule's expr parses as PromQL (with the Dash0 $__threshold
+//     placeholder standing in for a number),
+//   - the group interval and the rules' for and keep_firing_for are valid
+//     Prometheus durations,
+//   - label and annotation keys are syntactically valid.
+//
+// Every problem is reported as an error on attr, with a detail naming the
+// group and rule index (and the YAML line) it was found at. A document that
+// cannot be parsed at all is reported once and not checked further.
+func validatePrometheusRuleYAML(yamlStr string, attr path.Path, diags *diag.Diagnostics) {
+	var doc prometheusRuleDocument
+	if err := yaml.Unmarshal([]byte(yamlStr), &doc); err != nil {
+		diags.AddAttributeError(attr, "Invalid YAML", fmt.Sprintf("The rule definition is not valid YAML: %s", err))
+		return
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(doc.Metadata.Annotations)) {
+		if msg := annotationKeyError(key); msg != "" {
+			diags.AddAttributeError(attr, "Invalid annotation key", fmt.Sprintf("metadata.annotations: %s", msg))
+		}
+	}
+
+	for gi, group := range doc.Spec.Groups {
+		groupLocation := fmt.Sprintf("spec.groups[%d] (%q)", gi, group.Name)
+		if group.Interval != "" {
+			if _, err := model.ParseDuration(group.Interval); err != nil {
+				diags.AddAttributeError(attr, "Invalid duration", fmt.Sprintf("%s.interval: %s", groupLocation, err))
+			}
+		}
+
+		for ri := range group.Rules {
+			node := &group.Rules[ri]
+			var rule prometheusRuleEntry
+			if err := node.Decode(&rule); err != nil {
+				diags.AddAttributeError(attr, "Invalid rule",
+					fmt.Sprintf("%s.rules[%d] (line %d): %s", groupLocation, ri, node.Line, err))
+				continue
+			}
+			location := fmt.Sprintf("%s.rules[%d]", groupLocation, ri)
+			if name := ruleName(rule); name != "" {
+				location += fmt.Sprintf(" (%q, line %d)", name, node.Line)
+			} else {
+				location += fmt.Sprintf(" (line %d)", node.Line)
+			}
+			validatePrometheusRuleEntry(rule, location, attr, diags)
+		}
+	}
+}
+
+// validatePrometheusRuleEntry checks a single rule; location prefixes every
+// diagnostic detail.
+func validatePrometheusRuleEntry(rule prometheusRuleEntry, location string, attr path.Path, diags *diag.Diagnostics) {
+	if strings.TrimSpace(rule.Expr) == "" {
+		diags.AddAttributeError(attr, "Missing PromQL expression", fmt.Sprintf("%s: expr must be set", location))
+	} else if _, err := parsePromQL(strings.ReplaceAll(rule.Expr, thresholdPlaceholder, "0")); err != nil {
+		diags.AddAttributeError(attr, "Invalid PromQL expression", fmt.Sprintf("%s.expr: %s", location, err))
+	}
+
+	for _, d := range []struct{ field, value string }{{"for", rule.For}, {"keep_firing_for", rule.KeepFiringFor}} {
+		if d.value == "" {
+			continue
+		}
+		if _, err := model.ParseDuration(d.value); err != nil {
+			diags.AddAttributeError(attr, "Invalid duration", fmt.Sprintf("%s.%s: %s", location, d.field, err))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(rule.Labels)) {
+		if msg := labelKeyError(key); msg != "" {
+			diags.AddAttributeError(attr, "Invalid label key", fmt.Sprintf("%s.labels: %s", location, msg))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(rule.Annotations)) {
+		if msg := annotationKeyError(key); msg != "" {
+			diags.AddAttributeError(attr, "Invalid annotation key", fmt.Sprintf("%s.annotations: %s", location, msg))
+		}
+	}
+}
+
+// labelKeyError describes what is wrong with a label key, or returns an
+// empty string when it is valid. Prometheus accepts any UTF-8 label name,
+// except that names starting with "__" are reserved for internal use.
+func labelKeyError(key string) string {
+	switch {
+	case key == "":
+		return "label keys must not be empty"
+	case !utf8.ValidString(key):
+		return fmt.Sprintf("label key %q is not valid UTF-8", key)
+	case strings.HasPrefix(key, "__"):
+		return fmt.Sprintf("label key %q starts with \"__\", which is reserved for internal use", key)
+	}
+	return ""
+}
+
+// annotationKeyError describes what is wrong with an annotation key, or
+// returns an empty string when it is valid.
+func annotationKeyError(key string) string {
+	if !annotationKeyPattern.MatchString(key) {
+		return fmt.Sprintf("annotation key %q must consist of alphanumerics, '-', '_' or '.', start and end with an alphanumeric, and may have a DNS-style prefix followed by '/'", key)
+	}
+	return ""
+}
+
+// ruleName returns the alert or record name of a rule.
+func ruleName(rule prometheusRuleEntry) string {
+	if rule.Alert != "" {
+		return rule.Alert
+	}
+	return rule.Record
+}
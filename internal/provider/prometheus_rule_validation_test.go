@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePrometheusRuleYAML_Valid(t *testing.T) {
+	var diags diag.Diagnostics
+	validatePrometheusRuleYAML(`apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: checkout
+  annotations:
+    dash0.com/notification-channel-ids: abc
+    dash0-threshold-critical: "40"
+spec:
+  groups:
+    - name: Alerting
+      interval: 1m0s
+      rules:
+        - alert: Checkout Error Rate
+          expr: sum(rate(http_server_request_duration_seconds_count{service_name="checkout"}[5m])) > $__threshold
+          for: 5m
+          keep_firing_for: 0s
+          labels:
+            severity: critical
+          annotations:
+            summary: High error rate
+            dash0-enabled: true
+        - record: checkout:requests:rate5m
+          expr: sum(rate(http_server_request_duration_seconds_count[5m]))
+`, path.Root("check_rule_yaml"), &diags)
+
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+}
+
+func TestValidatePrometheusRuleYAML_Errors(t *testing.T) {
+	cases := []struct {
+		name          string
+		rules         string
+		expectSummary string
+		expectDetail  string
+	}{
+		{
+			name: "unparsable expression",
+			rules: `
+        - alert: Broken
+          expr: sum(rate(up[5m])`,
+			expectSummary: "Invalid PromQL expression",
+			expectDetail:  `spec.groups[0] ("Alerting").rules[0] ("Broken", line 9).expr:`,
+		},
+		{
+			name: "missing expression",
+			rules: `
+        - alert: Empty`,
+			expectSummary: "Missing PromQL expression",
+			expectDetail:  `rules[0] ("Empty", line 9): expr must be set`,
+		},
+		{
+			name: "invalid for",
+			rules: `
+        - alert: Up
+          expr: up == 0
+          for: 5 minutes`,
+			expectSummary: "Invalid duration",
+			expectDetail:  `rules[0] ("Up", line 9).for:`,
+		},
+		{
+			name: "invalid keep_firing_for",
+			rules: `
+        - alert: Up
+          expr: up == 0
+          keep_firing_for: -1m`,
+			expectSummary: "Invalid duration",
+			expectDetail:  `.keep_firing_for:`,
+		},
+		{
+			name: "reserved label key",
+			rules: `
+        - alert: Up
+          expr: up == 0
+          labels:
+            __name__: up`,
+			expectSummary: "Invalid label key",
+			expectDetail:  `label key "__name__" starts with "__"`,
+		},
+		{
+			name: "invalid annotation key",
+			rules: `
+        - alert: Up
+          expr: up == 0
+          annotations:
+            "run book": https://example.com`,
+			expectSummary: "Invalid annotation key",
+			expectDetail:  `annotation key "run book"`,
+		},
+		{
+			name: "second rule is reported by index",
+			rules: `
+        - alert: Up
+          expr: up == 0
+        - record: broken
+          expr: rate(up)`,
+			expectSummary: "Invalid PromQL expression",
+			expectDetail:  `rules[1] ("broken", line 11).expr:`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validatePrometheusRuleYAML(`apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test
+spec:
+  groups:
+    - name: Alerting
+      rules:`+tc.rules+"\n", path.Root("check_rule_yaml"), &diags)
+
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			errDiag := diags.Errors()[0]
+			assert.Equal(t, tc.expectSummary, errDiag.Summary())
+			assert.Contains(t, errDiag.Detail(), tc.expectDetail)
+			withPath, ok := errDiag.(diag.DiagnosticWithPath)
+			require.True(t, ok, "diagnostic should be attributed to the YAML attribute")
+			assert.Equal(t, path.Root("check_rule_yaml"), withPath.Path())
+		})
+	}
+}
+
+// TestValidatePrometheusRuleYAML_ExperimentalFunction covers an experimental
+// PromQL function, which the Dash0 API evaluates: it is accepted without
+// enabling experimental functions for the whole process.
+func TestValidatePrometheusRuleYAML_ExperimentalFunction(t *testing.T) {
+	var diags diag.Diagnostics
+	validatePrometheusRuleYAML(`spec:
+  groups:
+    - name: Sorted
+      rules:
+        - record: up:sorted
+          expr: sort_by_label(up, "instance")
+`, path.Root("recording_rule_yaml"), &diags)
+
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.False(t, parser.EnableExperimentalFunctions)
+}
+
+func TestValidatePrometheusRuleYAML_InvalidGroupInterval(t *testing.T) {
+	var diags diag.Diagnostics
+	validatePrometheusRuleYAML(`spec:
+  groups:
+    - name: Slow
+      interval: often
+      rules:
+        - record: up:sum
+          expr: sum(up)
+`, path.Root("recording_rule_yaml"), &diags)
+
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "Invalid duration", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), `spec.groups[0] ("Slow").interval:`)
+}
+
+func TestValidatePrometheusRuleYAML_InvalidYAML(t *testing.T) {
+	var diags diag.Diagnostics
+	validatePrometheusRuleYAML("invalid: yaml: content: [", path.Root("check_rule_yaml"), &diags)
+
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "Invalid YAML", diags.Errors()[0].Summary())
+}
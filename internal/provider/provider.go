@@ -11,6 +11,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,8 +29,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider            = &dash0Provider{}
-	_ provider.ProviderWithActions = &dash0Provider{}
+	_ provider.Provider                       = &dash0Provider{}
+	_ provider.ProviderWithActions            = &dash0Provider{}
+	_ provider.ProviderWithEphemeralResources = &dash0Provider{}
+	_ provider.ProviderWithFunctions          = &dash0Provider{}
+	_ provider.ProviderWithListResources      = &dash0Provider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -44,12 +52,17 @@ type dash0Provider struct {
 
 // provider-level config model
 type providerConfigModel struct {
-	URL        types.String `tfsdk:"url"`
-	AuthToken  types.String `tfsdk:"auth_token"`
-	OtlpURL    types.String `tfsdk:"otlp_url"`
-	Profile    types.String `tfsdk:"profile"`
-	Dataset    types.String `tfsdk:"dataset"`
-	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	URL                types.String      `tfsdk:"url"`
+	AuthToken          types.String      `tfsdk:"auth_token"`
+	OtlpURL            types.String      `tfsdk:"otlp_url"`
+	Profile            types.String      `tfsdk:"profile"`
+	Dataset            types.String      `tfsdk:"dataset"`
+	MaxRetries         types.Int64       `tfsdk:"max_retries"`
+	PlanValidation     types.String      `tfsdk:"plan_validation"`
+	ConflictDetection  types.Bool        `tfsdk:"conflict_detection"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool        `tfsdk:"adopt_existing"`
+	Policy             *policyBlockModel `tfsdk:"policy"`
 }
 
 // resourceProviderData is what Configure stores as resp.ResourceData. It
@@ -59,6 +72,21 @@ type providerConfigModel struct {
 type resourceProviderData struct {
 	client         client.Client
 	defaultDataset string
+	// policy holds the conventions from the provider's `policy` block, which
+	// resources enforce in ModifyPlan.
+	policy policy
+	// planValidation is the provider's `plan_validation` setting, which
+	// resources apply in ModifyPlan.
+	planValidation planValidation
+	// conflictDetection is the provider's `conflict_detection` setting, which
+	// resources apply in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// which resources inherit in ModifyPlan.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, which
+	// resources inherit in Create.
+	adoptExisting bool
 }
 
 // Metadata returns the provider type name.
@@ -96,6 +124,63 @@ func providerSchema() schema.Schema {
 				Optional:    true,
 				Description: "Maximum number of retries for failed API requests (0–5). If omitted, the DASH0_MAX_RETRIES environment variable is used. Defaults to 3.",
 			},
+			"plan_validation": schema.StringAttribute{
+				Optional:    true,
+				Description: "Whether `terraform plan` checks check rules, Prometheus rules, synthetic checks and spam filters against the assets that exist in the organization and the definitions the API accepts, so that a mistake the API would only reject at apply time, after earlier resources have already been written, fails the plan instead: `off`, `warn` (report problems as warnings) or `error` (fail the plan). The Dash0 API offers no dry-run mode, so the check is limited to what the read endpoints can confirm and what can be checked offline: every notification channel id in the `dash0.com/notification-channel-ids` annotation or in `spec.notifications.channels` must exist, and every spam filter condition needs a `key` and an `operator` and applies to the signal types `log`, `span`, `datapoint` or `web_event`. References to notification channels created in the same run are not known at plan time and are not checked. The DASH0_PLAN_VALIDATION environment variable takes precedence. Defaults to `off`.",
+			},
+			"conflict_detection": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether `terraform apply` refuses to update an asset that was changed on the server, for example in the Dash0 UI, after Terraform last read it, instead of silently overwriting that change. The update fails with a \"modified concurrently, re-plan\" error; running `terraform plan` again shows the change so it can be kept or reverted deliberately. The Dash0 API accepts no precondition on updates, so the provider reads the asset right before writing it; a change made between that read and the write is still overwritten. Applies to dashboards, views, synthetic checks, check rules, recording rules, spam filters, notification channels and teams. The DASH0_CONFLICT_DETECTION environment variable takes precedence. Defaults to `false`.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Description: "The default for the `deletion_protection` attribute of every asset resource that omits its own. While an asset's `deletion_protection` is `true`, Terraform refuses to delete it, including when its resource block is removed from the configuration, which the `prevent_destroy` lifecycle argument does not cover. The Dash0 API has no deletion lock, so protected assets can still be deleted in the Dash0 UI. The DASH0_DELETION_PROTECTION environment variable takes precedence. Defaults to `false`.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "The default for the `adopt_existing` attribute of every asset resource that omits its own. While it is `true`, creating a resource takes over an existing asset with the same name, for example one created in the Dash0 UI, and updates it in place instead of creating a duplicate. The DASH0_ADOPT_EXISTING environment variable takes precedence. Defaults to `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"policy": schema.SingleNestedBlock{
+				Description: "Conventions every managed asset must follow, checked at plan time so they are enforced in one place rather than by external pipelines that cannot see into the YAML attributes. Each `rule` applies to one resource type. Policy is not checked by `terraform validate`, because Terraform does not configure the provider for it.",
+				Blocks: map[string]schema.Block{
+					"rule": schema.ListNestedBlock{
+						Description: "A convention for one resource type. Rules for `dash0_check_rule` and `dash0_recording_rule` also apply to the alerting and recording rules of a `dash0_prometheus_rule` document.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"resource_type": schema.StringAttribute{
+									Required:    true,
+									Description: "The resource type the rule applies to: one of `dash0_check_rule`, `dash0_dashboard`, `dash0_recording_rule`, `dash0_synthetic_check` or `dash0_view`.",
+								},
+								"required_annotations": schema.ListAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+									Description: "Annotation keys that must be set to a non-empty value. For check rules these are the rule's annotations, including those inherited from the document's top-level `metadata.annotations`; for all other resource types they are the document's `metadata.annotations`.",
+								},
+								"required_labels": schema.ListAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+									Description: "Label keys that must be set to a non-empty value. For check rules and recording rules these are the rule's labels; for all other resource types they are the document's `metadata.labels`.",
+								},
+								"allowed_folder_path_prefixes": schema.ListAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+									Description: "Only for `dash0_dashboard` and `dash0_view`. When set, every dashboard or view must set the `dash0.com/folder-path` annotation to one of these prefixes or a path below one of them. Prefixes match whole path segments, so `/teams` allows `/teams/checkout` but not `/teams-archive`.",
+								},
+								"require_notification_channel": schema.BoolAttribute{
+									Optional:    true,
+									Description: "Only for `dash0_check_rule` and `dash0_synthetic_check`. When `true`, every check rule must route its alerts to at least one notification channel through the `dash0.com/notification-channel-ids` annotation, and every synthetic check must list at least one channel in `spec.notifications.channels`.",
+								},
+								"enforcement": schema.StringAttribute{
+									Optional:    true,
+									Description: "How violations are reported: `error` fails the plan, `warn` only shows a warning. Defaults to `error`.",
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -409,13 +494,25 @@ func (p *dash0Provider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	defaultDataset := resolveDataset(ctx, &cfg, auth.profileCfg)
 
+	assetPolicy := parsePolicy(ctx, cfg.Policy, &resp.Diagnostics)
+	planValidation := parsePlanValidation(os.Getenv("DASH0_PLAN_VALIDATION"), cfg.PlanValidation, &resp.Diagnostics)
+	conflictDetection := conflictDetection(parseBoolSetting("DASH0_CONFLICT_DETECTION", cfg.ConflictDetection, path.Root("conflict_detection"), &resp.Diagnostics))
+	deletionProtection := parseBoolSetting("DASH0_DELETION_PROTECTION", cfg.DeletionProtection, path.Root("deletion_protection"), &resp.Diagnostics)
+	adoptExisting := parseBoolSetting("DASH0_ADOPT_EXISTING", cfg.AdoptExisting, path.Root("adopt_existing"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "dash0_url", auth.url)
 	ctx = tflog.SetField(ctx, "dash0_auth_token", auth.token)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "dash0_auth_token")
 
 	tflog.Debug(ctx, "Creating Dash0 client")
 
-	dash0Client, err := client.NewDash0Client(auth.url, auth.tokenProvider(), auth.isOAuth, p.version, maxRetries, auth.otlpURL)
+	// The client and the dash0_access_token ephemeral resource share one token
+	// provider, so that an OAuth access token is refreshed only once.
+	tokenProvider := auth.tokenProvider()
+	dash0Client, err := client.NewDash0Client(auth.url, tokenProvider, auth.isOAuth, p.version, maxRetries, auth.otlpURL)
 	if err != nil && auth.otlpURL != "" {
 		// A malformed otlp_url only needs to break the dash0_log_event and
 		// dash0_deployment_event actions, not every resource and data source.
@@ -430,7 +527,7 @@ func (p *dash0Provider) Configure(ctx context.Context, req provider.ConfigureReq
 				err,
 			),
 		)
-		dash0Client, err = client.NewDash0Client(auth.url, auth.tokenProvider(), auth.isOAuth, p.version, maxRetries, "")
+		dash0Client, err = client.NewDash0Client(auth.url, tokenProvider, auth.isOAuth, p.version, maxRetries, "")
 	}
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -441,8 +538,24 @@ func (p *dash0Provider) Configure(ctx context.Context, req provider.ConfigureReq
 	}
 
 	resp.DataSourceData = dash0Client
-	resp.ResourceData = resourceProviderData{client: dash0Client, defaultDataset: defaultDataset}
+	resp.ResourceData = resourceProviderData{
+		client:             dash0Client,
+		defaultDataset:     defaultDataset,
+		policy:             assetPolicy,
+		planValidation:     planValidation,
+		conflictDetection:  conflictDetection,
+		deletionProtection: deletionProtection,
+		adoptExisting:      adoptExisting,
+	}
+	resp.ListResourceData = resp.ResourceData
 	resp.ActionData = dash0Client
+	resp.EphemeralResourceData = ephemeralProviderData{
+		tokenProvider:  tokenProvider,
+		isOAuth:        auth.isOAuth,
+		apiURL:         auth.url,
+		otlpURL:        auth.otlpURL,
+		defaultDataset: defaultDataset,
+	}
 
 	tflog.Info(ctx, "Configured Dash0 client", map[string]any{"success": true})
 }
@@ -460,9 +573,35 @@ func (p *dash0Provider) Resources(_ context.Context) []func() resource.Resource
 		NewViewResource,
 		NewCheckRuleResource,
 		NewRecordingRuleResource,
+		NewPrometheusRuleResource,
 		NewNotificationChannelResource,
 		NewSpamFilterResource,
 		NewTeamResource,
+		NewTeamMembershipResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider, one
+// per asset kind. List resources back `terraform query` and require Terraform
+// 1.14 or later.
+func (p *dash0Provider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDashboardListResource,
+		NewSyntheticCheckListResource,
+		NewViewListResource,
+		NewCheckRuleListResource,
+		NewRecordingRuleListResource,
+		NewNotificationChannelListResource,
+		NewSpamFilterListResource,
+		NewTeamListResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the
+// provider. Ephemeral resources require Terraform 1.10 or later.
+func (p *dash0Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
 	}
 }
 
@@ -475,3 +614,32 @@ func (p *dash0Provider) Actions(_ context.Context) []func() action.Action {
 		NewDeploymentEventAction,
 	}
 }
+
+// Functions defines the provider-defined functions implemented in the
+// provider. Provider-defined functions require Terraform 1.8 or later.
+func (p *dash0Provider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizeYAMLFunction,
+		NewYAMLEquivalentFunction,
+		NewSplitPrometheusRulesFunction,
+		NewGrafanaToDashboardFunction,
+	}
+}
+
+// parseBoolSetting resolves a boolean provider setting: the environment
+// variable envName, then the provider attribute attr, then false. An
+// environment variable that is not a boolean is reported as an error on the
+// attribute at attrPath.
+func parseBoolSetting(envName string, attr types.Bool, attrPath path.Path, diags *diag.Diagnostics) bool {
+	envValue := os.Getenv(envName)
+	if envValue == "" {
+		return attr.ValueBool()
+	}
+	value, err := strconv.ParseBool(envValue)
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid "+envName,
+			fmt.Sprintf("The %s environment variable must be \"true\" or \"false\", got: %q", envName, envValue))
+		return false
+	}
+	return value
+}
@@ -6,8 +6,11 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -75,6 +78,10 @@ func clearCredentialEnv(t *testing.T) {
 	t.Setenv("DASH0_AUTH_TOKEN", "")
 	t.Setenv("DASH0_OTLP_URL", "")
 	t.Setenv("DASH0_DATASET", "")
+	t.Setenv("DASH0_PLAN_VALIDATION", "")
+	t.Setenv("DASH0_CONFLICT_DETECTION", "")
+	t.Setenv("DASH0_DELETION_PROTECTION", "")
+	t.Setenv("DASH0_ADOPT_EXISTING", "")
 	t.Setenv("DASH0_CONFIG_DIR", filepath.Join(t.TempDir(), "no-config-here"))
 }
 
@@ -96,6 +103,17 @@ func providerTestConfigWithOtlpURL(url, authToken, profile, otlpURL *string, max
 // providerTestConfigFull builds a tfsdk.Config with every provider attribute
 // exposed for tests. Pass nil for any value to leave it unset (null).
 func providerTestConfigFull(url, authToken, profile, otlpURL, dataset *string, maxRetries *int64) tfsdk.Config {
+	return providerTestConfigWithPolicy(url, authToken, profile, otlpURL, dataset, maxRetries, tftypes.NewValue(providerPolicyType(), nil))
+}
+
+// providerPolicyType is the Terraform type of the provider's `policy` block.
+func providerPolicyType() tftypes.Type {
+	return providerSchema().Blocks["policy"].Type().TerraformType(context.Background())
+}
+
+// providerTestConfigWithPolicy is providerTestConfigFull with the `policy`
+// block set to the given value.
+func providerTestConfigWithPolicy(url, authToken, profile, otlpURL, dataset *string, maxRetries *int64, policy tftypes.Value) tfsdk.Config {
 	stringVal := func(p *string) tftypes.Value {
 		if p == nil {
 			return tftypes.NewValue(tftypes.String, nil)
@@ -111,20 +129,30 @@ func providerTestConfigFull(url, authToken, profile, otlpURL, dataset *string, m
 	return tfsdk.Config{
 		Raw: tftypes.NewValue(tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"url":         tftypes.String,
-				"auth_token":  tftypes.String,
-				"otlp_url":    tftypes.String,
-				"profile":     tftypes.String,
-				"dataset":     tftypes.String,
-				"max_retries": tftypes.Number,
+				"url":                 tftypes.String,
+				"auth_token":          tftypes.String,
+				"otlp_url":            tftypes.String,
+				"profile":             tftypes.String,
+				"dataset":             tftypes.String,
+				"max_retries":         tftypes.Number,
+				"plan_validation":     tftypes.String,
+				"conflict_detection":  tftypes.Bool,
+				"deletion_protection": tftypes.Bool,
+				"adopt_existing":      tftypes.Bool,
+				"policy":              providerPolicyType(),
 			},
 		}, map[string]tftypes.Value{
-			"url":         stringVal(url),
-			"auth_token":  stringVal(authToken),
-			"otlp_url":    stringVal(otlpURL),
-			"profile":     stringVal(profile),
-			"dataset":     stringVal(dataset),
-			"max_retries": numberVal(maxRetries),
+			"url":                 stringVal(url),
+			"auth_token":          stringVal(authToken),
+			"otlp_url":            stringVal(otlpURL),
+			"profile":             stringVal(profile),
+			"dataset":             stringVal(dataset),
+			"max_retries":         numberVal(maxRetries),
+			"plan_validation":     tftypes.NewValue(tftypes.String, nil),
+			"conflict_detection":  tftypes.NewValue(tftypes.Bool, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"policy":              policy,
 		}),
 		Schema: providerSchema(),
 	}
@@ -150,7 +178,7 @@ func TestDash0Provider_Schema(t *testing.T) {
 	assert.NotNil(t, resp.Schema)
 	assert.Contains(t, resp.Schema.Description, "observability platform")
 
-	for _, name := range []string{"url", "auth_token", "profile", "dataset", "max_retries"} {
+	for _, name := range []string{"url", "auth_token", "profile", "dataset", "max_retries", "plan_validation", "conflict_detection", "deletion_protection", "adopt_existing"} {
 		assert.Contains(t, resp.Schema.Attributes, name)
 	}
 
@@ -479,7 +507,46 @@ func TestDash0Provider_DataSources(t *testing.T) {
 func TestDash0Provider_Resources(t *testing.T) {
 	p := &dash0Provider{}
 	resources := p.Resources(context.Background())
-	assert.Len(t, resources, 8)
+	assert.Len(t, resources, 10)
+}
+
+func TestDash0Provider_EphemeralResources(t *testing.T) {
+	p := &dash0Provider{}
+	ephemeralResources := p.EphemeralResources(context.Background())
+	assert.Len(t, ephemeralResources, 1)
+}
+
+func TestDash0Provider_Functions(t *testing.T) {
+	p := &dash0Provider{}
+	functions := p.Functions(context.Background())
+	assert.Len(t, functions, 4)
+}
+
+// TestDash0Provider_ListResources asserts that every list resource lists a
+// resource of the same type that declares a resource identity, which the
+// framework requires of list results.
+func TestDash0Provider_ListResources(t *testing.T) {
+	ctx := context.Background()
+	p := &dash0Provider{}
+
+	resources := map[string]resource.Resource{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var resp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dash0"}, &resp)
+		resources[resp.TypeName] = r
+	}
+
+	listResources := p.ListResources(ctx)
+	assert.Len(t, listResources, 8)
+	for _, newListResource := range listResources {
+		var resp resource.MetadataResponse
+		newListResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dash0"}, &resp)
+		r, ok := resources[resp.TypeName]
+		require.True(t, ok, "no resource for list resource %s", resp.TypeName)
+		_, ok = r.(resource.ResourceWithIdentity)
+		assert.True(t, ok, "%s declares no resource identity", resp.TypeName)
+	}
 }
 
 // TestResolveAuthInfo_Precedence pins the precedence order in a single place
@@ -811,3 +878,93 @@ func TestDash0Provider_Configure_DefaultDataset(t *testing.T) {
 		assert.Equal(t, "default", data.defaultDataset)
 	})
 }
+
+// providerTestPolicy builds a `policy` block value holding a single rule.
+func providerTestPolicy(resourceType, enforcement string, requiredLabels ...string) tftypes.Value {
+	ruleType := providerPolicyType().(tftypes.Object).AttributeTypes["rule"].(tftypes.List).ElementType.(tftypes.Object)
+	stringList := tftypes.List{ElementType: tftypes.String}
+
+	labels := make([]tftypes.Value, len(requiredLabels))
+	for i, l := range requiredLabels {
+		labels[i] = tftypes.NewValue(tftypes.String, l)
+	}
+	rule := tftypes.NewValue(ruleType, map[string]tftypes.Value{
+		"resource_type":                tftypes.NewValue(tftypes.String, resourceType),
+		"required_annotations":         tftypes.NewValue(stringList, nil),
+		"required_labels":              tftypes.NewValue(stringList, labels),
+		"allowed_folder_path_prefixes": tftypes.NewValue(stringList, nil),
+		"require_notification_channel": tftypes.NewValue(tftypes.Bool, nil),
+		"enforcement":                  tftypes.NewValue(tftypes.String, enforcement),
+	})
+	return tftypes.NewValue(providerPolicyType(), map[string]tftypes.Value{
+		"rule": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{rule}),
+	})
+}
+
+// TestDash0Provider_Configure_Policy verifies that Configure parses the
+// `policy` block and threads it through resp.ResourceData.
+func TestDash0Provider_Configure_Policy(t *testing.T) {
+	t.Run("policy is passed to resources", func(t *testing.T) {
+		clearCredentialEnv(t)
+
+		p := &dash0Provider{}
+		req := provider.ConfigureRequest{
+			Config: providerTestConfigWithPolicy(
+				strPtr("https://api.attr.com"),
+				strPtr("auth_attr_token"),
+				nil, nil, nil, nil,
+				providerTestPolicy("dash0_view", "warn", "team"),
+			),
+		}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics.Errors())
+		data, ok := resp.ResourceData.(resourceProviderData)
+		require.True(t, ok, "resp.ResourceData should be a resourceProviderData, got %T", resp.ResourceData)
+		assert.Equal(t, []policyRule{{
+			resourceType:   "dash0_view",
+			requiredLabels: []string{"team"},
+			enforcement:    policyEnforcementWarn,
+		}}, data.policy.rules)
+	})
+
+	t.Run("invalid enforcement fails configuration", func(t *testing.T) {
+		clearCredentialEnv(t)
+
+		p := &dash0Provider{}
+		req := provider.ConfigureRequest{
+			Config: providerTestConfigWithPolicy(
+				strPtr("https://api.attr.com"),
+				strPtr("auth_attr_token"),
+				nil, nil, nil, nil,
+				providerTestPolicy("dash0_view", "block"),
+			),
+		}
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Invalid policy rule", resp.Diagnostics.Errors()[0].Summary())
+		assert.Nil(t, resp.ResourceData)
+	})
+}
+
+func TestParseBoolSetting(t *testing.T) {
+	var diags diag.Diagnostics
+	attrPath := path.Root("conflict_detection")
+
+	t.Setenv("DASH0_CONFLICT_DETECTION", "")
+	assert.False(t, parseBoolSetting("DASH0_CONFLICT_DETECTION", types.BoolNull(), attrPath, &diags))
+	assert.True(t, parseBoolSetting("DASH0_CONFLICT_DETECTION", types.BoolValue(true), attrPath, &diags))
+
+	// The environment variable takes precedence over the attribute.
+	t.Setenv("DASH0_CONFLICT_DETECTION", "false")
+	assert.False(t, parseBoolSetting("DASH0_CONFLICT_DETECTION", types.BoolValue(true), attrPath, &diags))
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+
+	t.Setenv("DASH0_CONFLICT_DETECTION", "sometimes")
+	parseBoolSetting("DASH0_CONFLICT_DETECTION", types.BoolNull(), attrPath, &diags)
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "Invalid DASH0_CONFLICT_DETECTION", diags.Errors()[0].Summary())
+}
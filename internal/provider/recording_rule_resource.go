@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"maps"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,7 +11,6 @@ import (
 
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,9 +23,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &RecordingRuleResource{}
-	_ resource.ResourceWithConfigure   = &RecordingRuleResource{}
-	_ resource.ResourceWithImportState = &RecordingRuleResource{}
+	_ resource.Resource                   = &RecordingRuleResource{}
+	_ resource.ResourceWithConfigure      = &RecordingRuleResource{}
+	_ resource.ResourceWithImportState    = &RecordingRuleResource{}
+	_ resource.ResourceWithIdentity       = &RecordingRuleResource{}
+	_ resource.ResourceWithValidateConfig = &RecordingRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &RecordingRuleResource{}
 )
 
 // NewRecordingRuleResource is a helper function to simplify the provider implementation.
@@ -40,14 +42,35 @@ type RecordingRuleResource struct {
 	// defaultDataset is the provider-level default dataset, inherited by this
 	// resource's `dataset` attribute when it is omitted from configuration.
 	defaultDataset string
+	// policy is the provider-level policy, enforced in ModifyPlan.
+	policy policy
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
 }
 
 // recordingRuleModel is the Terraform state model for a recording rule resource.
 type recordingRuleModel struct {
-	Origin            types.String `tfsdk:"origin"`
-	ID                types.String `tfsdk:"id"`
-	Dataset           types.String `tfsdk:"dataset"`
-	RecordingRuleYaml types.String `tfsdk:"recording_rule_yaml"`
+	Origin             types.String `tfsdk:"origin"`
+	ID                 types.String `tfsdk:"id"`
+	Dataset            types.String `tfsdk:"dataset"`
+	RecordingRuleYaml  types.String `tfsdk:"recording_rule_yaml"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	AllowDatasetMove   types.Bool   `tfsdk:"allow_dataset_move"`
+	Datasets           types.Set    `tfsdk:"datasets"`
+	DatasetIDs         types.Map    `tfsdk:"dataset_ids"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -67,10 +90,42 @@ func (r *RecordingRuleResource) Configure(_ context.Context, req resource.Config
 
 	r.client = data.client
 	r.defaultDataset = data.defaultDataset
+	r.policy = data.policy
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
 }
 
 func (r *RecordingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_recording_rule"
+	// Moving the resource to another dataset (allow_dataset_move) changes the
+	// dataset in its identity.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the recording rule by.
+func (r *RecordingRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = datasetAssetIdentitySchema("recording rule")
+}
+
+// ValidateConfig checks recording_rule_yaml offline, so `terraform validate` reports an
+// invalid PromQL expression, duration, or label or annotation key without
+// credentials and before any rule is written (see validatePrometheusRuleYAML).
+// It also checks that at most one of dataset and datasets is set.
+func (r *RecordingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateDatasetReplicas(ctx, req.Config, &resp.Diagnostics)
+
+	var model recordingRuleModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.RecordingRuleYaml.IsNull() || model.RecordingRuleYaml.IsUnknown() {
+		return
+	}
+	validatePrometheusRuleYAML(model.RecordingRuleYaml.ValueString(), path.Root("recording_rule_yaml"), &resp.Diagnostics)
 }
 
 func (r *RecordingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -93,23 +148,39 @@ func (r *RecordingRuleResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"dataset": schema.StringAttribute{
-				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the recording rule belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated.",
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the recording rule belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated, unless `allow_dataset_move` is set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					datasetRequiresReplace(),
 				},
 			},
 			"recording_rule_yaml": schema.StringAttribute{
-				Description: "The recording rule definition in YAML format, following the [Prometheus recording rule specification](https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/).",
+				Description: "The recording rule definition in YAML format, following the [Prometheus recording rule specification](https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/). Each rule's `expr` is parsed as PromQL, and its `for` and `keep_firing_for` durations and label and annotation keys are checked, at plan time.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqual(),
+					yamlKinds["recording_rule"].planModifier(),
 				},
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("recording rule"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("recording rule")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("recording rule", "`metadata.name`", "in its dataset")
+	resp.Schema.Attributes["allow_dataset_move"] = allowDatasetMoveAttribute("recording rule")
+	resp.Schema.Attributes["datasets"] = datasetsAttribute("recording rule")
+	resp.Schema.Attributes["dataset_ids"] = datasetIDsAttribute("recording rule")
+}
+
+// ModifyPlan plans the provider-level deletion protection default, dataset
+// moves and replicas, and enforces the provider-level policy on the planned
+// recording rule.
+func (r *RecordingRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	planDatasetMove(ctx, req, resp, "id")
+	planDatasetReplicas(ctx, req, resp, "id")
+	r.policy.enforcePlan(ctx, req.Plan, "dash0_recording_rule", path.Root("recording_rule_yaml"), &resp.Diagnostics)
 }
 
 // resolveRecordingRule populates the recording rule's server-assigned id on
@@ -129,6 +200,31 @@ func (r *RecordingRuleResource) resolveRecordingRule(ctx context.Context, model
 	model.ID = stringOrNull(id)
 }
 
+// replicas returns the replicas of a recording rule with `datasets`.
+func (r *RecordingRuleResource) replicas() datasetReplicas {
+	return datasetReplicas{
+		noun:   "recording rule",
+		create: r.client.CreateRecordingRule,
+		update: r.client.UpdateRecordingRule,
+		remove: r.client.DeleteRecordingRule,
+		get:    r.client.GetRecordingRule,
+		resolve: func(ctx context.Context, origin, dataset string) (string, string, error) {
+			id, err := r.client.ResolveRecordingRule(ctx, origin, dataset)
+			return id, "", err
+		},
+	}
+}
+
+// resolveLastModified populates the recording rule's last-modified attributes
+// after a create or update (best-effort), and records the revision the write
+// produced for conflict detection.
+func (r *RecordingRuleResource) resolveLastModified(ctx context.Context, model *recordingRuleModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "recording rule", func(ctx context.Context) (string, error) {
+		return r.client.GetRecordingRule(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *RecordingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model recordingRuleModel
 	diags := req.Plan.Get(ctx, &model)
@@ -138,7 +234,7 @@ func (r *RecordingRuleResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	model.Origin = types.StringValue("tf_" + uuid.New().String())
-	if model.Dataset.IsNull() || model.Dataset.IsUnknown() {
+	if model.Datasets.IsNull() && (model.Dataset.IsNull() || model.Dataset.IsUnknown()) {
 		model.Dataset = types.StringValue(r.defaultDataset)
 	}
 
@@ -160,7 +256,33 @@ func (r *RecordingRuleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	err = r.client.CreateRecordingRule(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Write a replica of the recording rule to each of its datasets instead, if
+	// datasets is set.
+	if !model.Datasets.IsNull() {
+		model.Datasets, model.DatasetIDs, _ = r.replicas().apply(ctx, model.Origin.ValueString(), jsonBody, types.SetNull(types.StringType), model.Datasets, model.DatasetIDs, types.MapNull(types.StringType), &resp.Diagnostics)
+		tflog.Trace(ctx, "created a replicated recording rule resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Take over an existing recording rule with the same name instead of creating
+	// a second one, if adopt_existing is set.
+	createRecordingRule := r.client.CreateRecordingRule
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "recording rule", fmt.Sprintf("in dataset %q", model.Dataset.ValueString()), jsonBody, func(ctx context.Context) ([]client.Asset, error) {
+			return r.client.ListRecordingRules(ctx, model.Dataset.ValueString())
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createRecordingRule = r.client.UpdateRecordingRule
+		}
+	}
+
+	err = createRecordingRule(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create recording rule, got error: %s", err))
 		return
@@ -168,12 +290,14 @@ func (r *RecordingRuleResource) Create(ctx context.Context, req resource.CreateR
 
 	// Resolve the id for the newly created recording rule (best-effort).
 	r.resolveRecordingRule(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a recording rule resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
 }
 
 func (r *RecordingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -185,6 +309,14 @@ func (r *RecordingRuleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// Read each replica of a recording rule with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.readReplicas(ctx, &state, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+		return
+	}
+
 	apiResponseJSON, err := r.client.GetRecordingRule(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read recording rule, got error: %s", err))
@@ -192,31 +324,59 @@ func (r *RecordingRuleResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	tflog.Trace(ctx, "read a recording rule resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseJSON, &resp.Diagnostics)
+
+	r.refreshRecordingRule(ctx, &state, apiResponseJSON, &resp.Diagnostics)
 
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseJSON)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+}
+
+// refreshRecordingRule compares the recording rule in state with document, the
+// recording rule as returned by the API, and replaces recording_rule_yaml with it only
+// if they differ beyond metadata the server manages.
+func (r *RecordingRuleResource) refreshRecordingRule(ctx context.Context, state *recordingRuleModel, document string, diags *diag.Diagnostics) {
 	// Compare the current state with the retrieved recording rule
 	if state.RecordingRuleYaml.ValueString() != "" {
 		stateYAML := state.RecordingRuleYaml.ValueString()
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, nil)
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, document, additionalIgnored, nil)
 		if err != nil {
-			resp.Diagnostics.AddWarning(
+			diags.AddWarning(
 				"Recording Rule Comparison Error",
 				fmt.Sprintf("Error comparing recording rules: %s. Using API response as source of truth.", err),
 			)
-			state.RecordingRuleYaml = types.StringValue(apiResponseJSON)
+			state.RecordingRuleYaml = types.StringValue(document)
 		} else if !equivalent {
 			tflog.Debug(ctx, "Recording rule has changed, updating state")
-			state.RecordingRuleYaml = types.StringValue(apiResponseJSON)
+			warnChangedOutsideTerraform("recording rule", document, diags)
+			state.RecordingRuleYaml = types.StringValue(document)
 		} else {
 			tflog.Debug(ctx, "Recording rule is equivalent, ignoring changes in metadata fields")
 		}
 	} else {
-		state.RecordingRuleYaml = types.StringValue(apiResponseJSON)
+		state.RecordingRuleYaml = types.StringValue(document)
 	}
+}
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+// readReplicas refreshes a recording rule with datasets from its replicas. The
+// first replica that differs from the configured recording rule replaces
+// recording_rule_yaml, so that the next apply rewrites all of them.
+func (r *RecordingRuleResource) readReplicas(ctx context.Context, state *recordingRuleModel, diags *diag.Diagnostics) {
+	configured := *state
+	drifted := false
+	state.Datasets, state.DatasetIDs, _ = r.replicas().read(ctx, state.Origin.ValueString(), state.Datasets, state.DatasetIDs, types.MapNull(types.StringType), func(document string, diags *diag.Diagnostics) {
+		replica := configured
+		r.refreshRecordingRule(ctx, &replica, document, diags)
+		if !drifted && !replica.RecordingRuleYaml.Equal(configured.RecordingRuleYaml) {
+			state.RecordingRuleYaml, drifted = replica.RecordingRuleYaml, true
+		}
+	}, diags)
+	tflog.Trace(ctx, "read a replicated recording rule resource")
 }
 
 func (r *RecordingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -254,22 +414,55 @@ func (r *RecordingRuleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	// Update the existing recording rule (dataset changes force recreation via RequiresReplace)
+	// Update the replicas of a recording rule with datasets instead, creating and
+	// deleting those of added and removed datasets.
+	if !plan.Datasets.IsNull() {
+		plan.Origin = state.Origin
+		plan.Datasets, plan.DatasetIDs, _ = r.replicas().apply(ctx, plan.Origin.ValueString(), jsonBody, state.Datasets, plan.Datasets, state.DatasetIDs, types.MapNull(types.StringType), &resp.Diagnostics)
+		tflog.Trace(ctx, "updated a replicated recording rule resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Update the existing recording rule. A dataset change forces recreation via
+	// RequiresReplace, unless allow_dataset_move is set; then it is moved below.
 	plan.Origin = state.Origin
 	// The recording rule's identifier is immutable, so the id never changes on
 	// update; carry it from state instead of re-resolving it via the API.
 	plan.ID = state.ID
-	err = r.client.UpdateRecordingRule(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update recording rule, got error: %s", err))
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "recording rule", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetRecordingRule(ctx, plan.Origin.ValueString(), state.Dataset.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.Dataset.Equal(state.Dataset) {
+		err = r.client.UpdateRecordingRule(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update recording rule, got error: %s", err))
+			return
+		}
+	} else {
+		if !moveToDataset(ctx, "recording rule", plan.Origin.ValueString(), jsonBody, state.Dataset.ValueString(), plan.Dataset.ValueString(), r.client.CreateRecordingRule, r.client.DeleteRecordingRule, &resp.Diagnostics) {
+			return
+		}
+
+		// The moved recording rule has a new id.
+		r.resolveRecordingRule(ctx, &plan, &resp.Diagnostics)
+	}
+
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a recording rule resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *RecordingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -279,6 +472,15 @@ func (r *RecordingRuleResource) Delete(ctx context.Context, req resource.DeleteR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("recording rule", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete every replica of a recording rule with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.replicas().deleteAll(ctx, state.Origin.ValueString(), state.Datasets, &resp.Diagnostics)
+		return
+	}
 
 	err := r.client.DeleteRecordingRule(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
@@ -291,18 +493,11 @@ func (r *RecordingRuleResource) Delete(ctx context.Context, req resource.DeleteR
 
 // ImportState function is required for resources that support import
 func (r *RecordingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
-		)
+	dataset, origin, ok := datasetImportTarget(ctx, req, "recording rule", r.client.ListRecordingRules, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	dataset := idParts[0]
-	origin := idParts[1]
-
 	apiResponseJSON, err := r.client.GetRecordingRule(ctx, origin, dataset)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -320,4 +515,5 @@ func (r *RecordingRuleResource) ImportState(ctx context.Context, req resource.Im
 	model := recordingRuleModel{Origin: types.StringValue(origin), Dataset: types.StringValue(dataset)}
 	r.resolveRecordingRule(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
+	setDatasetAssetIdentity(ctx, resp.Identity, types.StringValue(dataset), types.StringValue(origin), &resp.Diagnostics)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -92,7 +93,7 @@ spec:
             env: production
 `,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			name:              "invalid YAML response - should update and warn",
@@ -106,6 +107,14 @@ spec:
 		t.Run(tc.name, func(t *testing.T) {
 			testSchema := schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -130,6 +139,14 @@ spec:
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
+						"last_modified_at":    tftypes.String,
+						"last_modified_by":    tftypes.String,
+						"server_version":      tftypes.String,
+						"deletion_protection": tftypes.Bool,
+						"adopt_existing":      tftypes.Bool,
+						"allow_dataset_move":  tftypes.Bool,
+						"datasets":            tftypes.Set{ElementType: tftypes.String},
+						"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
 						"origin":              tftypes.String,
 						"id":                  tftypes.String,
 						"dataset":             tftypes.String,
@@ -137,6 +154,14 @@ spec:
 					},
 				},
 				map[string]tftypes.Value{
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"origin":              tftypes.NewValue(tftypes.String, testOrigin),
 					"id":                  tftypes.NewValue(tftypes.String, nil),
 					"dataset":             tftypes.NewValue(tftypes.String, testDataset),
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 )
@@ -152,6 +153,14 @@ func TestRecordingRuleResource_Create_InvalidYAML(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
+					"last_modified_at":    tftypes.String,
+					"last_modified_by":    tftypes.String,
+					"server_version":      tftypes.String,
+					"deletion_protection": tftypes.Bool,
+					"adopt_existing":      tftypes.Bool,
+					"allow_dataset_move":  tftypes.Bool,
+					"datasets":            tftypes.Set{ElementType: tftypes.String},
+					"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
 					"origin":              tftypes.String,
 					"id":                  tftypes.String,
 					"dataset":             tftypes.String,
@@ -159,6 +168,14 @@ func TestRecordingRuleResource_Create_InvalidYAML(t *testing.T) {
 				},
 			},
 			map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				"origin":              tftypes.NewValue(tftypes.String, "test-origin"),
 				"id":                  tftypes.NewValue(tftypes.String, nil),
 				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
@@ -167,6 +184,14 @@ func TestRecordingRuleResource_Create_InvalidYAML(t *testing.T) {
 		),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
@@ -190,6 +215,80 @@ func TestRecordingRuleResource_Create_InvalidYAML(t *testing.T) {
 	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Invalid YAML")
 }
 
+// TestRecordingRuleResource_ValidateConfig asserts that an invalid PromQL
+// expression fails at plan time, attributed to recording_rule_yaml.
+func TestRecordingRuleResource_ValidateConfig(t *testing.T) {
+	r := &RecordingRuleResource{}
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"last_modified_at":    tftypes.String,
+						"last_modified_by":    tftypes.String,
+						"server_version":      tftypes.String,
+						"deletion_protection": tftypes.Bool,
+						"adopt_existing":      tftypes.Bool,
+						"allow_dataset_move":  tftypes.Bool,
+						"datasets":            tftypes.Set{ElementType: tftypes.String},
+						"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+						"origin":              tftypes.String,
+						"id":                  tftypes.String,
+						"dataset":             tftypes.String,
+						"recording_rule_yaml": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"origin":              tftypes.NewValue(tftypes.String, nil),
+					"id":                  tftypes.NewValue(tftypes.String, nil),
+					"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
+					"recording_rule_yaml": tftypes.NewValue(tftypes.String, `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: test-rule
+spec:
+  groups:
+    - name: TestGroup
+      rules:
+        - record: up:rate5m
+          expr: rate(up)
+`),
+				},
+			),
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"origin":              schema.StringAttribute{Computed: true},
+					"id":                  schema.StringAttribute{Computed: true},
+					"dataset":             schema.StringAttribute{Optional: true},
+					"recording_rule_yaml": schema.StringAttribute{Required: true},
+				},
+			},
+		},
+	}
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), req, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid PromQL expression", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `rules[0] ("up:rate5m", line 9).expr:`)
+}
+
 func TestRecordingRuleResource_ReadError(t *testing.T) {
 	mockClient := &MockClient{}
 	r := &RecordingRuleResource{client: mockClient}
@@ -206,6 +305,14 @@ func TestRecordingRuleResource_ReadError(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
+					"last_modified_at":    tftypes.String,
+					"last_modified_by":    tftypes.String,
+					"server_version":      tftypes.String,
+					"deletion_protection": tftypes.Bool,
+					"adopt_existing":      tftypes.Bool,
+					"allow_dataset_move":  tftypes.Bool,
+					"datasets":            tftypes.Set{ElementType: tftypes.String},
+					"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
 					"origin":              tftypes.String,
 					"id":                  tftypes.String,
 					"dataset":             tftypes.String,
@@ -213,6 +320,14 @@ func TestRecordingRuleResource_ReadError(t *testing.T) {
 				},
 			},
 			map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				"origin":              tftypes.NewValue(tftypes.String, "test-origin"),
 				"id":                  tftypes.NewValue(tftypes.String, nil),
 				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
@@ -221,6 +336,14 @@ func TestRecordingRuleResource_ReadError(t *testing.T) {
 		),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
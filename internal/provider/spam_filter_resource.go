@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"maps"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -12,7 +12,6 @@ import (
 	dash0 "github.com/dash0hq/dash0-api-client-go"
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,6 +27,8 @@ var (
 	_ resource.Resource                = &SpamFilterResource{}
 	_ resource.ResourceWithConfigure   = &SpamFilterResource{}
 	_ resource.ResourceWithImportState = &SpamFilterResource{}
+	_ resource.ResourceWithIdentity    = &SpamFilterResource{}
+	_ resource.ResourceWithModifyPlan  = &SpamFilterResource{}
 )
 
 // NewSpamFilterResource is a helper function to simplify the provider implementation.
@@ -41,14 +42,34 @@ type SpamFilterResource struct {
 	// defaultDataset is the provider-level default dataset, inherited by this
 	// resource's `dataset` attribute when it is omitted from configuration.
 	defaultDataset string
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
+	// planValidation is the provider's `plan_validation` setting, applied in
+	// ModifyPlan.
+	planValidation planValidation
 }
 
 // spamFilterModel is the Terraform state model for a spam filter resource.
 type spamFilterModel struct {
-	Origin         types.String `tfsdk:"origin"`
-	ID             types.String `tfsdk:"id"`
-	Dataset        types.String `tfsdk:"dataset"`
-	SpamFilterYaml types.String `tfsdk:"spam_filter_yaml"`
+	Origin             types.String `tfsdk:"origin"`
+	ID                 types.String `tfsdk:"id"`
+	Dataset            types.String `tfsdk:"dataset"`
+	SpamFilterYaml     types.String `tfsdk:"spam_filter_yaml"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	AllowDatasetMove   types.Bool   `tfsdk:"allow_dataset_move"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -68,10 +89,23 @@ func (r *SpamFilterResource) Configure(_ context.Context, req resource.Configure
 
 	r.client = data.client
 	r.defaultDataset = data.defaultDataset
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
+	r.planValidation = data.planValidation
 }
 
 func (r *SpamFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_spam_filter"
+	// Moving the resource to another dataset (allow_dataset_move) changes the
+	// dataset in its identity.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the spam filter by.
+func (r *SpamFilterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = datasetAssetIdentitySchema("spam filter")
 }
 
 func (r *SpamFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -97,12 +131,12 @@ func (r *SpamFilterResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"dataset": schema.StringAttribute{
-				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the spam filter belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated.",
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the spam filter belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated, unless `allow_dataset_move` is set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					datasetRequiresReplace(),
 				},
 			},
 			"spam_filter_yaml": schema.StringAttribute{
@@ -113,11 +147,23 @@ func (r *SpamFilterResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					"`apiVersion` field determines which shape is expected. ",
 				Required: true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqual(),
+					yamlKinds["spam_filter"].planModifier(),
 				},
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("spam filter"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("spam filter")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("spam filter", "`metadata.name`", "in its dataset")
+	resp.Schema.Attributes["allow_dataset_move"] = allowDatasetMoveAttribute("spam filter")
+}
+
+// ModifyPlan plans the provider-level deletion protection default and dataset
+// moves, and applies plan validation to the planned spam filter.
+func (r *SpamFilterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	planDatasetMove(ctx, req, resp, "id")
+	r.planValidation.validatePlan(ctx, r.client, req.Plan, "dash0_spam_filter", path.Root("spam_filter_yaml"), &resp.Diagnostics)
 }
 
 // resolveSpamFilter populates the spam filter's server-assigned id on the
@@ -137,6 +183,16 @@ func (r *SpamFilterResource) resolveSpamFilter(ctx context.Context, model *spamF
 	model.ID = stringOrNull(id)
 }
 
+// resolveLastModified populates the spam filter's last-modified attributes
+// after a create or update (best-effort), and records the revision the write
+// produced for conflict detection.
+func (r *SpamFilterResource) resolveLastModified(ctx context.Context, model *spamFilterModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "spam filter", func(ctx context.Context) (string, error) {
+		return r.client.GetSpamFilter(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *SpamFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model spamFilterModel
 	diags := req.Plan.Get(ctx, &model)
@@ -168,7 +224,23 @@ func (r *SpamFilterResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	err = r.client.CreateSpamFilter(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Take over an existing spam filter with the same name instead of creating a
+	// second one, if adopt_existing is set.
+	createSpamFilter := r.client.CreateSpamFilter
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "spam filter", fmt.Sprintf("in dataset %q", model.Dataset.ValueString()), jsonBody, func(ctx context.Context) ([]client.Asset, error) {
+			return r.client.ListSpamFilters(ctx, model.Dataset.ValueString())
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createSpamFilter = r.client.UpdateSpamFilter
+		}
+	}
+
+	err = createSpamFilter(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create spam filter, got error: %s", err))
 		return
@@ -176,12 +248,14 @@ func (r *SpamFilterResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Resolve the id for the newly created spam filter (best-effort).
 	r.resolveSpamFilter(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a spam filter resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
 }
 
 func (r *SpamFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -205,6 +279,7 @@ func (r *SpamFilterResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	tflog.Trace(ctx, "read a spam filter resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseJSON, &resp.Diagnostics)
 
 	// Compare the current state with the retrieved spam filter
 	if state.SpamFilterYaml.ValueString() != "" {
@@ -219,6 +294,7 @@ func (r *SpamFilterResource) Read(ctx context.Context, req resource.ReadRequest,
 			state.SpamFilterYaml = types.StringValue(apiResponseJSON)
 		} else if !equivalent {
 			tflog.Debug(ctx, "Spam filter has changed, updating state")
+			warnChangedOutsideTerraform("spam filter", apiResponseJSON, &resp.Diagnostics)
 			state.SpamFilterYaml = types.StringValue(apiResponseJSON)
 		} else {
 			tflog.Debug(ctx, "Spam filter is equivalent, ignoring changes in metadata fields")
@@ -227,9 +303,12 @@ func (r *SpamFilterResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.SpamFilterYaml = types.StringValue(apiResponseJSON)
 	}
 
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseJSON)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
 }
 
 func (r *SpamFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -267,22 +346,44 @@ func (r *SpamFilterResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Update the existing spam filter (dataset changes force recreation via RequiresReplace)
+	// Update the existing spam filter. A dataset change forces recreation via
+	// RequiresReplace, unless allow_dataset_move is set; then it is moved below.
 	plan.Origin = state.Origin
 	// The spam filter's identifier is immutable, so the id never changes on
 	// update; carry it from state instead of re-resolving it via the API.
 	plan.ID = state.ID
-	err = r.client.UpdateSpamFilter(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update spam filter, got error: %s", err))
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "spam filter", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetSpamFilter(ctx, plan.Origin.ValueString(), state.Dataset.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.Dataset.Equal(state.Dataset) {
+		err = r.client.UpdateSpamFilter(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update spam filter, got error: %s", err))
+			return
+		}
+	} else {
+		if !moveToDataset(ctx, "spam filter", plan.Origin.ValueString(), jsonBody, state.Dataset.ValueString(), plan.Dataset.ValueString(), r.client.CreateSpamFilter, r.client.DeleteSpamFilter, &resp.Diagnostics) {
+			return
+		}
+
+		// The moved spam filter has a new id.
+		r.resolveSpamFilter(ctx, &plan, &resp.Diagnostics)
+	}
+
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a spam filter resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *SpamFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -292,6 +393,9 @@ func (r *SpamFilterResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("spam filter", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
 
 	err := r.client.DeleteSpamFilter(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
@@ -304,18 +408,11 @@ func (r *SpamFilterResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState function is required for resources that support import
 func (r *SpamFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
-		)
+	dataset, origin, ok := datasetImportTarget(ctx, req, "spam filter", r.client.ListSpamFilters, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	dataset := idParts[0]
-	origin := idParts[1]
-
 	apiResponseJSON, err := r.client.GetSpamFilter(ctx, origin, dataset)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -333,4 +430,5 @@ func (r *SpamFilterResource) ImportState(ctx context.Context, req resource.Impor
 	model := spamFilterModel{Origin: types.StringValue(origin), Dataset: types.StringValue(dataset)}
 	r.resolveSpamFilter(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
+	setDatasetAssetIdentity(ctx, resp.Identity, types.StringValue(dataset), types.StringValue(origin), &resp.Diagnostics)
 }
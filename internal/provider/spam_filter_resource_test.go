@@ -43,25 +43,43 @@ func TestSpamFilterResource_Read_404IsNotHandledAsGone(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"origin":           tftypes.String,
-					"id":               tftypes.String,
-					"dataset":          tftypes.String,
-					"spam_filter_yaml": tftypes.String,
+					"last_modified_at":    tftypes.String,
+					"last_modified_by":    tftypes.String,
+					"server_version":      tftypes.String,
+					"deletion_protection": tftypes.Bool,
+					"adopt_existing":      tftypes.Bool,
+					"allow_dataset_move":  tftypes.Bool,
+					"origin":              tftypes.String,
+					"id":                  tftypes.String,
+					"dataset":             tftypes.String,
+					"spam_filter_yaml":    tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
-				"origin":           tftypes.NewValue(tftypes.String, "tf_origin"),
-				"id":               tftypes.NewValue(tftypes.String, nil),
-				"dataset":          tftypes.NewValue(tftypes.String, "dataset-1"),
-				"spam_filter_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"origin":              tftypes.NewValue(tftypes.String, "tf_origin"),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, "dataset-1"),
+				"spam_filter_yaml":    tftypes.NewValue(tftypes.String, "test-yaml"),
 			},
 		),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
-				"origin":           schema.StringAttribute{Computed: true},
-				"id":               schema.StringAttribute{Computed: true},
-				"dataset":          schema.StringAttribute{Required: true},
-				"spam_filter_yaml": schema.StringAttribute{Required: true},
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"origin":              schema.StringAttribute{Computed: true},
+				"id":                  schema.StringAttribute{Computed: true},
+				"dataset":             schema.StringAttribute{Required: true},
+				"spam_filter_yaml":    schema.StringAttribute{Required: true},
 			},
 		},
 	}
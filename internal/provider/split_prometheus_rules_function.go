@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &SplitPrometheusRulesFunction{}
+
+// prometheusRuleMemberAttrTypes is the object type of an element of the map
+// split_prometheus_rules returns.
+var prometheusRuleMemberAttrTypes = map[string]attr.Type{
+	"yaml":         types.StringType,
+	"is_recording": types.BoolType,
+	"group":        types.StringType,
+	"name":         types.StringType,
+}
+
+// NewSplitPrometheusRulesFunction is a helper function to simplify the
+// provider implementation.
+func NewSplitPrometheusRulesFunction() function.Function {
+	return &SplitPrometheusRulesFunction{}
+}
+
+// SplitPrometheusRulesFunction splits a PrometheusRule document into the
+// single-rule documents dash0_check_rule and dash0_recording_rule accept.
+type SplitPrometheusRulesFunction struct{}
+
+func (f *SplitPrometheusRulesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "split_prometheus_rules"
+}
+
+func (f *SplitPrometheusRulesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a PrometheusRule document into single-rule documents",
+		MarkdownDescription: "Splits a PrometheusRule document with any number of groups and rules into one document per " +
+			"rule, each holding a single group with a single rule, the shape `dash0_check_rule` and `dash0_recording_rule` " +
+			"accept. The rules are split the same way `dash0_prometheus_rule` splits them.\n\n" +
+			"The result is a map keyed by `<group>/<alert>`, or `<group>/<record>` for recording rules. When the same " +
+			"name appears more than once in a group, later occurrences are keyed with a `#2`, `#3`, ... suffix. Each " +
+			"element is an object with the following attributes:\n\n" +
+			"- `yaml` - The single-rule document. It keeps the source document's `apiVersion`, `kind` and " +
+			"`metadata.name`, and the group's other fields such as `interval`. For alerting rules, the source " +
+			"document's top-level `metadata.annotations` are merged into the rule's own annotations, the rule's own " +
+			"annotations taking precedence.\n" +
+			"- `is_recording` - Whether the rule is a recording rule, for `dash0_recording_rule`, rather than an " +
+			"alerting rule, for `dash0_check_rule`.\n" +
+			"- `group` - The name of the group the rule belongs to.\n" +
+			"- `name` - The rule's alert name, or its record name for recording rules.\n\n" +
+			"Provider-defined functions require Terraform 1.8 or later.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "yaml",
+				MarkdownDescription: "The PrometheusRule document, in YAML or JSON.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ObjectType{AttrTypes: prometheusRuleMemberAttrTypes},
+		},
+	}
+}
+
+func (f *SplitPrometheusRulesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	members, err := converter.SplitPrometheusRules(document)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to split the PrometheusRule document: %s", err))
+		return
+	}
+
+	elements := make(map[string]attr.Value, len(members))
+	for _, member := range members {
+		element, diags := types.ObjectValue(prometheusRuleMemberAttrTypes, map[string]attr.Value{
+			"yaml":         types.StringValue(member.YAML),
+			"is_recording": types.BoolValue(member.IsRecording),
+			"group":        types.StringValue(member.Group),
+			"name":         types.StringValue(member.Name),
+		})
+		if diags.HasError() {
+			resp.Error = function.FuncErrorFromDiags(ctx, diags)
+			return
+		}
+		elements[member.Key] = element
+	}
+
+	result, diags := types.MapValue(types.ObjectType{AttrTypes: prometheusRuleMemberAttrTypes}, elements)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const splitTestPrometheusRule = `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: payments
+  annotations:
+    dash0.com/sharing: team
+spec:
+  groups:
+    - name: api
+      interval: 1m
+      rules:
+        - alert: HighErrorRate
+          expr: sum(rate(errors[5m])) > 1
+          annotations:
+            summary: Too many errors
+        - record: api:requests:rate5m
+          expr: sum(rate(requests[5m]))
+    - name: db
+      rules:
+        - alert: SlowQueries
+          expr: histogram_quantile(0.99, sum by (le) (rate(query_duration_bucket[5m]))) > 1
+          annotations:
+            dash0.com/sharing: private
+`
+
+// splitTestMember is an element of the map split_prometheus_rules returns.
+type splitTestMember struct {
+	YAML        string `tfsdk:"yaml"`
+	IsRecording bool   `tfsdk:"is_recording"`
+	Group       string `tfsdk:"group"`
+	Name        string `tfsdk:"name"`
+}
+
+// splitTestMembers runs split_prometheus_rules and returns its result.
+func splitTestMembers(t *testing.T, document string) map[string]splitTestMember {
+	t.Helper()
+	resp := runFunction(&SplitPrometheusRulesFunction{}, types.MapUnknown(types.ObjectType{AttrTypes: prometheusRuleMemberAttrTypes}), document)
+	require.Nil(t, resp.Error)
+
+	var members map[string]splitTestMember
+	require.False(t, resp.Result.Value().(types.Map).ElementsAs(context.Background(), &members, false).HasError())
+	return members
+}
+
+func TestSplitPrometheusRulesFunction_Metadata(t *testing.T) {
+	resp := &function.MetadataResponse{}
+	(&SplitPrometheusRulesFunction{}).Metadata(context.Background(), function.MetadataRequest{}, resp)
+	assert.Equal(t, "split_prometheus_rules", resp.Name)
+}
+
+func TestSplitPrometheusRulesFunction_Run(t *testing.T) {
+	members := splitTestMembers(t, splitTestPrometheusRule)
+
+	require.Len(t, members, 3)
+
+	high := members["api/HighErrorRate"]
+	assert.False(t, high.IsRecording)
+	assert.Equal(t, "api", high.Group)
+	assert.Equal(t, "HighErrorRate", high.Name)
+	var highDoc map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(high.YAML), &highDoc))
+	group := highDoc["spec"].(map[string]interface{})["groups"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "1m", group["interval"])
+	rules := group["rules"].([]interface{})
+	require.Len(t, rules, 1)
+	assert.Equal(t, map[string]interface{}{
+		"dash0.com/sharing": "team",
+		"summary":           "Too many errors",
+	}, rules[0].(map[string]interface{})["annotations"])
+	assert.Equal(t, map[string]interface{}{"name": "payments"}, highDoc["metadata"])
+
+	record := members["api/api:requests:rate5m"]
+	assert.True(t, record.IsRecording)
+	assert.Equal(t, "api:requests:rate5m", record.Name)
+
+	slow := members["db/SlowQueries"]
+	assert.Contains(t, slow.YAML, "dash0.com/sharing: private")
+}
+
+func TestSplitPrometheusRulesFunction_Run_DuplicateNames(t *testing.T) {
+	members := splitTestMembers(t, `spec:
+  groups:
+    - name: api
+      rules:
+        - alert: High
+          expr: a > 1
+        - alert: High
+          expr: b > 1
+`)
+
+	assert.Contains(t, members, "api/High")
+	assert.Contains(t, members, "api/High#2")
+}
+
+func TestSplitPrometheusRulesFunction_Run_Invalid(t *testing.T) {
+	for name, document := range map[string]string{
+		"not yaml":  "spec: [",
+		"no groups": "spec: {}",
+		"no rules":  "spec:\n  groups:\n    - name: api\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := runFunction(&SplitPrometheusRulesFunction{}, types.MapUnknown(types.ObjectType{AttrTypes: prometheusRuleMemberAttrTypes}), document)
+
+			require.NotNil(t, resp.Error)
+			assert.Contains(t, resp.Error.Text, "Unable to split the PrometheusRule document")
+		})
+	}
+}
@@ -3,15 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"maps"
+	"reflect"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,9 +25,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &SyntheticCheckResource{}
-	_ resource.ResourceWithConfigure   = &SyntheticCheckResource{}
-	_ resource.ResourceWithImportState = &SyntheticCheckResource{}
+	_ resource.Resource                   = &SyntheticCheckResource{}
+	_ resource.ResourceWithConfigure      = &SyntheticCheckResource{}
+	_ resource.ResourceWithImportState    = &SyntheticCheckResource{}
+	_ resource.ResourceWithIdentity       = &SyntheticCheckResource{}
+	_ resource.ResourceWithModifyPlan     = &SyntheticCheckResource{}
+	_ resource.ResourceWithValidateConfig = &SyntheticCheckResource{}
 )
 
 // NewSyntheticCheckResource is a helper function to simplify the provider implementation.
@@ -40,6 +44,21 @@ type SyntheticCheckResource struct {
 	// defaultDataset is the provider-level default dataset, inherited by this
 	// resource's `dataset` attribute when it is omitted from configuration.
 	defaultDataset string
+	// policy is the provider-level policy, enforced in ModifyPlan.
+	policy policy
+	// planValidation is the provider's `plan_validation` setting, applied in
+	// ModifyPlan.
+	planValidation planValidation
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
 }
 
 // syntheticCheckModel is the Terraform state model for a synthetic check resource.
@@ -49,6 +68,32 @@ type syntheticCheckModel struct {
 	Dataset            types.String `tfsdk:"dataset"`
 	SyntheticCheckYaml types.String `tfsdk:"synthetic_check_yaml"`
 	URL                types.String `tfsdk:"url"`
+	Name               types.String `tfsdk:"name"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Request            types.Object `tfsdk:"request"`
+	Assertions         types.List   `tfsdk:"assertion"`
+	Schedule           types.Object `tfsdk:"schedule"`
+	Notifications      types.Object `tfsdk:"notifications"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	AllowDatasetMove   types.Bool   `tfsdk:"allow_dataset_move"`
+	Datasets           types.Set    `tfsdk:"datasets"`
+	DatasetIDs         types.Map    `tfsdk:"dataset_ids"`
+	DatasetURLs        types.Map    `tfsdk:"dataset_urls"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
+}
+
+// checkYAML returns the synthetic check document to send to the API: the
+// synthetic_check_yaml attribute, or the document rendered from the typed
+// attributes and blocks.
+func (m *syntheticCheckModel) checkYAML(ctx context.Context, diags *diag.Diagnostics) string {
+	if m.usesTypedBlocks() {
+		return syntheticCheckTypedDocument(ctx, m, diags)
+	}
+	return m.SyntheticCheckYaml.ValueString()
 }
 
 // Configure adds the provider configured client to the resource.
@@ -68,15 +113,31 @@ func (r *SyntheticCheckResource) Configure(_ context.Context, req resource.Confi
 
 	r.client = data.client
 	r.defaultDataset = data.defaultDataset
+	r.policy = data.policy
+	r.planValidation = data.planValidation
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
 }
 
 func (r *SyntheticCheckResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_synthetic_check"
+	// Moving the resource to another dataset (allow_dataset_move) changes the
+	// dataset in its identity.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the synthetic check by.
+func (r *SyntheticCheckResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = datasetAssetIdentitySchema("synthetic check")
 }
 
 func (r *SyntheticCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: `Manages a Dash0 Synthetic Check. Synthetic checks periodically probe endpoints or URLs from multiple locations to monitor availability, latency, and correctness of your services. See [Synthetic Monitoring](https://dash0.com/docs/dash0/monitoring/synthetics/synthetic-monitoring) and [Manage Synthetic Checks as Code](https://dash0.com/docs/dash0/monitoring/synthetics/manage-synthetic-checks-as-code) for more details.`,
+		Description: `Manages a Dash0 Synthetic Check. Synthetic checks periodically probe endpoints or URLs from multiple locations to monitor availability, latency, and correctness of your services. See [Synthetic Monitoring](https://dash0.com/docs/dash0/monitoring/synthetics/synthetic-monitoring) and [Manage Synthetic Checks as Code](https://dash0.com/docs/dash0/monitoring/synthetics/manage-synthetic-checks-as-code) for more details.
+
+An HTTP check is configured either as YAML in ` + "`synthetic_check_yaml`" + ` or through ` + "`name`" + ` and the typed ` + "`request`" + `, ` + "`assertion`" + `, ` + "`schedule`" + ` and ` + "`notifications`" + ` blocks, which validate URLs, header names, intervals, locations and assertions at plan time. The YAML attribute remains available for everything the typed blocks do not cover, such as labels, annotations and other check kinds.`,
 		Attributes: map[string]schema.Attribute{
 			"origin": schema.StringAttribute{
 				Description: "A unique identifier for the synthetic check, automatically generated on creation. Used to reference the synthetic check for updates, reads, deletes, and imports.",
@@ -93,19 +154,19 @@ func (r *SyntheticCheckResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 			"dataset": schema.StringAttribute{
-				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the synthetic check belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated.",
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the synthetic check belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated, unless `allow_dataset_move` is set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					datasetRequiresReplace(),
 				},
 			},
 			"synthetic_check_yaml": schema.StringAttribute{
-				Description: "The synthetic check definition in YAML format, specifying the check type, target URL, schedule, and assertion criteria. See [Create Synthetic Checks](https://dash0.com/docs/dash0/monitoring/synthetics/create-synthetic-checks) for the available options. The `dash0.com/sharing` metadata annotation is supported to control sharing settings; changes to it trigger a resource update. All other metadata annotations are managed by the server and ignored during drift detection.",
-				Required:    true,
+				Description: "The synthetic check definition in YAML format, specifying the check type, target URL, schedule, and assertion criteria. See [Create Synthetic Checks](https://dash0.com/docs/dash0/monitoring/synthetics/create-synthetic-checks) for the available options. The `dash0.com/sharing` metadata annotation is supported to control sharing settings; changes to it trigger a resource update. All other metadata annotations are managed by the server and ignored during drift detection. A `Dash0SyntheticCheck` custom resource (`apiVersion: operator.dash0.com/v1alpha1`) as managed by the Dash0 Operator for Kubernetes is accepted as-is: the provider unwraps it the same way the Operator does, keeping `metadata.name` and the `dash0.com/` labels and annotations and defaulting `spec.plugin.display.name` to `metadata.name`. Conflicts with the typed attributes and blocks.",
+				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing),
+					yamlKinds["synthetic_check"].planModifier(),
 				},
 			},
 			"url": schema.StringAttribute{
@@ -115,8 +176,76 @@ func (r *SyntheticCheckResource) Schema(_ context.Context, _ resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"name": schema.StringAttribute{
+				Description: "The name of the synthetic check, set as `metadata.name` and display name. Required when the check is configured through the typed blocks. Conflicts with `synthetic_check_yaml`.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the check runs. Defaults to `true`. Conflicts with `synthetic_check_yaml`.",
+				Optional:    true,
+			},
 		},
+		Blocks: syntheticCheckTypedBlocks(),
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("synthetic check"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("synthetic check")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("synthetic check", "display name (`spec.plugin.display.name`)", "in its dataset")
+	resp.Schema.Attributes["allow_dataset_move"] = allowDatasetMoveAttribute("synthetic check")
+	resp.Schema.Attributes["datasets"] = datasetsAttribute("synthetic check")
+	resp.Schema.Attributes["dataset_ids"] = datasetIDsAttribute("synthetic check")
+	resp.Schema.Attributes["dataset_urls"] = datasetURLsAttribute("synthetic check")
+}
+
+// ValidateConfig checks that the synthetic check is configured either in YAML
+// or through the typed attributes and blocks, and validates the typed blocks
+// so that mistakes surface at plan time rather than at apply. It also checks
+// that at most one of dataset and datasets is set.
+func (r *SyntheticCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateDatasetReplicas(ctx, req.Config, &resp.Diagnostics)
+
+	var model syntheticCheckModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateSyntheticCheckTyped(ctx, &model, &resp.Diagnostics)
+}
+
+// ModifyPlan plans the provider-level deletion protection default, dataset
+// moves and replicas, and enforces the provider-level policy and plan
+// validation on the planned synthetic check, on the document rendered from the
+// typed blocks when they are used.
+func (r *SyntheticCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	planDatasetMove(ctx, req, resp, "id", "url")
+	planDatasetReplicas(ctx, req, resp, "id", "url")
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan syntheticCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.usesTypedBlocks() {
+		r.policy.enforcePlan(ctx, req.Plan, "dash0_synthetic_check", path.Root("synthetic_check_yaml"), &resp.Diagnostics)
+		r.planValidation.validatePlan(ctx, r.client, req.Plan, "dash0_synthetic_check", path.Root("synthetic_check_yaml"), &resp.Diagnostics)
+		return
+	}
+	// Values that are only known after apply, such as the ids of notification
+	// channels created in the same run, would be missing from the document.
+	for _, v := range []attr.Value{plan.Name, plan.Request, plan.Assertions, plan.Schedule, plan.Notifications} {
+		tfValue, err := v.ToTerraformValue(ctx)
+		if err != nil || !tfValue.IsFullyKnown() {
+			return
+		}
+	}
+	document := syntheticCheckTypedDocument(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.policy.enforce("dash0_synthetic_check", document, path.Root("name"), &resp.Diagnostics)
+	r.planValidation.validate(ctx, r.client, "dash0_synthetic_check", document, path.Root("notifications"), &resp.Diagnostics)
 }
 
 // resolveSyntheticCheck populates the synthetic check's server-assigned id and
@@ -138,6 +267,28 @@ func (r *SyntheticCheckResource) resolveSyntheticCheck(ctx context.Context, mode
 	model.URL = stringOrNull(syntheticCheckURL)
 }
 
+// replicas returns the replicas of a synthetic check with `datasets`.
+func (r *SyntheticCheckResource) replicas() datasetReplicas {
+	return datasetReplicas{
+		noun:    "synthetic check",
+		create:  r.client.CreateSyntheticCheck,
+		update:  r.client.UpdateSyntheticCheck,
+		remove:  r.client.DeleteSyntheticCheck,
+		get:     r.client.GetSyntheticCheck,
+		resolve: r.client.ResolveSyntheticCheck,
+	}
+}
+
+// resolveLastModified populates the synthetic check's last-modified attributes
+// after a create or update (best-effort), and records the revision the write
+// produced for conflict detection.
+func (r *SyntheticCheckResource) resolveLastModified(ctx context.Context, model *syntheticCheckModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "synthetic check", func(ctx context.Context) (string, error) {
+		return r.client.GetSyntheticCheck(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *SyntheticCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model syntheticCheckModel
 	diags := req.Plan.Get(ctx, &model)
@@ -147,13 +298,17 @@ func (r *SyntheticCheckResource) Create(ctx context.Context, req resource.Create
 	}
 
 	model.Origin = types.StringValue("tf_" + uuid.New().String())
-	if model.Dataset.IsNull() || model.Dataset.IsUnknown() {
+	if model.Datasets.IsNull() && (model.Dataset.IsNull() || model.Dataset.IsUnknown()) {
 		model.Dataset = types.StringValue(r.defaultDataset)
 	}
 
 	// Validate YAML format
+	checkDocument := model.checkYAML(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var checkYaml interface{}
-	err := yaml.Unmarshal([]byte(model.SyntheticCheckYaml.ValueString()), &checkYaml)
+	err := yaml.Unmarshal([]byte(checkDocument), &checkYaml)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid YAML",
@@ -162,14 +317,41 @@ func (r *SyntheticCheckResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.SyntheticCheckYaml.ValueString())
+	// Unwrap an Operator custom resource envelope, if any, and convert YAML
+	// to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(converter.UnwrapOperatorSyntheticCheck(checkDocument))
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert synthetic check YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateSyntheticCheck(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Write a replica of the synthetic check to each of its datasets instead, if
+	// datasets is set.
+	if !model.Datasets.IsNull() {
+		model.Datasets, model.DatasetIDs, model.DatasetURLs = r.replicas().apply(ctx, model.Origin.ValueString(), jsonBody, types.SetNull(types.StringType), model.Datasets, model.DatasetIDs, model.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "created a replicated synthetic check resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Take over an existing synthetic check with the same name instead of
+	// creating a second one, if adopt_existing is set.
+	createSyntheticCheck := r.client.CreateSyntheticCheck
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "synthetic check", fmt.Sprintf("in dataset %q", model.Dataset.ValueString()), jsonBody, func(ctx context.Context) ([]client.Asset, error) {
+			return r.client.ListSyntheticChecks(ctx, model.Dataset.ValueString())
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createSyntheticCheck = r.client.UpdateSyntheticCheck
+		}
+	}
+
+	err = createSyntheticCheck(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create synthetic check, got error: %s", err))
 		return
@@ -177,12 +359,14 @@ func (r *SyntheticCheckResource) Create(ctx context.Context, req resource.Create
 
 	// Resolve the id and web app URL for the newly created synthetic check (best-effort).
 	r.resolveSyntheticCheck(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a synthetic check resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
 }
 
 func (r *SyntheticCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -194,6 +378,14 @@ func (r *SyntheticCheckResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// Read each replica of a synthetic check with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.readReplicas(ctx, &state, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+		return
+	}
+
 	apiResponseJSON, err := r.client.GetSyntheticCheck(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read synthetic check, got error: %s", err))
@@ -201,31 +393,98 @@ func (r *SyntheticCheckResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	tflog.Trace(ctx, "read a synthetic check resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseJSON, &resp.Diagnostics)
+
+	r.refreshSyntheticCheck(ctx, &state, apiResponseJSON, &resp.Diagnostics)
+
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseJSON)
 
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+}
+
+// readTypedSyntheticCheck refreshes a synthetic check configured through the
+// typed blocks, updating them from the API response only when it differs from
+// the document they render to.
+func readTypedSyntheticCheck(ctx context.Context, state *syntheticCheckModel, apiResponseJSON string, diags *diag.Diagnostics) {
+	stateDocument := syntheticCheckTypedDocument(ctx, state, diags)
+	if diags.HasError() {
+		return
+	}
+	additionalIgnored := converter.FieldsAbsentFromYAML(stateDocument, converter.ConditionallyIgnoredFields)
+	equivalent, err := converter.ResourceYAMLEquivalent(stateDocument, apiResponseJSON, additionalIgnored, nil)
+	if err != nil {
+		diags.AddWarning(
+			"Synthetic Check Comparison Error",
+			fmt.Sprintf("Error comparing synthetic checks: %s. Keeping the current state.", err),
+		)
+		return
+	}
+	if equivalent {
+		tflog.Debug(ctx, "Synthetic check is equivalent, ignoring changes in metadata fields")
+		return
+	}
+
+	tflog.Debug(ctx, "Synthetic check has changed, updating state")
+	warnChangedOutsideTerraform("synthetic check", apiResponseJSON, diags)
+	if err := applySyntheticCheckDocument(ctx, state, apiResponseJSON, diags); err != nil {
+		diags.AddWarning(
+			"Synthetic Check Comparison Error",
+			fmt.Sprintf("Error reading the synthetic check: %s. Keeping the current state.", err),
+		)
+	}
+}
+
+// refreshSyntheticCheck compares the synthetic check in state with document, the
+// synthetic check as returned by the API, and replaces synthetic_check_yaml with it only
+// if they differ beyond metadata the server manages.
+func (r *SyntheticCheckResource) refreshSyntheticCheck(ctx context.Context, state *syntheticCheckModel, document string, diags *diag.Diagnostics) {
 	// Compare the current state with the retrieved synthetic check
-	if state.SyntheticCheckYaml.ValueString() != "" {
-		stateYAML := state.SyntheticCheckYaml.ValueString()
+	if state.usesTypedBlocks() {
+		readTypedSyntheticCheck(ctx, state, document, diags)
+	} else if state.SyntheticCheckYaml.ValueString() != "" {
+		stateYAML := converter.UnwrapOperatorSyntheticCheck(state.SyntheticCheckYaml.ValueString())
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, []string{converter.AnnotationSharing})
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, document, additionalIgnored, []string{converter.AnnotationSharing})
 		if err != nil {
-			resp.Diagnostics.AddWarning(
+			diags.AddWarning(
 				"Synthetic Check Comparison Error",
 				fmt.Sprintf("Error comparing synthetic checks: %s. Using API response as source of truth.", err),
 			)
-			state.SyntheticCheckYaml = types.StringValue(apiResponseJSON)
+			state.SyntheticCheckYaml = types.StringValue(document)
 		} else if !equivalent {
 			tflog.Debug(ctx, "Synthetic check has changed, updating state")
-			state.SyntheticCheckYaml = types.StringValue(apiResponseJSON)
+			warnChangedOutsideTerraform("synthetic check", document, diags)
+			state.SyntheticCheckYaml = types.StringValue(document)
 		} else {
 			tflog.Debug(ctx, "Synthetic check is equivalent, ignoring changes in metadata fields")
 		}
 	} else {
-		state.SyntheticCheckYaml = types.StringValue(apiResponseJSON)
+		state.SyntheticCheckYaml = types.StringValue(document)
 	}
+}
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+// readReplicas refreshes a synthetic check with datasets from its replicas.
+// The first replica that differs from the configured synthetic check replaces
+// synthetic_check_yaml, or the typed blocks it is configured through, so that
+// the next apply rewrites all of them.
+func (r *SyntheticCheckResource) readReplicas(ctx context.Context, state *syntheticCheckModel, diags *diag.Diagnostics) {
+	configured := *state
+	var drifted *syntheticCheckModel
+	datasets, ids, urls := r.replicas().read(ctx, state.Origin.ValueString(), state.Datasets, state.DatasetIDs, state.DatasetURLs, func(document string, diags *diag.Diagnostics) {
+		replica := configured
+		r.refreshSyntheticCheck(ctx, &replica, document, diags)
+		if drifted == nil && !reflect.DeepEqual(replica, configured) {
+			drifted = &replica
+		}
+	}, diags)
+	if drifted != nil {
+		*state = *drifted
+	}
+	state.Datasets, state.DatasetIDs, state.DatasetURLs = datasets, ids, urls
+	tflog.Trace(ctx, "read a replicated synthetic check resource")
 }
 
 func (r *SyntheticCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -246,8 +505,12 @@ func (r *SyntheticCheckResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Validate YAML format
+	checkDocument := plan.checkYAML(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var checkYaml interface{}
-	err := yaml.Unmarshal([]byte(plan.SyntheticCheckYaml.ValueString()), &checkYaml)
+	err := yaml.Unmarshal([]byte(checkDocument), &checkYaml)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid YAML",
@@ -256,31 +519,65 @@ func (r *SyntheticCheckResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.SyntheticCheckYaml.ValueString())
+	// Unwrap an Operator custom resource envelope, if any, and convert YAML
+	// to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(converter.UnwrapOperatorSyntheticCheck(checkDocument))
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert synthetic check YAML to JSON: %s", err))
 		return
 	}
 
-	// Update the existing synthetic check (dataset changes force recreation via RequiresReplace)
+	// Update the replicas of a synthetic check with datasets instead, creating and
+	// deleting those of added and removed datasets.
+	if !plan.Datasets.IsNull() {
+		plan.Origin = state.Origin
+		plan.Datasets, plan.DatasetIDs, plan.DatasetURLs = r.replicas().apply(ctx, plan.Origin.ValueString(), jsonBody, state.Datasets, plan.Datasets, state.DatasetIDs, state.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "updated a replicated synthetic check resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Update the existing synthetic check. A dataset change forces recreation via
+	// RequiresReplace, unless allow_dataset_move is set; then it is moved below.
 	plan.Origin = state.Origin
 	// The synthetic check's identifier is immutable, so neither the id nor the
 	// URL change on update; carry them from state instead of re-resolving them
 	// via the API.
 	plan.ID = state.ID
 	plan.URL = state.URL
-	err = r.client.UpdateSyntheticCheck(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update synthetic check, got error: %s", err))
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "synthetic check", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetSyntheticCheck(ctx, plan.Origin.ValueString(), state.Dataset.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.Dataset.Equal(state.Dataset) {
+		err = r.client.UpdateSyntheticCheck(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update synthetic check, got error: %s", err))
+			return
+		}
+	} else {
+		if !moveToDataset(ctx, "synthetic check", plan.Origin.ValueString(), jsonBody, state.Dataset.ValueString(), plan.Dataset.ValueString(), r.client.CreateSyntheticCheck, r.client.DeleteSyntheticCheck, &resp.Diagnostics) {
+			return
+		}
+
+		// The moved synthetic check has a new id and URL.
+		r.resolveSyntheticCheck(ctx, &plan, &resp.Diagnostics)
+	}
+
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a synthetic check resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *SyntheticCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -290,6 +587,15 @@ func (r *SyntheticCheckResource) Delete(ctx context.Context, req resource.Delete
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("synthetic check", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete every replica of a synthetic check with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.replicas().deleteAll(ctx, state.Origin.ValueString(), state.Datasets, &resp.Diagnostics)
+		return
+	}
 
 	err := r.client.DeleteSyntheticCheck(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
@@ -302,18 +608,11 @@ func (r *SyntheticCheckResource) Delete(ctx context.Context, req resource.Delete
 
 // ImportState function is required for resources that support import
 func (r *SyntheticCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
-		)
+	dataset, origin, ok := datasetImportTarget(ctx, req, "synthetic check", r.client.ListSyntheticChecks, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	dataset := idParts[0]
-	origin := idParts[1]
-
 	apiResponseJSON, err := r.client.GetSyntheticCheck(ctx, origin, dataset)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -332,4 +631,5 @@ func (r *SyntheticCheckResource) ImportState(ctx context.Context, req resource.I
 	r.resolveSyntheticCheck(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), model.URL)...)
+	setDatasetAssetIdentity(ctx, resp.Identity, types.StringValue(dataset), types.StringValue(origin), &resp.Diagnostics)
 }
@@ -127,15 +127,16 @@ spec:
 			// Setup request with current state
 			req := resource.ReadRequest{
 				State: tfsdk.State{
-					Raw: tftypes.NewValue(tftypes.Object{
-						AttributeTypes: map[string]tftypes.Type{
-							"origin":               tftypes.String,
-							"id":                   tftypes.String,
-							"dataset":              tftypes.String,
-							"synthetic_check_yaml": tftypes.String,
-							"url":                  tftypes.String,
-						},
-					}, map[string]tftypes.Value{
+					Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+						"last_modified_at":     tftypes.NewValue(tftypes.String, nil),
+						"last_modified_by":     tftypes.NewValue(tftypes.String, nil),
+						"server_version":       tftypes.NewValue(tftypes.String, nil),
+						"deletion_protection":  tftypes.NewValue(tftypes.Bool, nil),
+						"adopt_existing":       tftypes.NewValue(tftypes.Bool, nil),
+						"allow_dataset_move":   tftypes.NewValue(tftypes.Bool, nil),
+						"datasets":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+						"dataset_ids":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"dataset_urls":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 						"origin":               tftypes.NewValue(tftypes.String, "test-origin"),
 						"id":                   tftypes.NewValue(tftypes.String, nil),
 						"dataset":              tftypes.NewValue(tftypes.String, "test-dataset"),
@@ -183,3 +184,98 @@ spec:
 		})
 	}
 }
+
+// TestSyntheticCheckResource_ReadOperatorEnvelope checks that a
+// synthetic_check_yaml holding a Dash0SyntheticCheck custom resource, as
+// managed by the Dash0 Operator, is compared against the API response in its
+// unwrapped form, so the Kubernetes envelope itself is not reported as drift.
+func TestSyntheticCheckResource_ReadOperatorEnvelope(t *testing.T) {
+	envelopeYaml := `
+apiVersion: operator.dash0.com/v1alpha1
+kind: Dash0SyntheticCheck
+metadata:
+  name: examplecom
+  namespace: default
+  labels:
+    team: web
+spec:
+  enabled: true
+  plugin:
+    kind: http
+    spec:
+      request:
+        url: https://www.example.com
+`
+
+	tests := []struct {
+		name              string
+		apiResponseYaml   string
+		expectYamlUpdated bool
+	}{
+		{
+			name: "unwrapped envelope matches the API response",
+			apiResponseYaml: `
+kind: Dash0SyntheticCheck
+metadata:
+  name: examplecom
+  labels:
+    dash0.com/dataset: default
+spec:
+  enabled: true
+  plugin:
+    kind: http
+    display:
+      name: examplecom
+    spec:
+      request:
+        url: https://www.example.com
+`,
+			expectYamlUpdated: false,
+		},
+		{
+			name: "changed URL is drift",
+			apiResponseYaml: `
+kind: Dash0SyntheticCheck
+metadata:
+  name: examplecom
+spec:
+  enabled: true
+  plugin:
+    kind: http
+    display:
+      name: examplecom
+    spec:
+      request:
+        url: https://api.example.com
+`,
+			expectYamlUpdated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &SyntheticCheckResource{client: &testSyntheticCheckClient{getResponse: tc.apiResponseYaml}}
+
+			state := tfsdk.State{
+				Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+					"origin":               tftypes.NewValue(tftypes.String, "tf_check"),
+					"dataset":              tftypes.NewValue(tftypes.String, "default"),
+					"synthetic_check_yaml": tftypes.NewValue(tftypes.String, envelopeYaml),
+				}),
+				Schema: testSyntheticCheckSchema(),
+			}
+			resp := resource.ReadResponse{State: state}
+
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var resultState syntheticCheckModel
+			resp.State.Get(context.Background(), &resultState)
+			if tc.expectYamlUpdated {
+				assert.Equal(t, tc.apiResponseYaml, resultState.SyntheticCheckYaml.ValueString())
+			} else {
+				assert.Equal(t, envelopeYaml, resultState.SyntheticCheckYaml.ValueString())
+			}
+		})
+	}
+}
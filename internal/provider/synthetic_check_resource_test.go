@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -59,9 +60,15 @@ func TestSyntheticCheckResource_Schema(t *testing.T) {
 	assert.True(t, datasetAttr.Optional)
 	assert.True(t, datasetAttr.Computed)
 
-	// Check synthetic_check_yaml is required
+	// Check synthetic_check_yaml is optional, as the typed blocks are an alternative
 	checkYamlAttr := attrs["synthetic_check_yaml"].(schema.StringAttribute)
-	assert.True(t, checkYamlAttr.Required)
+	assert.True(t, checkYamlAttr.Optional)
+
+	// Check the typed blocks
+	assert.Contains(t, resp.Schema.Blocks, "request")
+	assert.Contains(t, resp.Schema.Blocks, "assertion")
+	assert.Contains(t, resp.Schema.Blocks, "schedule")
+	assert.Contains(t, resp.Schema.Blocks, "notifications")
 
 	// Check url is computed
 	urlAttr := attrs["url"].(schema.StringAttribute)
@@ -81,18 +88,19 @@ func TestSyntheticCheckResource_Create(t *testing.T) {
 	// Setup request
 	req := resource.CreateRequest{
 		Plan: tfsdk.Plan{
-			Raw: tftypes.NewValue(tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"origin":               tftypes.String,
-					"id":                   tftypes.String,
-					"dataset":              tftypes.String,
-					"synthetic_check_yaml": tftypes.String,
-					"url":                  tftypes.String,
-				},
-			}, map[string]tftypes.Value{
-				"origin":  tftypes.NewValue(tftypes.String, nil),
-				"id":      tftypes.NewValue(tftypes.String, nil),
-				"dataset": tftypes.NewValue(tftypes.String, "test-dataset"),
+			Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, nil),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
 				"synthetic_check_yaml": tftypes.NewValue(tftypes.String, `
 kind: Dash0SyntheticCheck
 metadata:
@@ -118,6 +126,7 @@ spec:
 
 	// Setup mock expectations - CreateSyntheticCheck(ctx, origin, jsonBody, dataset)
 	mockClient.On("CreateSyntheticCheck", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetSyntheticCheck", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 	// After create, the URL is resolved by origin (generated tf_-prefixed value).
 	mockClient.On("ResolveSyntheticCheck", ctx, mock.Anything, "test-dataset").Return("test-id", testURL, nil)
 
@@ -135,6 +144,94 @@ spec:
 	assert.Equal(t, testURL, resultState.URL.ValueString())
 }
 
+// TestSyntheticCheckResource_CreateOperatorEnvelope checks that a
+// synthetic_check_yaml holding a Dash0SyntheticCheck custom resource, as
+// managed by the Dash0 Operator, is sent to the API in its unwrapped form
+// while state keeps the envelope as configured, and that reading the check
+// back does not report the envelope as drift.
+func TestSyntheticCheckResource_CreateOperatorEnvelope(t *testing.T) {
+	ctx := context.Background()
+	mockClient := new(MockClient)
+	r := &SyntheticCheckResource{client: mockClient}
+
+	envelopeYaml := `apiVersion: operator.dash0.com/v1alpha1
+kind: Dash0SyntheticCheck
+metadata:
+  name: examplecom
+  namespace: default
+  labels:
+    team: web
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: "{}"
+spec:
+  enabled: true
+  plugin:
+    kind: http
+    spec:
+      request:
+        url: https://www.example.com
+`
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{
+			Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+				"dataset":              tftypes.NewValue(tftypes.String, "default"),
+				"synthetic_check_yaml": tftypes.NewValue(tftypes.String, envelopeYaml),
+			}),
+			Schema: testSyntheticCheckSchema(),
+		},
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: testSyntheticCheckSchema()}}
+
+	var sent string
+	mockClient.On("CreateSyntheticCheck", mock.Anything, mock.Anything, mock.Anything, "default").
+		Run(func(args mock.Arguments) { sent = args.String(2) }).
+		Return(nil)
+	mockClient.On("GetSyntheticCheck", mock.Anything, mock.Anything, "default").Return("", nil).Once()
+	mockClient.On("ResolveSyntheticCheck", mock.Anything, mock.Anything, "default").Return("test-id", "", nil)
+
+	r.Create(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.JSONEq(t, `{
+		"kind": "Dash0SyntheticCheck",
+		"metadata": {"name": "examplecom"},
+		"spec": {
+			"enabled": true,
+			"plugin": {
+				"kind": "http",
+				"display": {"name": "examplecom"},
+				"spec": {"request": {"url": "https://www.example.com"}}
+			}
+		}
+	}`, sent)
+
+	var created syntheticCheckModel
+	require.False(t, resp.State.Get(ctx, &created).HasError(), "state cannot be unmarshalled")
+	assert.Equal(t, envelopeYaml, created.SyntheticCheckYaml.ValueString())
+
+	// The API returns the check it was sent, plus metadata it manages.
+	var stored map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(sent), &stored))
+	stored["metadata"].(map[string]interface{})["labels"] = map[string]interface{}{
+		"dash0.com/dataset": "default",
+		"dash0.com/origin":  created.Origin.ValueString(),
+	}
+	apiResponse, err := json.Marshal(stored)
+	require.NoError(t, err)
+	mockClient.On("GetSyntheticCheck", mock.Anything, created.Origin.ValueString(), "default").Return(string(apiResponse), nil)
+
+	readResp := resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "%v", readResp.Diagnostics)
+	assert.Zero(t, readResp.Diagnostics.WarningsCount(), "%v", readResp.Diagnostics)
+
+	var read syntheticCheckModel
+	require.False(t, readResp.State.Get(ctx, &read).HasError(), "state cannot be unmarshalled")
+	assert.Equal(t, envelopeYaml, read.SyntheticCheckYaml.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
 func TestSyntheticCheckResource_CreateWithError(t *testing.T) {
 	ctx := context.Background()
 	mockClient := new(MockClient)
@@ -146,18 +243,19 @@ func TestSyntheticCheckResource_CreateWithError(t *testing.T) {
 	// Setup request
 	req := resource.CreateRequest{
 		Plan: tfsdk.Plan{
-			Raw: tftypes.NewValue(tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"origin":               tftypes.String,
-					"id":                   tftypes.String,
-					"dataset":              tftypes.String,
-					"synthetic_check_yaml": tftypes.String,
-					"url":                  tftypes.String,
-				},
-			}, map[string]tftypes.Value{
-				"origin":  tftypes.NewValue(tftypes.String, nil),
-				"id":      tftypes.NewValue(tftypes.String, nil),
-				"dataset": tftypes.NewValue(tftypes.String, "test-dataset"),
+			Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, nil),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
 				"synthetic_check_yaml": tftypes.NewValue(tftypes.String, `
 kind: Dash0SyntheticCheck
 metadata:
@@ -196,15 +294,16 @@ func TestSyntheticCheckResource_Delete(t *testing.T) {
 	// Setup request
 	req := resource.DeleteRequest{
 		State: tfsdk.State{
-			Raw: tftypes.NewValue(tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"origin":               tftypes.String,
-					"id":                   tftypes.String,
-					"dataset":              tftypes.String,
-					"synthetic_check_yaml": tftypes.String,
-					"url":                  tftypes.String,
-				},
-			}, map[string]tftypes.Value{
+			Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+				"last_modified_at":     tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":     tftypes.NewValue(tftypes.String, nil),
+				"server_version":       tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection":  tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":       tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":   tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 				"origin":               tftypes.NewValue(tftypes.String, "test-origin"),
 				"id":                   tftypes.NewValue(tftypes.String, nil),
 				"dataset":              tftypes.NewValue(tftypes.String, "test-dataset"),
@@ -230,25 +329,24 @@ func TestSyntheticCheckResource_Delete(t *testing.T) {
 
 // Helper function to create test schema
 func testSyntheticCheckSchema() schema.Schema {
-	return schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin": schema.StringAttribute{
-				Computed: true,
-			},
-			"id": schema.StringAttribute{
-				Computed: true,
-			},
-			"dataset": schema.StringAttribute{
-				Required: true,
-			},
-			"synthetic_check_yaml": schema.StringAttribute{
-				Required: true,
-			},
-			"url": schema.StringAttribute{
-				Computed: true,
-			},
-		},
+	resp := &resource.SchemaResponse{}
+	(&SyntheticCheckResource{}).Schema(context.Background(), resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// syntheticCheckTestValue builds a synthetic check object value from the
+// given attributes, setting all others to null.
+func syntheticCheckTestValue(values map[string]tftypes.Value) tftypes.Value {
+	objectType := testSyntheticCheckSchema().Type().TerraformType(context.Background()).(tftypes.Object)
+	all := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			all[name] = v
+		} else {
+			all[name] = tftypes.NewValue(attrType, nil)
+		}
 	}
+	return tftypes.NewValue(objectType, all)
 }
 
 func TestSyntheticCheckResource_SharingAnnotationTriggersReplan(t *testing.T) {
@@ -381,15 +479,16 @@ func TestSyntheticCheckResource_Update(t *testing.T) {
 	t.Run("Update same dataset", func(t *testing.T) {
 		req := resource.UpdateRequest{
 			State: tfsdk.State{
-				Raw: tftypes.NewValue(tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"origin":               tftypes.String,
-						"id":                   tftypes.String,
-						"dataset":              tftypes.String,
-						"synthetic_check_yaml": tftypes.String,
-						"url":                  tftypes.String,
-					},
-				}, map[string]tftypes.Value{
+				Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+					"last_modified_at":     tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":     tftypes.NewValue(tftypes.String, nil),
+					"server_version":       tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection":  tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":       tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":   tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"dataset_urls":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"origin":               tftypes.NewValue(tftypes.String, "test-origin"),
 					"id":                   tftypes.NewValue(tftypes.String, nil),
 					"dataset":              tftypes.NewValue(tftypes.String, "test-dataset"),
@@ -399,18 +498,19 @@ func TestSyntheticCheckResource_Update(t *testing.T) {
 				Schema: testSyntheticCheckSchema(),
 			},
 			Plan: tfsdk.Plan{
-				Raw: tftypes.NewValue(tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"origin":               tftypes.String,
-						"id":                   tftypes.String,
-						"dataset":              tftypes.String,
-						"synthetic_check_yaml": tftypes.String,
-						"url":                  tftypes.String,
-					},
-				}, map[string]tftypes.Value{
-					"origin":  tftypes.NewValue(tftypes.String, "test-origin"),
-					"id":      tftypes.NewValue(tftypes.String, nil),
-					"dataset": tftypes.NewValue(tftypes.String, "test-dataset"),
+				Raw: syntheticCheckTestValue(map[string]tftypes.Value{
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"origin":              tftypes.NewValue(tftypes.String, "test-origin"),
+					"id":                  tftypes.NewValue(tftypes.String, nil),
+					"dataset":             tftypes.NewValue(tftypes.String, "test-dataset"),
 					"synthetic_check_yaml": tftypes.NewValue(tftypes.String, `
 kind: Dash0SyntheticCheck
 metadata:
@@ -429,6 +529,7 @@ metadata:
 
 		// Setup mock expectations - UpdateSyntheticCheck(ctx, origin, jsonBody, dataset)
 		mockClient.On("UpdateSyntheticCheck", ctx, "test-origin", mock.Anything, "test-dataset").Return(nil).Once()
+		mockClient.On("GetSyntheticCheck", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 
 		r.Update(ctx, req, resp)
 
@@ -0,0 +1,891 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"gopkg.in/yaml.v3"
+)
+
+// Defaults rendered into the synthetic check document for typed attributes
+// that are not set, so that the document sent to the API is complete and the
+// server does not fill in values that would surface as drift.
+const (
+	syntheticCheckDefaultMethod            = "get"
+	syntheticCheckDefaultRedirects         = "follow"
+	syntheticCheckDefaultAllowInsecure     = false
+	syntheticCheckDefaultAddTracingHeaders = true
+	syntheticCheckDefaultStrategy          = "all_locations"
+	syntheticCheckDefaultRetries           = 3
+	syntheticCheckDefaultRetryDelay        = "1s"
+	syntheticCheckDefaultSeverity          = "critical"
+)
+
+// syntheticCheckMethods are the HTTP methods a synthetic check can send.
+var syntheticCheckMethods = []string{"get", "post", "put", "patch", "delete", "head", "options"}
+
+// syntheticCheckAssertionKind describes an assertion kind: the operators it
+// supports and which of the kind-specific attributes it requires. An
+// attribute that a kind does not require cannot be set on it.
+type syntheticCheckAssertionKind struct {
+	kind      string
+	operators []string
+	attribute string
+}
+
+// syntheticCheckAssertionKinds are the assertion kinds of HTTP synthetic
+// checks.
+var syntheticCheckAssertionKinds = []syntheticCheckAssertionKind{
+	{kind: "status_code", operators: []string{"is", "is_not", "gt", "gte", "lt", "lte"}},
+	{kind: "timing", operators: []string{"gt", "gte", "lt", "lte"}, attribute: "type"},
+	{kind: "response_header", operators: []string{"is", "is_not", "contains", "does_not_contain", "starts_with", "ends_with", "matches_regex"}, attribute: "key"},
+	{kind: "json_body", operators: []string{"is", "is_not", "contains", "does_not_contain", "gt", "gte", "lt", "lte", "matches_regex"}, attribute: "json_path"},
+	{kind: "text_body", operators: []string{"is", "is_not", "contains", "does_not_contain", "starts_with", "ends_with", "matches_regex"}},
+}
+
+// syntheticCheckTimingTypes are the request phases a timing assertion can
+// measure.
+var syntheticCheckTimingTypes = []string{"dns", "connection", "ssl", "request", "response", "total"}
+
+// syntheticCheckHeaderNamePattern matches an HTTP header name, a token as
+// defined by RFC 9110.
+var syntheticCheckHeaderNamePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// syntheticCheckLocationPattern matches the format of a location identifier
+// such as `de-frankfurt` or `us-oregon`. Only the format is checked: the set of
+// locations is not published by the API client and grows over time, so whether
+// a well-formed location exists is left to the Dash0 API on apply.
+var syntheticCheckLocationPattern = regexp.MustCompile(`^[a-z]{2}-[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// syntheticCheckRequestModel is the `request` block.
+type syntheticCheckRequestModel struct {
+	Method            types.String `tfsdk:"method"`
+	URL               types.String `tfsdk:"url"`
+	Headers           types.Map    `tfsdk:"headers"`
+	QueryParameters   types.Map    `tfsdk:"query_parameters"`
+	Body              types.String `tfsdk:"body"`
+	Redirects         types.String `tfsdk:"redirects"`
+	AllowInsecure     types.Bool   `tfsdk:"allow_insecure"`
+	AddTracingHeaders types.Bool   `tfsdk:"add_tracing_headers"`
+}
+
+// syntheticCheckAssertionModel is an `assertion` block.
+type syntheticCheckAssertionModel struct {
+	Severity types.String `tfsdk:"severity"`
+	Kind     types.String `tfsdk:"kind"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+	Type     types.String `tfsdk:"type"`
+	Key      types.String `tfsdk:"key"`
+	JSONPath types.String `tfsdk:"json_path"`
+}
+
+// syntheticCheckScheduleModel is the `schedule` block.
+type syntheticCheckScheduleModel struct {
+	Interval   types.String `tfsdk:"interval"`
+	Locations  types.Set    `tfsdk:"locations"`
+	Strategy   types.String `tfsdk:"strategy"`
+	Retries    types.Int64  `tfsdk:"retries"`
+	RetryDelay types.String `tfsdk:"retry_delay"`
+}
+
+// syntheticCheckNotificationsModel is the `notifications` block.
+type syntheticCheckNotificationsModel struct {
+	Channels types.Set `tfsdk:"channels"`
+}
+
+var syntheticCheckRequestType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"method":              types.StringType,
+	"url":                 types.StringType,
+	"headers":             types.MapType{ElemType: types.StringType},
+	"query_parameters":    types.MapType{ElemType: types.StringType},
+	"body":                types.StringType,
+	"redirects":           types.StringType,
+	"allow_insecure":      types.BoolType,
+	"add_tracing_headers": types.BoolType,
+}}
+
+var syntheticCheckAssertionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"severity":  types.StringType,
+	"kind":      types.StringType,
+	"operator":  types.StringType,
+	"value":     types.StringType,
+	"type":      types.StringType,
+	"key":       types.StringType,
+	"json_path": types.StringType,
+}}
+
+var syntheticCheckScheduleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"interval":    types.StringType,
+	"locations":   types.SetType{ElemType: types.StringType},
+	"strategy":    types.StringType,
+	"retries":     types.Int64Type,
+	"retry_delay": types.StringType,
+}}
+
+var syntheticCheckNotificationsType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"channels": types.SetType{ElemType: types.StringType},
+}}
+
+// syntheticCheckTypedBlocks returns the schema of the `request`, `assertion`,
+// `schedule` and `notifications` blocks.
+func syntheticCheckTypedBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"request": schema.SingleNestedBlock{
+			Description: "The HTTP request the check sends. Required when the check is configured through the typed blocks. Conflicts with `synthetic_check_yaml`.",
+			Attributes: map[string]schema.Attribute{
+				"method": schema.StringAttribute{
+					Description: "The HTTP method: one of `get`, `post`, `put`, `patch`, `delete`, `head` or `options`, in any case. Defaults to `get`.",
+					Optional:    true,
+				},
+				"url": schema.StringAttribute{
+					Description: "The absolute `http` or `https` URL to request.",
+					Optional:    true,
+				},
+				"headers": schema.MapAttribute{
+					Description: "HTTP headers sent with the request, keyed by header name.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"query_parameters": schema.MapAttribute{
+					Description: "Query parameters added to the URL, keyed by parameter name.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"body": schema.StringAttribute{
+					Description: "The request body.",
+					Optional:    true,
+				},
+				"redirects": schema.StringAttribute{
+					Description: "How redirects are handled. Defaults to `follow`.",
+					Optional:    true,
+				},
+				"allow_insecure": schema.BoolAttribute{
+					Description: "Whether invalid TLS certificates are accepted. Defaults to `false`.",
+					Optional:    true,
+				},
+				"add_tracing_headers": schema.BoolAttribute{
+					Description: "Whether W3C trace context headers are added to the request, so that the check's requests can be followed into the traces of the service. Defaults to `true`.",
+					Optional:    true,
+				},
+			},
+		},
+		"assertion": schema.ListNestedBlock{
+			Description: "A condition the response must meet. The check fails when a `critical` assertion does not hold and is degraded when a `degraded` one does not. Conflicts with `synthetic_check_yaml`.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"severity": schema.StringAttribute{
+						Description: "`critical` or `degraded`. Defaults to `critical`.",
+						Optional:    true,
+					},
+					"kind": schema.StringAttribute{
+						Description: "What is asserted on: `status_code`, `timing`, `response_header`, `json_body` or `text_body`.",
+						Required:    true,
+					},
+					"operator": schema.StringAttribute{
+						Description: "How the value is compared. `status_code` supports `is`, `is_not`, `gt`, `gte`, `lt` and `lte`; `timing` supports `gt`, `gte`, `lt` and `lte`; `response_header` and `text_body` support `is`, `is_not`, `contains`, `does_not_contain`, `starts_with`, `ends_with` and `matches_regex`; `json_body` supports `is`, `is_not`, `contains`, `does_not_contain`, `gt`, `gte`, `lt`, `lte` and `matches_regex`.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The value to compare against: a status code such as `200` for `status_code`, a duration such as `500ms` for `timing`, and a regular expression for `matches_regex`.",
+						Required:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Only for `timing`, where it is required. The phase of the request that is timed: `dns`, `connection`, `ssl`, `request`, `response` or `total`.",
+						Optional:    true,
+					},
+					"key": schema.StringAttribute{
+						Description: "Only for `response_header`, where it is required. The name of the response header.",
+						Optional:    true,
+					},
+					"json_path": schema.StringAttribute{
+						Description: "Only for `json_body`, where it is required. The JSONPath of the asserted field, e.g. `$.status`.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"schedule": schema.SingleNestedBlock{
+			Description: "When and where the check runs. Required when the check is configured through the typed blocks. Conflicts with `synthetic_check_yaml`.",
+			Attributes: map[string]schema.Attribute{
+				"interval": schema.StringAttribute{
+					Description: "How often the check runs, as a duration such as `1m` or `1h`.",
+					Optional:    true,
+				},
+				"locations": schema.SetAttribute{
+					Description: "The locations the check runs from, e.g. `de-frankfurt` or `us-oregon`. Only the " +
+						"`<country>-<city>` format is checked at plan time; whether a location exists is left to the Dash0 API.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"strategy": schema.StringAttribute{
+					Description: "How the check is spread over its locations. Defaults to `all_locations`.",
+					Optional:    true,
+				},
+				"retries": schema.Int64Attribute{
+					Description: "How often a failed check is retried before it is reported as failed. Defaults to `3`.",
+					Optional:    true,
+				},
+				"retry_delay": schema.StringAttribute{
+					Description: "The delay between retries, as a duration. Defaults to `1s`.",
+					Optional:    true,
+				},
+			},
+		},
+		"notifications": schema.SingleNestedBlock{
+			Description: "Where failures of the check are reported. Conflicts with `synthetic_check_yaml`.",
+			Attributes: map[string]schema.Attribute{
+				"channels": schema.SetAttribute{
+					Description: "The ids of the notification channels to notify, e.g. `dash0_notification_channel.oncall.id`.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// usesTypedBlocks reports whether the synthetic check is configured through the
+// typed attributes and blocks rather than synthetic_check_yaml.
+func (m *syntheticCheckModel) usesTypedBlocks() bool {
+	return !m.Name.IsNull() || !m.Enabled.IsNull() || !m.Request.IsNull() || len(m.Assertions.Elements()) > 0 ||
+		!m.Schedule.IsNull() || !m.Notifications.IsNull()
+}
+
+// validateSyntheticCheckTyped checks that the synthetic check is configured
+// either through synthetic_check_yaml or through the typed attributes and
+// blocks, and validates the typed blocks.
+func validateSyntheticCheckTyped(ctx context.Context, m *syntheticCheckModel, diags *diag.Diagnostics) {
+	typed := map[string]bool{
+		"name":          !m.Name.IsNull(),
+		"enabled":       !m.Enabled.IsNull(),
+		"request":       !m.Request.IsNull(),
+		"assertion":     len(m.Assertions.Elements()) > 0,
+		"schedule":      !m.Schedule.IsNull(),
+		"notifications": !m.Notifications.IsNull(),
+	}
+
+	if !m.SyntheticCheckYaml.IsNull() {
+		for _, name := range []string{"name", "enabled", "request", "assertion", "schedule", "notifications"} {
+			if typed[name] {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Conflicting synthetic check configuration",
+					fmt.Sprintf("%s cannot be combined with synthetic_check_yaml. Configure the check either in YAML or through the typed attributes and blocks.", name),
+				)
+			}
+		}
+		return
+	}
+
+	if !m.usesTypedBlocks() {
+		diags.AddError(
+			"Missing synthetic check configuration",
+			"Set synthetic_check_yaml, or set name and the request and schedule blocks.",
+		)
+		return
+	}
+	for _, name := range []string{"name", "request", "schedule"} {
+		if !typed[name] {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing synthetic check attribute",
+				fmt.Sprintf("%s is required when the check is configured through the typed attributes and blocks.", name),
+			)
+		}
+	}
+
+	if !m.Request.IsNull() && !m.Request.IsUnknown() {
+		var request syntheticCheckRequestModel
+		diags.Append(m.Request.As(ctx, &request, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		validateSyntheticCheckRequest(request, diags)
+	}
+	if !m.Assertions.IsUnknown() {
+		var assertions []syntheticCheckAssertionModel
+		diags.Append(m.Assertions.ElementsAs(ctx, &assertions, false)...)
+		for i, assertion := range assertions {
+			validateSyntheticCheckAssertion(path.Root("assertion").AtListIndex(i), assertion, diags)
+		}
+	}
+	if !m.Schedule.IsNull() && !m.Schedule.IsUnknown() {
+		var schedule syntheticCheckScheduleModel
+		diags.Append(m.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		validateSyntheticCheckSchedule(schedule, diags)
+	}
+}
+
+func validateSyntheticCheckRequest(request syntheticCheckRequestModel, diags *diag.Diagnostics) {
+	requestPath := path.Root("request")
+
+	if isKnown(request.Method) && !slices.Contains(syntheticCheckMethods, strings.ToLower(request.Method.ValueString())) {
+		diags.AddAttributeError(
+			requestPath.AtName("method"),
+			"Invalid HTTP method",
+			fmt.Sprintf("method must be one of %s, got: %q.", strings.Join(syntheticCheckMethods, ", "), request.Method.ValueString()),
+		)
+	}
+
+	switch {
+	case request.URL.IsNull():
+		diags.AddAttributeError(
+			requestPath.AtName("url"),
+			"Missing synthetic check attribute",
+			"url is required in the request block.",
+		)
+	case !request.URL.IsUnknown():
+		u, err := url.Parse(request.URL.ValueString())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			diags.AddAttributeError(
+				requestPath.AtName("url"),
+				"Invalid URL",
+				fmt.Sprintf("url must be an absolute http or https URL, got: %q.", request.URL.ValueString()),
+			)
+		}
+	}
+
+	if !request.Headers.IsNull() && !request.Headers.IsUnknown() {
+		for _, name := range slices.Sorted(maps.Keys(request.Headers.Elements())) {
+			if !syntheticCheckHeaderNamePattern.MatchString(name) {
+				diags.AddAttributeError(
+					requestPath.AtName("headers").AtMapKey(name),
+					"Invalid header name",
+					fmt.Sprintf("%q is not a valid HTTP header name. Header names consist of letters, digits and the characters !#$%%&'*+-.^_`|~.", name),
+				)
+			}
+		}
+	}
+}
+
+func validateSyntheticCheckAssertion(assertionPath path.Path, assertion syntheticCheckAssertionModel, diags *diag.Diagnostics) {
+	if isKnown(assertion.Severity) && assertion.Severity.ValueString() != "critical" && assertion.Severity.ValueString() != "degraded" {
+		diags.AddAttributeError(
+			assertionPath.AtName("severity"),
+			"Invalid assertion severity",
+			fmt.Sprintf("severity must be critical or degraded, got: %q.", assertion.Severity.ValueString()),
+		)
+	}
+	if !isKnown(assertion.Kind) {
+		return
+	}
+
+	idx := slices.IndexFunc(syntheticCheckAssertionKinds, func(k syntheticCheckAssertionKind) bool {
+		return k.kind == assertion.Kind.ValueString()
+	})
+	if idx < 0 {
+		kinds := make([]string, len(syntheticCheckAssertionKinds))
+		for i, k := range syntheticCheckAssertionKinds {
+			kinds[i] = k.kind
+		}
+		diags.AddAttributeError(
+			assertionPath.AtName("kind"),
+			"Invalid assertion kind",
+			fmt.Sprintf("kind must be one of %s, got: %q.", strings.Join(kinds, ", "), assertion.Kind.ValueString()),
+		)
+		return
+	}
+	kind := syntheticCheckAssertionKinds[idx]
+
+	if isKnown(assertion.Operator) && !slices.Contains(kind.operators, assertion.Operator.ValueString()) {
+		diags.AddAttributeError(
+			assertionPath.AtName("operator"),
+			"Invalid assertion operator",
+			fmt.Sprintf("%s assertions support the operators %s, got: %q.", kind.kind, strings.Join(kind.operators, ", "), assertion.Operator.ValueString()),
+		)
+	}
+
+	attributes := map[string]types.String{"type": assertion.Type, "key": assertion.Key, "json_path": assertion.JSONPath}
+	for _, name := range []string{"type", "key", "json_path"} {
+		switch {
+		case name == kind.attribute && attributes[name].IsNull():
+			diags.AddAttributeError(
+				assertionPath.AtName(name),
+				"Missing assertion attribute",
+				fmt.Sprintf("%s is required for %s assertions.", name, kind.kind),
+			)
+		case name != kind.attribute && !attributes[name].IsNull():
+			diags.AddAttributeError(
+				assertionPath.AtName(name),
+				"Unsupported assertion attribute",
+				fmt.Sprintf("%s cannot be set on %s assertions.", name, kind.kind),
+			)
+		}
+	}
+	if kind.kind == "timing" && isKnown(assertion.Type) && !slices.Contains(syntheticCheckTimingTypes, assertion.Type.ValueString()) {
+		diags.AddAttributeError(
+			assertionPath.AtName("type"),
+			"Invalid timing assertion",
+			fmt.Sprintf("type must be one of %s, got: %q.", strings.Join(syntheticCheckTimingTypes, ", "), assertion.Type.ValueString()),
+		)
+	}
+
+	if !isKnown(assertion.Value) {
+		return
+	}
+	value := assertion.Value.ValueString()
+	switch {
+	case assertion.Operator.ValueString() == "matches_regex":
+		if _, err := regexp.Compile(value); err != nil {
+			diags.AddAttributeError(
+				assertionPath.AtName("value"),
+				"Invalid assertion value",
+				fmt.Sprintf("value must be a valid regular expression: %s.", err),
+			)
+		}
+	case kind.kind == "status_code":
+		if code, err := strconv.Atoi(value); err != nil || code < 100 || code > 599 {
+			diags.AddAttributeError(
+				assertionPath.AtName("value"),
+				"Invalid assertion value",
+				fmt.Sprintf("value must be an HTTP status code between 100 and 599, got: %q.", value),
+			)
+		}
+	case kind.kind == "timing":
+		if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+			diags.AddAttributeError(
+				assertionPath.AtName("value"),
+				"Invalid assertion value",
+				fmt.Sprintf("value must be a positive duration such as \"500ms\" or \"2s\", got: %q.", value),
+			)
+		}
+	}
+}
+
+func validateSyntheticCheckSchedule(schedule syntheticCheckScheduleModel, diags *diag.Diagnostics) {
+	schedulePath := path.Root("schedule")
+
+	switch {
+	case schedule.Interval.IsNull():
+		diags.AddAttributeError(
+			schedulePath.AtName("interval"),
+			"Missing synthetic check attribute",
+			"interval is required in the schedule block.",
+		)
+	case !schedule.Interval.IsUnknown():
+		if d, err := time.ParseDuration(schedule.Interval.ValueString()); err != nil || d <= 0 {
+			diags.AddAttributeError(
+				schedulePath.AtName("interval"),
+				"Invalid interval",
+				fmt.Sprintf("interval must be a positive duration such as \"1m\" or \"1h\", got: %q.", schedule.Interval.ValueString()),
+			)
+		}
+	}
+
+	switch {
+	case schedule.Locations.IsNull() || (!schedule.Locations.IsUnknown() && len(schedule.Locations.Elements()) == 0):
+		diags.AddAttributeError(
+			schedulePath.AtName("locations"),
+			"Missing synthetic check attribute",
+			"locations must list at least one location.",
+		)
+	case !schedule.Locations.IsUnknown():
+		for _, v := range schedule.Locations.Elements() {
+			location, ok := v.(types.String)
+			if !ok || !isKnown(location) || syntheticCheckLocationPattern.MatchString(location.ValueString()) {
+				continue
+			}
+			diags.AddAttributeError(
+				schedulePath.AtName("locations"),
+				"Invalid location",
+				fmt.Sprintf("%q is not a location identifier. Locations are written in lowercase as <country>-<city>, e.g. \"de-frankfurt\" or \"us-oregon\".", location.ValueString()),
+			)
+		}
+	}
+
+	if isKnown(schedule.Retries) && schedule.Retries.ValueInt64() < 0 {
+		diags.AddAttributeError(
+			schedulePath.AtName("retries"),
+			"Invalid retries",
+			fmt.Sprintf("retries must not be negative, got: %d.", schedule.Retries.ValueInt64()),
+		)
+	}
+	if isKnown(schedule.RetryDelay) {
+		if d, err := time.ParseDuration(schedule.RetryDelay.ValueString()); err != nil || d < 0 {
+			diags.AddAttributeError(
+				schedulePath.AtName("retry_delay"),
+				"Invalid retry delay",
+				fmt.Sprintf("retry_delay must be a duration such as \"1s\", got: %q.", schedule.RetryDelay.ValueString()),
+			)
+		}
+	}
+}
+
+// syntheticCheckTypedDocument renders the typed attributes and blocks as a
+// Dash0SyntheticCheck document in JSON, which is also valid YAML and is handled
+// exactly like synthetic_check_yaml from then on. Unset attributes are rendered
+// with their defaults, and maps and sets are sorted so that the document does
+// not depend on their order.
+func syntheticCheckTypedDocument(ctx context.Context, m *syntheticCheckModel, diags *diag.Diagnostics) string {
+	var request syntheticCheckRequestModel
+	if !m.Request.IsNull() {
+		diags.Append(m.Request.As(ctx, &request, basetypes.ObjectAsOptions{})...)
+	}
+	var assertions []syntheticCheckAssertionModel
+	diags.Append(m.Assertions.ElementsAs(ctx, &assertions, false)...)
+	var schedule syntheticCheckScheduleModel
+	if !m.Schedule.IsNull() {
+		diags.Append(m.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	}
+	channels := []string{}
+	if !m.Notifications.IsNull() {
+		var notifications syntheticCheckNotificationsModel
+		diags.Append(m.Notifications.As(ctx, &notifications, basetypes.ObjectAsOptions{})...)
+		if !notifications.Channels.IsNull() {
+			diags.Append(notifications.Channels.ElementsAs(ctx, &channels, false)...)
+		}
+	}
+	var locations []string
+	if !schedule.Locations.IsNull() {
+		diags.Append(schedule.Locations.ElementsAs(ctx, &locations, false)...)
+	}
+	if diags.HasError() {
+		return ""
+	}
+	slices.Sort(channels)
+	slices.Sort(locations)
+
+	critical := []interface{}{}
+	degraded := []interface{}{}
+	for _, a := range assertions {
+		spec := map[string]interface{}{
+			"operator": a.Operator.ValueString(),
+			"value":    a.Value.ValueString(),
+		}
+		if !a.Type.IsNull() {
+			spec["type"] = a.Type.ValueString()
+		}
+		if !a.Key.IsNull() {
+			spec["key"] = a.Key.ValueString()
+		}
+		if !a.JSONPath.IsNull() {
+			spec["jsonPath"] = a.JSONPath.ValueString()
+		}
+		assertion := map[string]interface{}{"kind": a.Kind.ValueString(), "spec": spec}
+		if stringOrDefault(a.Severity, syntheticCheckDefaultSeverity) == "degraded" {
+			degraded = append(degraded, assertion)
+		} else {
+			critical = append(critical, assertion)
+		}
+	}
+
+	requestDocument := map[string]interface{}{
+		"method":          strings.ToLower(stringOrDefault(request.Method, syntheticCheckDefaultMethod)),
+		"url":             request.URL.ValueString(),
+		"headers":         syntheticCheckNameValues(ctx, request.Headers, diags),
+		"queryParameters": syntheticCheckNameValues(ctx, request.QueryParameters, diags),
+		"redirects":       stringOrDefault(request.Redirects, syntheticCheckDefaultRedirects),
+		"tls": map[string]interface{}{
+			"allowInsecure": boolOrDefault(request.AllowInsecure, syntheticCheckDefaultAllowInsecure),
+		},
+		"tracing": map[string]interface{}{
+			"addTracingHeaders": boolOrDefault(request.AddTracingHeaders, syntheticCheckDefaultAddTracingHeaders),
+		},
+	}
+	if !request.Body.IsNull() {
+		requestDocument["body"] = request.Body.ValueString()
+	}
+
+	retries := int64(syntheticCheckDefaultRetries)
+	if !schedule.Retries.IsNull() {
+		retries = schedule.Retries.ValueInt64()
+	}
+
+	document := map[string]interface{}{
+		"kind":     "Dash0SyntheticCheck",
+		"metadata": map[string]interface{}{"name": m.Name.ValueString()},
+		"spec": map[string]interface{}{
+			"enabled":       boolOrDefault(m.Enabled, true),
+			"notifications": map[string]interface{}{"channels": channels},
+			"plugin": map[string]interface{}{
+				"display": map[string]interface{}{"name": m.Name.ValueString()},
+				"kind":    "http",
+				"spec": map[string]interface{}{
+					"assertions": map[string]interface{}{
+						"criticalAssertions": critical,
+						"degradedAssertions": degraded,
+					},
+					"request": requestDocument,
+				},
+			},
+			"retries": map[string]interface{}{
+				"kind": "fixed",
+				"spec": map[string]interface{}{
+					"attempts": retries,
+					"delay":    stringOrDefault(schedule.RetryDelay, syntheticCheckDefaultRetryDelay),
+				},
+			},
+			"schedule": map[string]interface{}{
+				"interval":  schedule.Interval.ValueString(),
+				"locations": locations,
+				"strategy":  stringOrDefault(schedule.Strategy, syntheticCheckDefaultStrategy),
+			},
+		},
+	}
+	jsonBytes, err := json.Marshal(document)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to render synthetic check: %s", err))
+		return ""
+	}
+	return string(jsonBytes)
+}
+
+// syntheticCheckNameValue is a header or query parameter in the synthetic
+// check document.
+type syntheticCheckNameValue struct {
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// syntheticCheckNameValues converts a map attribute to the name/value list of
+// the synthetic check document, sorted by name.
+func syntheticCheckNameValues(ctx context.Context, value types.Map, diags *diag.Diagnostics) []syntheticCheckNameValue {
+	entries := []syntheticCheckNameValue{}
+	if value.IsNull() || value.IsUnknown() {
+		return entries
+	}
+	var m map[string]string
+	diags.Append(value.ElementsAs(ctx, &m, false)...)
+	for _, name := range slices.Sorted(maps.Keys(m)) {
+		entries = append(entries, syntheticCheckNameValue{Name: name, Value: m[name]})
+	}
+	return entries
+}
+
+// syntheticCheckDocument is the subset of a Dash0SyntheticCheck document
+// managed by the typed attributes and blocks.
+type syntheticCheckDocument struct {
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Enabled       bool `yaml:"enabled"`
+		Notifications struct {
+			Channels []string `yaml:"channels"`
+		} `yaml:"notifications"`
+		Plugin struct {
+			Display struct {
+				Name string `yaml:"name"`
+			} `yaml:"display"`
+			Spec struct {
+				Assertions struct {
+					CriticalAssertions []syntheticCheckAssertionDocument `yaml:"criticalAssertions"`
+					DegradedAssertions []syntheticCheckAssertionDocument `yaml:"degradedAssertions"`
+				} `yaml:"assertions"`
+				Request struct {
+					Method          string                    `yaml:"method"`
+					URL             string                    `yaml:"url"`
+					Headers         []syntheticCheckNameValue `yaml:"headers"`
+					QueryParameters []syntheticCheckNameValue `yaml:"queryParameters"`
+					Body            *string                   `yaml:"body"`
+					Redirects       string                    `yaml:"redirects"`
+					TLS             struct {
+						AllowInsecure bool `yaml:"allowInsecure"`
+					} `yaml:"tls"`
+					Tracing struct {
+						AddTracingHeaders bool `yaml:"addTracingHeaders"`
+					} `yaml:"tracing"`
+				} `yaml:"request"`
+			} `yaml:"spec"`
+		} `yaml:"plugin"`
+		Retries struct {
+			Spec struct {
+				Attempts int64  `yaml:"attempts"`
+				Delay    string `yaml:"delay"`
+			} `yaml:"spec"`
+		} `yaml:"retries"`
+		Schedule struct {
+			Interval  string   `yaml:"interval"`
+			Locations []string `yaml:"locations"`
+			Strategy  string   `yaml:"strategy"`
+		} `yaml:"schedule"`
+	} `yaml:"spec"`
+}
+
+// syntheticCheckAssertionDocument is an assertion in the synthetic check
+// document.
+type syntheticCheckAssertionDocument struct {
+	Kind string `yaml:"kind"`
+	Spec struct {
+		Operator string `yaml:"operator"`
+		Value    string `yaml:"value"`
+		Type     string `yaml:"type"`
+		Key      string `yaml:"key"`
+		JSONPath string `yaml:"jsonPath"`
+	} `yaml:"spec"`
+}
+
+// applySyntheticCheckDocument sets the typed attributes and blocks of the model
+// from a synthetic check document returned by the API. Optional attributes
+// that the model leaves unset stay unset as long as the API reports their
+// default, so that defaults do not surface as changes.
+func applySyntheticCheckDocument(ctx context.Context, m *syntheticCheckModel, document string, diags *diag.Diagnostics) error {
+	var parsed syntheticCheckDocument
+	if err := yaml.Unmarshal([]byte(document), &parsed); err != nil {
+		return fmt.Errorf("error parsing synthetic check: %w", err)
+	}
+	spec := parsed.Spec
+
+	var request syntheticCheckRequestModel
+	if !m.Request.IsNull() && !m.Request.IsUnknown() {
+		diags.Append(m.Request.As(ctx, &request, basetypes.ObjectAsOptions{})...)
+	}
+	var schedule syntheticCheckScheduleModel
+	if !m.Schedule.IsNull() && !m.Schedule.IsUnknown() {
+		diags.Append(m.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	}
+	var current []syntheticCheckAssertionModel
+	if !m.Assertions.IsUnknown() {
+		diags.Append(m.Assertions.ElementsAs(ctx, &current, false)...)
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	m.Name = types.StringValue(parsed.Metadata.Name)
+	m.Enabled = keepUnsetBool(m.Enabled, spec.Enabled, true)
+
+	r := spec.Plugin.Spec.Request
+	method := types.StringValue(r.Method)
+	if strings.EqualFold(request.Method.ValueString(), r.Method) || (request.Method.IsNull() && r.Method == syntheticCheckDefaultMethod) {
+		method = request.Method
+	}
+	body := types.StringNull()
+	if r.Body != nil {
+		body = types.StringValue(*r.Body)
+	}
+	requestValue, d := types.ObjectValueFrom(ctx, syntheticCheckRequestType.AttrTypes, syntheticCheckRequestModel{
+		Method:            method,
+		URL:               types.StringValue(r.URL),
+		Headers:           syntheticCheckNameValueMap(request.Headers, r.Headers),
+		QueryParameters:   syntheticCheckNameValueMap(request.QueryParameters, r.QueryParameters),
+		Body:              body,
+		Redirects:         keepUnsetString(request.Redirects, r.Redirects, syntheticCheckDefaultRedirects),
+		AllowInsecure:     keepUnsetBool(request.AllowInsecure, r.TLS.AllowInsecure, syntheticCheckDefaultAllowInsecure),
+		AddTracingHeaders: keepUnsetBool(request.AddTracingHeaders, r.Tracing.AddTracingHeaders, syntheticCheckDefaultAddTracingHeaders),
+	})
+	diags.Append(d...)
+	m.Request = requestValue
+
+	var assertions []syntheticCheckAssertionModel
+	for _, group := range []struct {
+		severity   string
+		assertions []syntheticCheckAssertionDocument
+	}{
+		{"critical", spec.Plugin.Spec.Assertions.CriticalAssertions},
+		{"degraded", spec.Plugin.Spec.Assertions.DegradedAssertions},
+	} {
+		for _, a := range group.assertions {
+			severity := types.StringValue(group.severity)
+			if i := len(assertions); group.severity == syntheticCheckDefaultSeverity && (i >= len(current) || current[i].Severity.IsNull()) {
+				severity = types.StringNull()
+			}
+			assertions = append(assertions, syntheticCheckAssertionModel{
+				Severity: severity,
+				Kind:     types.StringValue(a.Kind),
+				Operator: types.StringValue(a.Spec.Operator),
+				Value:    types.StringValue(a.Spec.Value),
+				Type:     stringOrNull(a.Spec.Type),
+				Key:      stringOrNull(a.Spec.Key),
+				JSONPath: stringOrNull(a.Spec.JSONPath),
+			})
+		}
+	}
+	if assertions == nil {
+		assertions = []syntheticCheckAssertionModel{}
+	}
+	assertionsValue, d := types.ListValueFrom(ctx, syntheticCheckAssertionType, assertions)
+	diags.Append(d...)
+	m.Assertions = assertionsValue
+
+	retries := types.Int64Value(spec.Retries.Spec.Attempts)
+	if schedule.Retries.IsNull() && spec.Retries.Spec.Attempts == syntheticCheckDefaultRetries {
+		retries = types.Int64Null()
+	}
+	locations, d := types.SetValueFrom(ctx, types.StringType, spec.Schedule.Locations)
+	diags.Append(d...)
+	scheduleValue, d := types.ObjectValueFrom(ctx, syntheticCheckScheduleType.AttrTypes, syntheticCheckScheduleModel{
+		Interval:   types.StringValue(spec.Schedule.Interval),
+		Locations:  locations,
+		Strategy:   keepUnsetString(schedule.Strategy, spec.Schedule.Strategy, syntheticCheckDefaultStrategy),
+		Retries:    retries,
+		RetryDelay: keepUnsetString(schedule.RetryDelay, spec.Retries.Spec.Delay, syntheticCheckDefaultRetryDelay),
+	})
+	diags.Append(d...)
+	m.Schedule = scheduleValue
+
+	if !m.Notifications.IsNull() || len(spec.Notifications.Channels) > 0 {
+		channels := types.SetNull(types.StringType)
+		if len(spec.Notifications.Channels) > 0 {
+			channels, d = types.SetValueFrom(ctx, types.StringType, spec.Notifications.Channels)
+			diags.Append(d...)
+		}
+		m.Notifications = types.ObjectValueMust(syntheticCheckNotificationsType.AttrTypes, map[string]attr.Value{"channels": channels})
+	}
+	return nil
+}
+
+// syntheticCheckNameValueMap converts the name/value list of the synthetic
+// check document to a map attribute. An empty list keeps the attribute null
+// when the model does not set it.
+func syntheticCheckNameValueMap(current types.Map, entries []syntheticCheckNameValue) types.Map {
+	if len(entries) == 0 && current.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]attr.Value, len(entries))
+	for _, e := range entries {
+		elements[e.Name] = types.StringValue(e.Value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// isKnown reports whether a value is neither null nor unknown.
+func isKnown(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+// stringOrDefault returns the value of s, or def when s is null or unknown.
+func stringOrDefault(s types.String, def string) string {
+	if !isKnown(s) {
+		return def
+	}
+	return s.ValueString()
+}
+
+// boolOrDefault returns the value of b, or def when b is null or unknown.
+func boolOrDefault(b types.Bool, def bool) bool {
+	if !isKnown(b) {
+		return def
+	}
+	return b.ValueBool()
+}
+
+// keepUnsetString returns remote as a value, or null when current is null and
+// remote is the default.
+func keepUnsetString(current types.String, remote, def string) types.String {
+	if current.IsNull() && (remote == def || remote == "") {
+		return types.StringNull()
+	}
+	return types.StringValue(remote)
+}
+
+// keepUnsetBool returns remote as a value, or null when current is null and
+// remote is the default.
+func keepUnsetBool(current types.Bool, remote, def bool) types.Bool {
+	if current.IsNull() && remote == def {
+		return types.BoolNull()
+	}
+	return types.BoolValue(remote)
+}
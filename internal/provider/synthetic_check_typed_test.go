@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// typedTestSyntheticCheckModel returns a model configured through the typed
+// blocks: a GET request with one critical and one degraded assertion, run
+// every minute from two locations.
+func typedTestSyntheticCheckModel() syntheticCheckModel {
+	return syntheticCheckModel{
+		Origin:             types.StringValue("test-origin"),
+		ID:                 types.StringNull(),
+		Dataset:            types.StringValue("default"),
+		SyntheticCheckYaml: types.StringNull(),
+		URL:                types.StringNull(),
+		Datasets:           types.SetNull(types.StringType),
+		DatasetIDs:         types.MapNull(types.StringType),
+		DatasetURLs:        types.MapNull(types.StringType),
+		Name:               types.StringValue("checkout-api"),
+		Enabled:            types.BoolNull(),
+		Request:            typedTestSyntheticCheckRequest("https://api.example.com/health", nil),
+		Assertions: types.ListValueMust(syntheticCheckAssertionType, []attr.Value{
+			typedTestSyntheticCheckAssertion("", "status_code", "is", "200", nil),
+			typedTestSyntheticCheckAssertion("degraded", "timing", "lte", "500ms", map[string]string{"type": "response"}),
+		}),
+		Schedule: types.ObjectValueMust(syntheticCheckScheduleType.AttrTypes, map[string]attr.Value{
+			"interval":    types.StringValue("1m"),
+			"locations":   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("us-oregon"), types.StringValue("de-frankfurt")}),
+			"strategy":    types.StringNull(),
+			"retries":     types.Int64Null(),
+			"retry_delay": types.StringNull(),
+		}),
+		Notifications: types.ObjectValueMust(syntheticCheckNotificationsType.AttrTypes, map[string]attr.Value{
+			"channels": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("channel-1")}),
+		}),
+	}
+}
+
+func typedTestSyntheticCheckRequest(url string, headers map[string]string) types.Object {
+	headersValue := types.MapNull(types.StringType)
+	if headers != nil {
+		elements := make(map[string]attr.Value, len(headers))
+		for k, v := range headers {
+			elements[k] = types.StringValue(v)
+		}
+		headersValue = types.MapValueMust(types.StringType, elements)
+	}
+	return types.ObjectValueMust(syntheticCheckRequestType.AttrTypes, map[string]attr.Value{
+		"method":              types.StringNull(),
+		"url":                 types.StringValue(url),
+		"headers":             headersValue,
+		"query_parameters":    types.MapNull(types.StringType),
+		"body":                types.StringNull(),
+		"redirects":           types.StringNull(),
+		"allow_insecure":      types.BoolNull(),
+		"add_tracing_headers": types.BoolNull(),
+	})
+}
+
+func typedTestSyntheticCheckAssertion(severity, kind, operator, value string, extra map[string]string) types.Object {
+	return types.ObjectValueMust(syntheticCheckAssertionType.AttrTypes, map[string]attr.Value{
+		"severity":  stringOrNull(severity),
+		"kind":      types.StringValue(kind),
+		"operator":  types.StringValue(operator),
+		"value":     types.StringValue(value),
+		"type":      stringOrNull(extra["type"]),
+		"key":       stringOrNull(extra["key"]),
+		"json_path": stringOrNull(extra["json_path"]),
+	})
+}
+
+// typedTestSyntheticCheckRaw converts a model to a value of the resource's
+// schema.
+func typedTestSyntheticCheckRaw(t *testing.T, m syntheticCheckModel) tftypes.Value {
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(testSyntheticCheckSchema().Type().TerraformType(context.Background()), nil),
+		Schema: testSyntheticCheckSchema(),
+	}
+	require.False(t, state.Set(context.Background(), &m).HasError())
+	return state.Raw
+}
+
+const typedTestSyntheticCheckDocument = `{
+  "kind": "Dash0SyntheticCheck",
+  "metadata": {"name": "checkout-api"},
+  "spec": {
+    "enabled": true,
+    "notifications": {"channels": ["channel-1"]},
+    "plugin": {
+      "display": {"name": "checkout-api"},
+      "kind": "http",
+      "spec": {
+        "assertions": {
+          "criticalAssertions": [{"kind": "status_code", "spec": {"operator": "is", "value": "200"}}],
+          "degradedAssertions": [{"kind": "timing", "spec": {"operator": "lte", "value": "500ms", "type": "response"}}]
+        },
+        "request": {
+          "method": "get",
+          "url": "https://api.example.com/health",
+          "headers": [],
+          "queryParameters": [],
+          "redirects": "follow",
+          "tls": {"allowInsecure": false},
+          "tracing": {"addTracingHeaders": true}
+        }
+      }
+    },
+    "retries": {"kind": "fixed", "spec": {"attempts": 3, "delay": "1s"}},
+    "schedule": {"interval": "1m", "locations": ["de-frankfurt", "us-oregon"], "strategy": "all_locations"}
+  }
+}`
+
+func TestSyntheticCheckTypedDocument(t *testing.T) {
+	m := typedTestSyntheticCheckModel()
+
+	var diags diag.Diagnostics
+	document := syntheticCheckTypedDocument(context.Background(), &m, &diags)
+
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	assert.JSONEq(t, typedTestSyntheticCheckDocument, document)
+}
+
+func TestSyntheticCheckTypedDocument_HeadersSortedByName(t *testing.T) {
+	m := typedTestSyntheticCheckModel()
+	m.Request = typedTestSyntheticCheckRequest("https://api.example.com/health", map[string]string{
+		"X-Request-Source": "dash0",
+		"Authorization":    "Bearer token",
+	})
+
+	var diags diag.Diagnostics
+	document := syntheticCheckTypedDocument(context.Background(), &m, &diags)
+
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	assert.Contains(t, document, `"headers":[{"name":"Authorization","value":"Bearer token"},{"name":"X-Request-Source","value":"dash0"}]`)
+}
+
+func TestValidateSyntheticCheckTyped(t *testing.T) {
+	cases := []struct {
+		name          string
+		modify        func(*syntheticCheckModel)
+		expectSummary string
+		expectPath    path.Path
+	}{
+		{
+			name:   "typed only",
+			modify: func(m *syntheticCheckModel) {},
+		},
+		{
+			name: "yaml only",
+			modify: func(m *syntheticCheckModel) {
+				*m = syntheticCheckModel{
+					SyntheticCheckYaml: types.StringValue("kind: Dash0SyntheticCheck"),
+					Request:            types.ObjectNull(syntheticCheckRequestType.AttrTypes),
+					Assertions:         types.ListValueMust(syntheticCheckAssertionType, nil),
+					Schedule:           types.ObjectNull(syntheticCheckScheduleType.AttrTypes),
+					Notifications:      types.ObjectNull(syntheticCheckNotificationsType.AttrTypes),
+				}
+			},
+		},
+		{
+			name: "yaml and a typed block",
+			modify: func(m *syntheticCheckModel) {
+				m.Name = types.StringNull()
+				m.Request = types.ObjectNull(syntheticCheckRequestType.AttrTypes)
+				m.Assertions = types.ListValueMust(syntheticCheckAssertionType, nil)
+				m.Notifications = types.ObjectNull(syntheticCheckNotificationsType.AttrTypes)
+				m.SyntheticCheckYaml = types.StringValue("kind: Dash0SyntheticCheck")
+			},
+			expectSummary: "Conflicting synthetic check configuration",
+			expectPath:    path.Root("schedule"),
+		},
+		{
+			name: "neither",
+			modify: func(m *syntheticCheckModel) {
+				*m = syntheticCheckModel{
+					Request:       types.ObjectNull(syntheticCheckRequestType.AttrTypes),
+					Assertions:    types.ListValueMust(syntheticCheckAssertionType, nil),
+					Schedule:      types.ObjectNull(syntheticCheckScheduleType.AttrTypes),
+					Notifications: types.ObjectNull(syntheticCheckNotificationsType.AttrTypes),
+				}
+			},
+			expectSummary: "Missing synthetic check configuration",
+		},
+		{
+			name: "typed without request",
+			modify: func(m *syntheticCheckModel) {
+				m.Request = types.ObjectNull(syntheticCheckRequestType.AttrTypes)
+			},
+			expectSummary: "Missing synthetic check attribute",
+			expectPath:    path.Root("request"),
+		},
+		{
+			name: "relative URL",
+			modify: func(m *syntheticCheckModel) {
+				m.Request = typedTestSyntheticCheckRequest("/health", nil)
+			},
+			expectSummary: "Invalid URL",
+			expectPath:    path.Root("request").AtName("url"),
+		},
+		{
+			name: "unsupported URL scheme",
+			modify: func(m *syntheticCheckModel) {
+				m.Request = typedTestSyntheticCheckRequest("ftp://example.com", nil)
+			},
+			expectSummary: "Invalid URL",
+			expectPath:    path.Root("request").AtName("url"),
+		},
+		{
+			name: "invalid header name",
+			modify: func(m *syntheticCheckModel) {
+				m.Request = typedTestSyntheticCheckRequest("https://example.com", map[string]string{"X Request": "1"})
+			},
+			expectSummary: "Invalid header name",
+			expectPath:    path.Root("request").AtName("headers").AtMapKey("X Request"),
+		},
+		{
+			name: "unknown assertion kind",
+			modify: func(m *syntheticCheckModel) {
+				m.Assertions = types.ListValueMust(syntheticCheckAssertionType, []attr.Value{
+					typedTestSyntheticCheckAssertion("", "body_size", "lt", "1000", nil),
+				})
+			},
+			expectSummary: "Invalid assertion kind",
+			expectPath:    path.Root("assertion").AtListIndex(0).AtName("kind"),
+		},
+		{
+			name: "operator not supported by kind",
+			modify: func(m *syntheticCheckModel) {
+				m.Assertions = types.ListValueMust(syntheticCheckAssertionType, []attr.Value{
+					typedTestSyntheticCheckAssertion("", "status_code", "is", "200", nil),
+					typedTestSyntheticCheckAssertion("", "timing", "contains", "500ms", map[string]string{"type": "total"}),
+				})
+			},
+			expectSummary: "Invalid assertion operator",
+			expectPath:    path.Root("assertion").AtListIndex(1).AtName("operator"),
+		},
+		{
+			name: "field not supported by kind",
+			modify: func(m *syntheticCheckModel) {
+				m.Assertions = types.ListValueMust(syntheticCheckAssertionType, []attr.Value{
+					typedTestSyntheticCheckAssertion("", "status_code", "is", "200", map[string]string{"json_path": "$.status"}),
+				})
+			},
+			expectSummary: "Unsupported assertion attribute",
+			expectPath:    path.Root("assertion").AtListIndex(0).AtName("json_path"),
+		},
+		{
+			name: "missing header key",
+			modify: func(m *syntheticCheckModel) {
+				m.Assertions = types.ListValueMust(syntheticCheckAssertionType, []attr.Value{
+					typedTestSyntheticCheckAssertion("", "response_header", "is", "application/json", nil),
+				})
+			},
+			expectSummary: "Missing assertion attribute",
+			expectPath:    path.Root("assertion").AtListIndex(0).AtName("key"),
+		},
+		{
+			name: "invalid status code",
+			modify: func(m *syntheticCheckModel) {
+				m.Assertions = types.ListValueMust(syntheticCheckAssertionType, []attr.Value{
+					typedTestSyntheticCheckAssertion("", "status_code", "is", "OK", nil),
+				})
+			},
+			expectSummary: "Invalid assertion value",
+			expectPath:    path.Root("assertion").AtListIndex(0).AtName("value"),
+		},
+		{
+			name: "invalid interval",
+			modify: func(m *syntheticCheckModel) {
+				m.Schedule = types.ObjectValueMust(syntheticCheckScheduleType.AttrTypes, map[string]attr.Value{
+					"interval":    types.StringValue("every minute"),
+					"locations":   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("de-frankfurt")}),
+					"strategy":    types.StringNull(),
+					"retries":     types.Int64Null(),
+					"retry_delay": types.StringNull(),
+				})
+			},
+			expectSummary: "Invalid interval",
+			expectPath:    path.Root("schedule").AtName("interval"),
+		},
+		{
+			name: "invalid location",
+			modify: func(m *syntheticCheckModel) {
+				m.Schedule = types.ObjectValueMust(syntheticCheckScheduleType.AttrTypes, map[string]attr.Value{
+					"interval":    types.StringValue("1m"),
+					"locations":   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Frankfurt")}),
+					"strategy":    types.StringNull(),
+					"retries":     types.Int64Null(),
+					"retry_delay": types.StringNull(),
+				})
+			},
+			expectSummary: "Invalid location",
+			expectPath:    path.Root("schedule").AtName("locations"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := typedTestSyntheticCheckModel()
+			tc.modify(&m)
+
+			var diags diag.Diagnostics
+			validateSyntheticCheckTyped(context.Background(), &m, &diags)
+
+			if tc.expectSummary == "" {
+				assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+				return
+			}
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Equal(t, tc.expectSummary, diags.Errors()[0].Summary())
+			if len(tc.expectPath.Steps()) == 0 {
+				return
+			}
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectPath, withPath.Path())
+		})
+	}
+}
+
+func TestApplySyntheticCheckDocument(t *testing.T) {
+	m := typedTestSyntheticCheckModel()
+	var diags diag.Diagnostics
+	err := applySyntheticCheckDocument(context.Background(), &m, `{
+  "kind": "Dash0SyntheticCheck",
+  "metadata": {"name": "checkout-api"},
+  "spec": {
+    "enabled": true,
+    "notifications": {"channels": ["channel-1"]},
+    "plugin": {
+      "display": {"name": "checkout-api"},
+      "kind": "http",
+      "spec": {
+        "assertions": {
+          "criticalAssertions": [{"kind": "status_code", "spec": {"operator": "is", "value": "204"}}]
+        },
+        "request": {
+          "method": "get",
+          "url": "https://api.example.com/health",
+          "headers": [],
+          "queryParameters": [],
+          "redirects": "follow",
+          "tls": {"allowInsecure": false},
+          "tracing": {"addTracingHeaders": true}
+        }
+      }
+    },
+    "retries": {"kind": "fixed", "spec": {"attempts": 5, "delay": "1s"}},
+    "schedule": {"interval": "5m", "locations": ["de-frankfurt"], "strategy": "all_locations"}
+  }
+}`, &diags)
+
+	require.NoError(t, err)
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+
+	// Defaults reported by the API stay unset.
+	assert.True(t, m.Enabled.IsNull())
+	request := m.Request.Attributes()
+	assert.True(t, request["method"].IsNull())
+	assert.True(t, request["headers"].IsNull())
+	assert.True(t, request["redirects"].IsNull())
+	assert.True(t, request["add_tracing_headers"].IsNull())
+
+	// Changes made outside of Terraform are adopted.
+	require.Len(t, m.Assertions.Elements(), 1)
+	assertion := m.Assertions.Elements()[0].(types.Object).Attributes()
+	assert.True(t, assertion["severity"].IsNull())
+	assert.Equal(t, "204", assertion["value"].(types.String).ValueString())
+	schedule := m.Schedule.Attributes()
+	assert.Equal(t, "5m", schedule["interval"].(types.String).ValueString())
+	assert.Equal(t, int64(5), schedule["retries"].(types.Int64).ValueInt64())
+	assert.True(t, schedule["retry_delay"].IsNull())
+	assert.Len(t, schedule["locations"].(types.Set).Elements(), 1)
+}
+
+func TestSyntheticCheckResource_Create_Typed(t *testing.T) {
+	mockClient := &MockClient{}
+	r := &SyntheticCheckResource{client: mockClient}
+
+	m := typedTestSyntheticCheckModel()
+	m.Origin = types.StringUnknown()
+	raw := typedTestSyntheticCheckRaw(t, m)
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Raw: raw, Schema: testSyntheticCheckSchema()}}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: testSyntheticCheckSchema()}}
+
+	mockClient.On("CreateSyntheticCheck", mock.Anything, mock.Anything, mock.MatchedBy(func(body string) bool {
+		return assert.JSONEq(t, typedTestSyntheticCheckDocument, body)
+	}), "default").Return(nil)
+	mockClient.On("ResolveSyntheticCheck", mock.Anything, mock.Anything, "default").Return("check-id", "https://app.dash0.com/x", nil)
+	mockClient.On("GetSyntheticCheck", mock.Anything, mock.Anything, "default").Return("", nil)
+
+	r.Create(context.Background(), req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+
+	var state syntheticCheckModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.True(t, state.SyntheticCheckYaml.IsNull())
+	assert.Equal(t, "checkout-api", state.Name.ValueString())
+}
+
+func TestSyntheticCheckResource_Read_Typed(t *testing.T) {
+	state := tfsdk.State{Raw: typedTestSyntheticCheckRaw(t, typedTestSyntheticCheckModel()), Schema: testSyntheticCheckSchema()}
+
+	cases := []struct {
+		name           string
+		apiResponse    string
+		expectInterval string
+		expectWarnings int
+	}{
+		{
+			name:           "server enrichment only",
+			apiResponse:    `{"kind":"Dash0SyntheticCheck","metadata":{"name":"checkout-api","labels":{"dash0.com/origin":"test-origin","dash0.com/version":"2"}},"spec":{"enabled":true,"permissions":[{"actions":["synthetic_check:read"],"role":"basic_member"}],"notifications":{"channels":["channel-1"]},"plugin":{"display":{"name":"checkout-api"},"kind":"http","spec":{"assertions":{"criticalAssertions":[{"kind":"status_code","spec":{"operator":"is","value":"200"}}],"degradedAssertions":[{"kind":"timing","spec":{"operator":"lte","value":"500ms","type":"response"}}]},"request":{"method":"get","url":"https://api.example.com/health","headers":[],"queryParameters":[],"redirects":"follow","tls":{"allowInsecure":false},"tracing":{"addTracingHeaders":true}}}},"retries":{"kind":"fixed","spec":{"attempts":3,"delay":"1s"}},"schedule":{"interval":"1m","locations":["de-frankfurt","us-oregon"],"strategy":"all_locations"}}}`,
+			expectInterval: "1m",
+		},
+		{
+			name:           "interval changed outside of Terraform",
+			apiResponse:    `{"kind":"Dash0SyntheticCheck","metadata":{"name":"checkout-api"},"spec":{"enabled":true,"notifications":{"channels":["channel-1"]},"plugin":{"display":{"name":"checkout-api"},"kind":"http","spec":{"assertions":{"criticalAssertions":[{"kind":"status_code","spec":{"operator":"is","value":"200"}}],"degradedAssertions":[{"kind":"timing","spec":{"operator":"lte","value":"500ms","type":"response"}}]},"request":{"method":"get","url":"https://api.example.com/health","headers":[],"queryParameters":[],"redirects":"follow","tls":{"allowInsecure":false},"tracing":{"addTracingHeaders":true}}}},"retries":{"kind":"fixed","spec":{"attempts":3,"delay":"1s"}},"schedule":{"interval":"10m","locations":["de-frankfurt","us-oregon"],"strategy":"all_locations"}}}`,
+			expectInterval: "10m",
+			expectWarnings: 1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &SyntheticCheckResource{client: &testSyntheticCheckClient{getResponse: tc.apiResponse}}
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tc.expectWarnings, resp.Diagnostics.WarningsCount())
+			var result syntheticCheckModel
+			require.False(t, resp.State.Get(context.Background(), &result).HasError())
+			assert.True(t, result.SyntheticCheckYaml.IsNull())
+			assert.Equal(t, tc.expectInterval, result.Schedule.Attributes()["interval"].(types.String).ValueString())
+		})
+	}
+}
+
+func TestSyntheticCheckResource_ModifyPlan_PolicyOnTypedBlocks(t *testing.T) {
+	r := &SyntheticCheckResource{policy: policy{rules: []policyRule{{
+		resourceType:               "dash0_synthetic_check",
+		requireNotificationChannel: true,
+		enforcement:                policyEnforcementError,
+	}}}}
+
+	m := typedTestSyntheticCheckModel()
+	m.Notifications = types.ObjectNull(syntheticCheckNotificationsType.AttrTypes)
+	plan := tfsdk.Plan{Raw: typedTestSyntheticCheckRaw(t, m), Schema: testSyntheticCheckSchema()}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan}, resp)
+
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, "Policy violation", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "spec.notifications.channels")
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// teamMemberEmails maps the id of every organization member the provider has
+// listed to the member's email address in lower case. Member ids are unique
+// across organizations, so like teamLocks it is package-level and shared by
+// aliased provider blocks.
+var teamMemberEmails sync.Map
+
+// rememberTeamMembers records the ids and email addresses of orgMembers, so
+// that members referenced by id can later be matched against the emails the
+// API returns without listing the organization's members again.
+func rememberTeamMembers(orgMembers []client.Member) {
+	for _, o := range orgMembers {
+		if o.ID != "" && o.Email != "" {
+			teamMemberEmails.Store(o.ID, strings.ToLower(o.Email))
+		}
+	}
+}
+
+// rememberedTeamMemberEmail returns the email address recorded for the member
+// with the given id, in lower case.
+func rememberedTeamMemberEmail(id string) (string, bool) {
+	email, ok := teamMemberEmails.Load(id)
+	if !ok {
+		return "", false
+	}
+	return email.(string), true
+}
+
+// teamMembersType is the type of the `members` attribute: a set of strings
+// whose values are equal when they refer to the same organization members.
+type teamMembersType struct {
+	basetypes.SetType
+}
+
+var _ basetypes.SetTypable = teamMembersType{}
+
+func newTeamMembersType() teamMembersType {
+	return teamMembersType{SetType: basetypes.SetType{ElemType: types.StringType}}
+}
+
+func (t teamMembersType) Equal(o attr.Type) bool {
+	other, ok := o.(teamMembersType)
+	return ok && t.SetType.Equal(other.SetType)
+}
+
+func (t teamMembersType) String() string {
+	return "teamMembersType"
+}
+
+func (t teamMembersType) ValueFromSet(_ context.Context, in basetypes.SetValue) (basetypes.SetValuable, diag.Diagnostics) {
+	return teamMembersValue{SetValue: in}, nil
+}
+
+func (t teamMembersType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.SetType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	set, ok := value.(basetypes.SetValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T", value)
+	}
+	return teamMembersValue{SetValue: set}, nil
+}
+
+func (t teamMembersType) ValueType(_ context.Context) attr.Value {
+	return teamMembersValue{}
+}
+
+// teamMembersValue is a value of teamMembersType. Members are read back from
+// the API as email addresses; its semantic equality treats a member
+// referenced by id, or by email in another letter case, as equal to that
+// email, so that Read can store the emails without the members the
+// configuration references by id surfacing as changes.
+type teamMembersValue struct {
+	basetypes.SetValue
+}
+
+var _ basetypes.SetValuableWithSemanticEquals = teamMembersValue{}
+
+func teamMembersNull() teamMembersValue {
+	return teamMembersValue{SetValue: types.SetNull(types.StringType)}
+}
+
+func teamMembersValueFrom(members []string) teamMembersValue {
+	elements := make([]attr.Value, len(members))
+	for i, member := range members {
+		elements[i] = types.StringValue(member)
+	}
+	return teamMembersValue{SetValue: types.SetValueMust(types.StringType, elements)}
+}
+
+func (v teamMembersValue) Equal(o attr.Value) bool {
+	other, ok := o.(teamMembersValue)
+	return ok && v.SetValue.Equal(other.SetValue)
+}
+
+func (v teamMembersValue) Type(_ context.Context) attr.Type {
+	return newTeamMembersType()
+}
+
+// SetSemanticEquals reports whether v and prior refer to the same
+// organization members. Ids are matched against emails through the members
+// recorded by rememberTeamMembers; an id that was never recorded only equals
+// itself.
+func (v teamMembersValue) SetSemanticEquals(ctx context.Context, prior basetypes.SetValuable) (bool, diag.Diagnostics) {
+	priorValue, diags := prior.ToSetValue(ctx)
+	if diags.HasError() || v.IsNull() || v.IsUnknown() || priorValue.IsNull() || priorValue.IsUnknown() {
+		return false, diags
+	}
+
+	var current, previous []string
+	diags.Append(v.ElementsAs(ctx, &current, false)...)
+	diags.Append(priorValue.ElementsAs(ctx, &previous, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	return sameTeamMembers(current, previous, nil), diags
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+	_ resource.ResourceWithModifyPlan  = &TeamMembershipResource{}
+)
+
+// NewTeamMembershipResource is a helper function to simplify the provider
+// implementation.
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+// TeamMembershipResource manages a single member of a team. Unlike the members
+// of dash0_team it is non-authoritative: members added to the team by anyone
+// else are left alone.
+type TeamMembershipResource struct {
+	client client.Client
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+}
+
+// teamMembershipModel is the Terraform state model for a team membership.
+type teamMembershipModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Team               types.String `tfsdk:"team"`
+	Member             types.String `tfsdk:"member"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TeamMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.resourceProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+	r.deletionProtection = data.deletionProtection
+}
+
+func (r *TeamMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+func (r *TeamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the membership of a single member in a Dash0 Team. The membership is non-authoritative: " +
+			"the resource adds or removes only its own member, and members added to the team by other workspaces, " +
+			"the Dash0 web app or other tools are left alone. This lets several parties, such as onboarding automation " +
+			"and project workspaces, share a team.\n\n" +
+			"Do not combine this resource with `members` or `spec.members` on a `dash0_team` for the same team: those " +
+			"are authoritative and would remove the memberships managed here. Leave `members` unset on a `dash0_team` " +
+			"configured through the typed attributes instead.\n\n" +
+			"Concurrent membership changes on the same team within one Terraform run are serialized; changes made " +
+			"concurrently from elsewhere can still overwrite each other, because the Dash0 API replaces a team's " +
+			"members as a whole.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the membership, `<team>,<member>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team": schema.StringAttribute{
+				Description: "The origin or server-assigned id of the team, e.g. `dash0_team.backend.origin`. Changing it moves the membership to another team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member": schema.StringAttribute{
+				Description: "The member to add to the team, referenced by email address (matched case-insensitively) or internal Dash0 id. Members that match no organization member fail the plan.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute("team membership"),
+		},
+	}
+}
+
+// ModifyPlan plans the provider-level deletion protection default and checks
+// the planned member against the organization's members, as dash0_team does
+// for its members.
+func (r *TeamMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan teamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Member.IsUnknown() {
+		return
+	}
+
+	orgMembers, err := r.client.ListMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to verify team member",
+			fmt.Sprintf("The organization's members could not be listed, so the member is not checked before apply: %s", err),
+		)
+		return
+	}
+	if len(unknownTeamMembers([]string{plan.Member.ValueString()}, orgMembers)) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member"),
+			"Unknown team member",
+			fmt.Sprintf("%q does not match the email address or id of any organization member. "+
+				"Invite them to the organization before adding them to a team.", plan.Member.ValueString()),
+		)
+	}
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model teamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddTeamMember(ctx, model.Team.ValueString(), model.Member.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add %s to team %s, got error: %s", model.Member.ValueString(), model.Team.ValueString(), err))
+		return
+	}
+	model.ID = types.StringValue(model.Team.ValueString() + "," + model.Member.ValueString())
+
+	tflog.Trace(ctx, "created a team membership resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isMember, err := r.client.HasTeamMember(ctx, state.Team.ValueString(), state.Member.ValueString())
+	if err != nil {
+		// A deleted team takes its memberships with it; clear state so the
+		// next plan re-creates the membership (and fails if the team is gone
+		// for good) instead of forcing a manual `terraform state rm`.
+		if dash0.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Team %s no longer exists on the server; removing membership from state", state.Team.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team membership, got error: %s", err))
+		return
+	}
+	if !isMember {
+		tflog.Debug(ctx, fmt.Sprintf("%s is no longer a member of team %s; removing membership from state", state.Member.ValueString(), state.Team.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read a team membership resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a change: both team and member force
+// replacement. It only persists the plan.
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if refuseProtectedDeletion("team membership", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.RemoveTeamMember(ctx, state.Team.ValueString(), state.Member.ValueString())
+	if err != nil {
+		// The team is already gone, and the membership with it.
+		if dash0.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Team %s was already gone at delete time; treating as success", state.Team.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s from team %s, got error: %s", state.Member.ValueString(), state.Team.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a team membership resource")
+}
+
+// ImportState imports a membership by `<team>,<member>`, where team is the
+// team's origin or id and member an email address or id.
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	team, member, ok := strings.Cut(req.ID, ",")
+	if !ok || team == "" || member == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <team>,<member>, e.g. tf_backend,alice@example.com; got: %q", req.ID),
+		)
+		return
+	}
+
+	isMember, err := r.client.HasTeamMember(ctx, team, member)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Team Membership",
+			fmt.Sprintf("Could not get team %s: %s", team, err),
+		)
+		return
+	}
+	if !isMember {
+		resp.Diagnostics.AddError(
+			"Error Importing Team Membership",
+			fmt.Sprintf("%s is not a member of team %s.", member, team),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), member)...)
+}
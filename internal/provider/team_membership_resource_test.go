@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dash0 "github.com/dash0hq/dash0-api-client-go"
+)
+
+func teamMembershipTestSchema() schema.Schema {
+	resp := &resource.SchemaResponse{}
+	(&TeamMembershipResource{}).Schema(context.Background(), resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// teamMembershipTestValue builds a membership object value; an empty id is
+// passed through as null.
+func teamMembershipTestValue(id, team, member string) tftypes.Value {
+	objectType := teamMembershipTestSchema().Type().TerraformType(context.Background()).(tftypes.Object)
+	idValue := tftypes.NewValue(tftypes.String, nil)
+	if id != "" {
+		idValue = tftypes.NewValue(tftypes.String, id)
+	}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                  idValue,
+		"team":                tftypes.NewValue(tftypes.String, team),
+		"member":              tftypes.NewValue(tftypes.String, member),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+	})
+}
+
+func teamMembershipTestState(team, member string) tfsdk.State {
+	return tfsdk.State{
+		Raw:    teamMembershipTestValue(team+","+member, team, member),
+		Schema: teamMembershipTestSchema(),
+	}
+}
+
+func TestTeamMembershipResource_Metadata(t *testing.T) {
+	resp := &resource.MetadataResponse{}
+	(&TeamMembershipResource{}).Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "dash0"}, resp)
+	assert.Equal(t, "dash0_team_membership", resp.TypeName)
+}
+
+func TestTeamMembershipResource_Schema(t *testing.T) {
+	s := teamMembershipTestSchema()
+
+	assert.True(t, s.Attributes["id"].IsComputed())
+	assert.True(t, s.Attributes["team"].IsRequired())
+	assert.True(t, s.Attributes["member"].IsRequired())
+}
+
+func TestTeamMembershipResource_Create(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("AddTeamMember", mock.Anything, "tf_backend", "alice@example.com").Return(nil)
+	r := &TeamMembershipResource{client: mockClient}
+
+	plan := tfsdk.Plan{Raw: teamMembershipTestValue("", "tf_backend", "alice@example.com"), Schema: teamMembershipTestSchema()}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: teamMembershipTestSchema()}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state teamMembershipModel
+	resp.State.Get(context.Background(), &state)
+	assert.Equal(t, "tf_backend,alice@example.com", state.ID.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamMembershipResource_Create_ClientError(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("AddTeamMember", mock.Anything, "tf_backend", "alice@example.com").Return(errors.New("upstream 500"))
+	r := &TeamMembershipResource{client: mockClient}
+
+	plan := tfsdk.Plan{Raw: teamMembershipTestValue("", "tf_backend", "alice@example.com"), Schema: teamMembershipTestSchema()}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: teamMembershipTestSchema()}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "upstream 500")
+}
+
+func TestTeamMembershipResource_Read(t *testing.T) {
+	cases := []struct {
+		name          string
+		isMember      bool
+		err           error
+		expectRemoved bool
+		expectError   bool
+	}{
+		{name: "still a member", isMember: true},
+		{name: "removed from the team elsewhere", isMember: false, expectRemoved: true},
+		{name: "team deleted", err: &dash0.APIError{StatusCode: 404, Status: "404 Not Found"}, expectRemoved: true},
+		{name: "other error", err: errors.New("upstream 500"), expectError: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &MockClient{}
+			mockClient.On("HasTeamMember", mock.Anything, "tf_backend", "alice@example.com").Return(tc.isMember, tc.err)
+			r := &TeamMembershipResource{client: mockClient}
+
+			state := teamMembershipTestState("tf_backend", "alice@example.com")
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tc.expectRemoved, resp.State.Raw.IsNull())
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTeamMembershipResource_Delete_NotFoundIsIdempotent(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("RemoveTeamMember", mock.Anything, "tf_backend", "alice@example.com").
+		Return(&dash0.APIError{StatusCode: 404, Status: "404 Not Found"})
+	r := &TeamMembershipResource{client: mockClient}
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: teamMembershipTestState("tf_backend", "alice@example.com")}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamMembershipResource_ModifyPlan_UnknownMember(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListMembers", mock.Anything).Return(typedTestOrgMembers, nil)
+	r := &TeamMembershipResource{client: mockClient}
+
+	plan := tfsdk.Plan{Raw: teamMembershipTestValue("", "tf_backend", "carol@example.com"), Schema: teamMembershipTestSchema()}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan}, resp)
+
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, "Unknown team member", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `"carol@example.com"`)
+}
+
+func TestTeamMembershipResource_ImportState(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("HasTeamMember", mock.Anything, "tf_backend", "alice@example.com").Return(true, nil)
+	r := &TeamMembershipResource{client: mockClient}
+
+	nullState := tfsdk.State{
+		Raw:    tftypes.NewValue(teamMembershipTestSchema().Type().TerraformType(context.Background()), nil),
+		Schema: teamMembershipTestSchema(),
+	}
+	resp := &resource.ImportStateResponse{State: nullState}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "tf_backend,alice@example.com"}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state teamMembershipModel
+	resp.State.Get(context.Background(), &state)
+	assert.Equal(t, "tf_backend", state.Team.ValueString())
+	assert.Equal(t, "alice@example.com", state.Member.ValueString())
+	assert.Equal(t, "tf_backend,alice@example.com", state.ID.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamMembershipResource_ImportState_Invalid(t *testing.T) {
+	cases := []struct {
+		name        string
+		id          string
+		expectError string
+	}{
+		{name: "missing member", id: "tf_backend", expectError: "Invalid Import ID"},
+		{name: "empty team", id: ",alice@example.com", expectError: "Invalid Import ID"},
+		{name: "not a member", id: "tf_backend,alice@example.com", expectError: "Error Importing Team Membership"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &MockClient{}
+			mockClient.On("HasTeamMember", mock.Anything, "tf_backend", "alice@example.com").Return(false, nil).Maybe()
+			r := &TeamMembershipResource{client: mockClient}
+
+			resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: teamMembershipTestSchema()}}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: tc.id}, resp)
+
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tc.expectError, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
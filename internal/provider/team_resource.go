@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"sort"
 	"strings"
 
@@ -13,7 +14,6 @@ import (
 	dash0 "github.com/dash0hq/dash0-api-client-go"
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -92,7 +92,9 @@ var (
 	_ resource.Resource                   = &TeamResource{}
 	_ resource.ResourceWithConfigure      = &TeamResource{}
 	_ resource.ResourceWithImportState    = &TeamResource{}
+	_ resource.ResourceWithIdentity       = &TeamResource{}
 	_ resource.ResourceWithValidateConfig = &TeamResource{}
+	_ resource.ResourceWithModifyPlan     = &TeamResource{}
 )
 
 // NewTeamResource is a helper function to simplify the provider implementation.
@@ -103,13 +105,42 @@ func NewTeamResource() resource.Resource {
 // TeamResource is the resource implementation.
 type TeamResource struct {
 	client client.Client
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
 }
 
 // teamModel is the Terraform state model for a team resource.
 type teamModel struct {
-	Origin   types.String `tfsdk:"origin"`
-	ID       types.String `tfsdk:"id"`
-	TeamYaml types.String `tfsdk:"team_yaml"`
+	Origin             types.String     `tfsdk:"origin"`
+	ID                 types.String     `tfsdk:"id"`
+	TeamYaml           types.String     `tfsdk:"team_yaml"`
+	Name               types.String     `tfsdk:"name"`
+	DisplayName        types.String     `tfsdk:"display_name"`
+	Color              types.Object     `tfsdk:"color"`
+	Members            teamMembersValue `tfsdk:"members"`
+	DeletionProtection types.Bool       `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool       `tfsdk:"adopt_existing"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
+}
+
+// teamYAML returns the team document to send to the API: the team_yaml
+// attribute, or the document rendered from the typed attributes.
+func (m *teamModel) teamYAML(ctx context.Context, diags *diag.Diagnostics) string {
+	if m.usesTypedAttributes() {
+		return teamTypedDocument(ctx, m, diags)
+	}
+	return m.TeamYaml.ValueString()
 }
 
 // Configure adds the provider configured client to the resource.
@@ -128,15 +159,26 @@ func (r *TeamResource) Configure(_ context.Context, req resource.ConfigureReques
 	}
 
 	r.client = data.client
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
 }
 
 func (r *TeamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team"
 }
 
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the team by.
+func (r *TeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = organizationAssetIdentitySchema("team")
+}
+
 // ValidateConfig runs plan-time validation for team_yaml so users see
 // problems on `terraform plan` rather than on the subsequent `terraform
-// apply`. Three checks fire, all cheap:
+// apply`. It first checks that the team is configured either through
+// team_yaml or through the typed attributes; for team_yaml, three further
+// checks fire, all cheap:
 //   - YAML syntax: catches malformed heredocs and typos before any write
 //     path is exercised.
 //   - Shape: asserts `kind: Dash0Team` (the CRD envelope discriminator).
@@ -153,6 +195,10 @@ func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.Validate
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	validateTeamTyped(&model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if model.TeamYaml.IsNull() || model.TeamYaml.IsUnknown() {
 		return
 	}
@@ -193,11 +239,63 @@ func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.Validate
 	warnIfCustomTeamMetadataSet(teamYaml, &resp.Diagnostics)
 }
 
+// ModifyPlan plans the provider-level deletion protection default and checks
+// the planned members against the organization's members, so that a mistyped
+// email fails `terraform plan` instead of the apply. It needs the configured
+// client and is therefore not part of ValidateConfig.
+func (r *TeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan teamModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []string
+	attribute := path.Root("members")
+	switch {
+	case plan.usesTypedAttributes():
+		if plan.Members.IsNull() || plan.Members.IsUnknown() {
+			return
+		}
+		resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &members, false)...)
+	case !plan.TeamYaml.IsUnknown():
+		members = teamYAMLMembers(plan.TeamYaml.ValueString())
+		attribute = path.Root("team_yaml")
+	}
+	if len(members) == 0 {
+		return
+	}
+
+	orgMembers, err := r.client.ListMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to verify team members",
+			fmt.Sprintf("The organization's members could not be listed, so the team's members are not checked before apply: %s", err),
+		)
+		return
+	}
+	rememberTeamMembers(orgMembers)
+	if unknown := unknownTeamMembers(members, orgMembers); len(unknown) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			attribute,
+			"Unknown team members",
+			fmt.Sprintf("The following members do not match the email address or id of any organization member: %s. "+
+				"Invite them to the organization before adding them to a team.", strings.Join(unknown, ", ")),
+		)
+	}
+}
+
 func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Dash0 Team. Teams group organization members so alert notifications, dashboards, and other assets " +
 			"can be attributed to a shared owner. Teams are organization-level resources and are not scoped to a dataset.\n\n" +
-			"Membership in `spec.members` accepts either the member's email address or their internal Dash0 id (the " +
+			"A team is configured either as YAML in `team_yaml` or through the typed `name`, `display_name`, `color` " +
+			"and `members` attributes.\n\n" +
+			"Membership in `spec.members` or `members` accepts either the member's email address or their internal Dash0 id (the " +
 			"`dash0.com/id` label value returned by the Members API, e.g. `user_01ABC...`). Emails are matched " +
 			"case-insensitively and translated to internal ids during reconciliation on the server. The provider normalizes " +
 			"server responses back to email addresses for legibility, so writing emails and refreshing state produces no drift.\n\n" +
@@ -230,13 +328,51 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					"schema version ships. Server-managed metadata fields (`dash0.com/id`, `dash0.com/source`, " +
 					"`dash0.com/created-at`, `dash0.com/updated-at`) are stripped from the state on read; the provider stamps " +
 					"`dash0.com/origin` from the `origin` attribute on write.",
-				Required: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqual(),
+					yamlKinds["team"].planModifier(),
 				},
 			},
+			"name": schema.StringAttribute{
+				Description: "The technical name of the team (`metadata.name`). Required when the team is configured through " +
+					"the typed attributes; conflicts with `team_yaml`.",
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The name the team is displayed with (`spec.display.name`). Required when the team is configured " +
+					"through the typed attributes; conflicts with `team_yaml`.",
+				Optional: true,
+			},
+			"color": schema.SingleNestedAttribute{
+				Description: "The color gradient the team is displayed with (`spec.display.color`). Required when the team is " +
+					"configured through the typed attributes; conflicts with `team_yaml`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"from": schema.StringAttribute{
+						Description: "The start color of the gradient, e.g. `#6366F1`.",
+						Required:    true,
+					},
+					"to": schema.StringAttribute{
+						Description: "The end color of the gradient, e.g. `#8B5CF6`.",
+						Required:    true,
+					},
+				},
+			},
+			"members": schema.SetAttribute{
+				Description: "The members of the team (`spec.members`), each referenced by email address or internal Dash0 id. " +
+					"Entries that match no organization member fail the plan. Members are read back from the API as email " +
+					"addresses; an entry that refers to the same member by id or in a different letter case is equal to that " +
+					"email, so refreshing state produces no drift. Leave unset to manage membership through " +
+					"`dash0_team_membership`, in which case the current members are kept on update. Conflicts with `team_yaml`.",
+				CustomType:  newTeamMembersType(),
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("team"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("team")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("team", "display name (`spec.display.name`)", "in the organization")
 }
 
 // resolveTeamID populates the team's server-assigned id on the model by
@@ -256,6 +392,16 @@ func (r *TeamResource) resolveTeamID(ctx context.Context, model *teamModel, diag
 	model.ID = stringOrNull(id)
 }
 
+// resolveLastModified populates the team's last-modified attributes after a
+// create or update (best-effort), and records the revision the write produced
+// for conflict detection.
+func (r *TeamResource) resolveLastModified(ctx context.Context, model *teamModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "team", func(ctx context.Context) (string, error) {
+		return r.client.GetTeam(ctx, model.Origin.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model teamModel
 	diags := req.Plan.Get(ctx, &model)
@@ -269,9 +415,33 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// path segment; UUIDs with dashes satisfy that constraint.
 	model.Origin = types.StringValue("tf_" + uuid.New().String())
 
+	teamDocument := model.teamYAML(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Take over an existing team with the same name instead of creating a second
+	// one, if adopt_existing is set.
+	createTeam := r.client.CreateTeam
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "team", "in the organization", teamDocument, r.client.ListTeams, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createTeam = r.client.UpdateTeam
+			// As in Update, a typed team without members keeps the members
+			// the adopted team already has.
+			if model.usesTypedAttributes() && model.Members.IsNull() {
+				createTeam = r.client.UpdateTeamKeepingMembers
+			}
+		}
+	}
+
 	// Validate YAML format before conversion.
 	var parsed interface{}
-	err := yaml.Unmarshal([]byte(model.TeamYaml.ValueString()), &parsed)
+	err := yaml.Unmarshal([]byte(teamDocument), &parsed)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid YAML",
@@ -281,13 +451,13 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Convert YAML to JSON for the API client.
-	jsonBody, err := converter.ConvertYAMLToJSON(model.TeamYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(teamDocument)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert team YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateTeam(ctx, model.Origin.ValueString(), jsonBody)
+	err = createTeam(ctx, model.Origin.ValueString(), jsonBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team, got error: %s", err))
 		return
@@ -295,12 +465,14 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Resolve the server-assigned id for the newly created team (best-effort).
 	r.resolveTeamID(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a team resource")
 
 	// Set state to fully populated data.
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, model.Origin, &resp.Diagnostics)
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -328,6 +500,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	tflog.Trace(ctx, "read a team resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseJSON, &resp.Diagnostics)
 
 	// Compare current state against the retrieved team so drift is detected
 	// only on fields the user actually authored. The normalizer's default
@@ -336,7 +509,9 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// makes stripMetadataAnnotations discard all metadata.annotations — so no
 	// team-specific list is needed here (see the block-comment above
 	// teamAlwaysIgnoredFields for the rationale).
-	if state.TeamYaml.ValueString() != "" {
+	if state.usesTypedAttributes() {
+		r.readTypedTeam(ctx, &state, apiResponseJSON, &resp.Diagnostics)
+	} else if state.TeamYaml.ValueString() != "" {
 		stateYAML := state.TeamYaml.ValueString()
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
 		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, nil)
@@ -356,6 +531,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			return
 		} else if !equivalent {
 			tflog.Debug(ctx, "Team has changed, updating state")
+			warnChangedOutsideTerraform("team", apiResponseJSON, &resp.Diagnostics)
 			state.TeamYaml = types.StringValue(apiResponseJSON)
 		} else {
 			tflog.Debug(ctx, "Team is equivalent, ignoring changes in server-managed fields")
@@ -376,9 +552,42 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		r.resolveTeamID(ctx, &state, &resp.Diagnostics)
 	}
 
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseJSON)
+
 	// Set refreshed state.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, state.Origin, &resp.Diagnostics)
+}
+
+// readTypedTeam refreshes a team configured through the typed attributes. The
+// organization's members are only listed when the members in state cannot be
+// matched against the emails returned by the API without them, i.e. when state
+// references members by id that have not been recorded yet. They are recorded
+// for the semantic equality of the members attribute, which keeps members
+// referenced by id from surfacing as drift.
+func (r *TeamResource) readTypedTeam(ctx context.Context, state *teamModel, apiResponseJSON string, diags *diag.Diagnostics) {
+	if !state.Members.IsNull() && !state.Members.IsUnknown() {
+		var current []string
+		diags.Append(state.Members.ElementsAs(ctx, &current, false)...)
+		if !sameTeamMembers(current, teamYAMLMembers(apiResponseJSON), nil) {
+			orgMembers, err := r.client.ListMembers(ctx)
+			if err != nil {
+				diags.AddWarning(
+					"Unable to list organization members",
+					fmt.Sprintf("Members referenced by id cannot be matched against the team's members: %s", err),
+				)
+			}
+			rememberTeamMembers(orgMembers)
+		}
+	}
+
+	if err := applyTeamDocument(state, apiResponseJSON); err != nil {
+		diags.AddError(
+			"Team Comparison Error",
+			fmt.Sprintf("Failed to read the team from the API response: %s. Leaving state unchanged.", err),
+		)
+	}
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -399,9 +608,22 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// A typed team without members leaves membership to
+	// dash0_team_membership. The API replaces spec.members on every write, so
+	// carry the current members over instead of clearing them, under the lock
+	// dash0_team_membership takes for its own changes.
+	updateTeam := r.client.UpdateTeam
+	if plan.usesTypedAttributes() && plan.Members.IsNull() {
+		updateTeam = r.client.UpdateTeamKeepingMembers
+	}
+	teamDocument := plan.teamYAML(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate YAML format.
 	var parsed interface{}
-	err := yaml.Unmarshal([]byte(plan.TeamYaml.ValueString()), &parsed)
+	err := yaml.Unmarshal([]byte(teamDocument), &parsed)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid YAML",
@@ -410,7 +632,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.TeamYaml.ValueString())
+	jsonBody, err := converter.ConvertYAMLToJSON(teamDocument)
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert team YAML to JSON: %s", err))
 		return
@@ -420,16 +642,28 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// and the team's server-assigned id does not change on update.
 	plan.Origin = state.Origin
 	plan.ID = state.ID
-	err = r.client.UpdateTeam(ctx, plan.Origin.ValueString(), jsonBody)
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "team", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetTeam(ctx, plan.Origin.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = updateTeam(ctx, plan.Origin.ValueString(), jsonBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team, got error: %s", err))
 		return
 	}
 
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a team resource")
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -439,6 +673,9 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("team", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
 
 	err := r.client.DeleteTeam(ctx, state.Origin.ValueString())
 	if err != nil {
@@ -459,7 +696,10 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 // ImportState allows importing an existing team by its origin (or the raw
 // team id — the server-side endpoint accepts either).
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	origin := req.ID
+	origin, ok := organizationImportTarget(ctx, req, "team", r.client.ListTeams, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
 	apiResponseJSON, err := r.client.GetTeam(ctx, origin)
 	if err != nil {
@@ -477,4 +717,5 @@ func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportState
 	model := teamModel{Origin: types.StringValue(origin)}
 	r.resolveTeamID(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
+	setOrganizationAssetIdentity(ctx, resp.Identity, types.StringValue(origin), &resp.Diagnostics)
 }
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
@@ -107,7 +106,7 @@ spec:
     - alice@example.com
     - bob@example.com`,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			name: "membership drift - server removed a member",
@@ -124,7 +123,7 @@ spec:
   members:
     - alice@example.com`,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			name: "metadata.name change - drift",
@@ -142,7 +141,7 @@ spec:
     - alice@example.com
     - bob@example.com`,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			// Regression: previously the code overwrote state.TeamYaml with
@@ -161,37 +160,21 @@ spec:
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			testSchema := schema.Schema{
-				Attributes: map[string]schema.Attribute{
-					"origin": schema.StringAttribute{
-						Computed: true,
-					},
-					"id": schema.StringAttribute{
-						Computed: true,
-					},
-					"team_yaml": schema.StringAttribute{
-						Required: true,
-					},
-				},
-			}
+			testSchema := teamTestSchema()
 
 			testClient := &testTeamClient{getResponse: tc.apiResponseYaml}
 			r := &TeamResource{client: testClient}
 
-			raw := tftypes.NewValue(
-				tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"team_yaml": tftypes.String,
-					},
-				},
-				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"team_yaml": tftypes.NewValue(tftypes.String, originalYaml),
-				},
-			)
+			raw := teamTestValue(map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"team_yaml":           tftypes.NewValue(tftypes.String, originalYaml),
+			})
 
 			state := tfsdk.State{Raw: raw, Schema: testSchema}
 			req := resource.ReadRequest{State: state}
@@ -251,30 +234,20 @@ spec:
   members:
     - alice@example.com`
 
-	testSchema := schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
-		},
-	}
+	testSchema := teamTestSchema()
 	testClient := &testTeamClient{getResponse: apiResponseYaml}
 	r := &TeamResource{client: testClient}
 
-	raw := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
-		},
-	)
+	raw := teamTestValue(map[string]tftypes.Value{
+		"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+		"server_version":      tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+		"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+		"id":                  tftypes.NewValue(tftypes.String, nil),
+		"team_yaml":           tftypes.NewValue(tftypes.String, stateYaml),
+	})
 
 	state := tfsdk.State{Raw: raw, Schema: testSchema}
 	req := resource.ReadRequest{State: state}
@@ -295,30 +268,20 @@ spec:
 // workspace), Read must clear state so the next plan re-creates the resource,
 // not surface a hard error that forces `terraform state rm`.
 func TestTeamResource_ReadNotFoundClearsState(t *testing.T) {
-	testSchema := schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
-		},
-	}
+	testSchema := teamTestSchema()
 	testClient := &testTeamClient{getError: &dash0.APIError{StatusCode: 404, Status: "404 Not Found"}}
 	r := &TeamResource{client: testClient}
 
-	raw := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"team_yaml": tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
-		},
-	)
+	raw := teamTestValue(map[string]tftypes.Value{
+		"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+		"server_version":      tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+		"origin":              tftypes.NewValue(tftypes.String, "tf_backend"),
+		"id":                  tftypes.NewValue(tftypes.String, nil),
+		"team_yaml":           tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
+	})
 
 	state := tfsdk.State{Raw: raw, Schema: testSchema}
 	req := resource.ReadRequest{State: state}
@@ -334,13 +297,7 @@ func TestTeamResource_ReadNotFoundClearsState(t *testing.T) {
 // does not swallow other transport errors (5xx, network failures, auth
 // errors). Only IsNotFound should route to RemoveResource.
 func TestTeamResource_ReadNonNotFoundStillErrors(t *testing.T) {
-	testSchema := schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
-		},
-	}
+	testSchema := teamTestSchema()
 	cases := []struct {
 		name string
 		err  error
@@ -354,20 +311,16 @@ func TestTeamResource_ReadNonNotFoundStillErrors(t *testing.T) {
 			testClient := &testTeamClient{getError: tc.err}
 			r := &TeamResource{client: testClient}
 
-			raw := tftypes.NewValue(
-				tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"team_yaml": tftypes.String,
-					},
-				},
-				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"team_yaml": tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
-				},
-			)
+			raw := teamTestValue(map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"origin":              tftypes.NewValue(tftypes.String, "tf_backend"),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"team_yaml":           tftypes.NewValue(tftypes.String, "kind: Dash0Team"),
+			})
 
 			state := tfsdk.State{Raw: raw, Schema: testSchema}
 			req := resource.ReadRequest{State: state}
@@ -405,33 +358,23 @@ spec:
     name: Backend Team
   members: []`
 
-	testSchema := schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
-		},
-	}
+	testSchema := teamTestSchema()
 	testClient := &testTeamClient{
 		getResponse: apiResponseYaml,
 		resolveID:   "00000000-0000-0000-0000-000000000001",
 	}
 	r := &TeamResource{client: testClient}
 
-	raw := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, nil), // stuck-null from a prior transient failure
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
-		},
-	)
+	raw := teamTestValue(map[string]tftypes.Value{
+		"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+		"server_version":      tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+		"origin":              tftypes.NewValue(tftypes.String, "tf_backend"),
+		"id":                  tftypes.NewValue(tftypes.String, nil), // stuck-null from a prior transient failure
+		"team_yaml":           tftypes.NewValue(tftypes.String, stateYaml),
+	})
 
 	state := tfsdk.State{Raw: raw, Schema: testSchema}
 	req := resource.ReadRequest{State: state}
@@ -466,30 +409,20 @@ metadata:
 spec:
   members: []`
 
-	testSchema := schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
-		},
-	}
+	testSchema := teamTestSchema()
 	testClient := &testTeamClient{getResponse: apiResponseYaml}
 	r := &TeamResource{client: testClient}
 
-	raw := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
-		},
-	)
+	raw := teamTestValue(map[string]tftypes.Value{
+		"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+		"server_version":      tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+		"origin":              tftypes.NewValue(tftypes.String, "tf_backend"),
+		"id":                  tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+		"team_yaml":           tftypes.NewValue(tftypes.String, stateYaml),
+	})
 
 	state := tfsdk.State{Raw: raw, Schema: testSchema}
 	req := resource.ReadRequest{State: state}
@@ -545,30 +478,20 @@ spec:
   members:
     - alice@example.com`
 
-	testSchema := schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
-		},
-	}
+	testSchema := teamTestSchema()
 	testClient := &testTeamClient{getResponse: apiResponseYaml}
 	r := &TeamResource{client: testClient}
 
-	raw := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, "tf_backend"),
-			"id":        tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
-			"team_yaml": tftypes.NewValue(tftypes.String, stateYaml),
-		},
-	)
+	raw := teamTestValue(map[string]tftypes.Value{
+		"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+		"server_version":      tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+		"origin":              tftypes.NewValue(tftypes.String, "tf_backend"),
+		"id":                  tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+		"team_yaml":           tftypes.NewValue(tftypes.String, stateYaml),
+	})
 
 	state := tfsdk.State{Raw: raw, Schema: testSchema}
 	req := resource.ReadRequest{State: state}
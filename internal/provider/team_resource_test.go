@@ -76,8 +76,12 @@ func TestTeamResource_Schema(t *testing.T) {
 	assert.False(t, idAttr.IsRequired())
 
 	yamlAttr := resp.Schema.Attributes["team_yaml"]
-	assert.True(t, yamlAttr.IsRequired())
+	assert.True(t, yamlAttr.IsOptional())
 	assert.False(t, yamlAttr.IsComputed())
+
+	for _, name := range []string{"name", "display_name", "color", "members"} {
+		assert.True(t, resp.Schema.Attributes[name].IsOptional(), name)
+	}
 }
 
 func TestTeamResource_Configure(t *testing.T) {
@@ -123,27 +127,17 @@ func TestTeamResource_Create_InvalidYAML(t *testing.T) {
 	req := resource.CreateRequest{}
 	resp := &resource.CreateResponse{}
 	req.Plan = tfsdk.Plan{
-		Raw: tftypes.NewValue(
-			tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"origin":    tftypes.String,
-					"id":        tftypes.String,
-					"team_yaml": tftypes.String,
-				},
-			},
-			map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, "tf_origin"),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"team_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
-			},
-		),
-		Schema: schema.Schema{
-			Attributes: map[string]schema.Attribute{
-				"origin":    schema.StringAttribute{Computed: true},
-				"id":        schema.StringAttribute{Computed: true},
-				"team_yaml": schema.StringAttribute{Required: true},
-			},
-		},
+		Raw: teamTestValue(map[string]tftypes.Value{
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"origin":              tftypes.NewValue(tftypes.String, "tf_origin"),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"team_yaml":           tftypes.NewValue(tftypes.String, "invalid: yaml: content: ["),
+		}),
+		Schema: teamTestSchema(),
 	}
 
 	r.Create(context.Background(), req, resp)
@@ -162,27 +156,17 @@ func TestTeamResource_ReadError(t *testing.T) {
 	resp := &resource.ReadResponse{}
 
 	req.State = tfsdk.State{
-		Raw: tftypes.NewValue(
-			tftypes.Object{
-				AttributeTypes: map[string]tftypes.Type{
-					"origin":    tftypes.String,
-					"id":        tftypes.String,
-					"team_yaml": tftypes.String,
-				},
-			},
-			map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, "tf_origin"),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"team_yaml": tftypes.NewValue(tftypes.String, "test-yaml"),
-			},
-		),
-		Schema: schema.Schema{
-			Attributes: map[string]schema.Attribute{
-				"origin":    schema.StringAttribute{Computed: true},
-				"id":        schema.StringAttribute{Computed: true},
-				"team_yaml": schema.StringAttribute{Required: true},
-			},
-		},
+		Raw: teamTestValue(map[string]tftypes.Value{
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"origin":              tftypes.NewValue(tftypes.String, "tf_origin"),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"team_yaml":           tftypes.NewValue(tftypes.String, "test-yaml"),
+		}),
+		Schema: teamTestSchema(),
 	}
 
 	r.Read(context.Background(), req, resp)
@@ -268,32 +252,39 @@ func teamTftypesValue(origin string, id *string, teamYaml string) tftypes.Value
 	} else {
 		idValue = tftypes.NewValue(tftypes.String, *id)
 	}
-	return tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, origin),
-			"id":        idValue,
-			"team_yaml": tftypes.NewValue(tftypes.String, teamYaml),
-		},
-	)
-}
-
-// teamTestSchema returns the minimal in-test schema shared by Delete/Update
-// fixtures. Kept as a helper so any future attribute rename lands in one place.
+	return teamTestValue(map[string]tftypes.Value{
+		"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+		"server_version":      tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+		"origin":              tftypes.NewValue(tftypes.String, origin),
+		"id":                  idValue,
+		"team_yaml":           tftypes.NewValue(tftypes.String, teamYaml),
+	})
+}
+
+// teamTestSchema returns the schema of the team resource, shared by the
+// Delete/Update fixtures.
 func teamTestSchema() schema.Schema {
-	return schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"origin":    schema.StringAttribute{Computed: true},
-			"id":        schema.StringAttribute{Computed: true},
-			"team_yaml": schema.StringAttribute{Required: true},
-		},
+	resp := &resource.SchemaResponse{}
+	(&TeamResource{}).Schema(context.Background(), resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// teamTestValue builds a team object value from the given attributes, setting
+// all others to null.
+func teamTestValue(values map[string]tftypes.Value) tftypes.Value {
+	objectType := teamTestSchema().Type().TerraformType(context.Background()).(tftypes.Object)
+	all := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			all[name] = v
+		} else {
+			all[name] = tftypes.NewValue(attrType, nil)
+		}
 	}
+	return tftypes.NewValue(objectType, all)
 }
 
 // teamDeleteState builds the minimal state fixture used by the Delete tests.
@@ -409,6 +400,7 @@ spec:
 	// The Update path converts YAML to JSON before calling the client, so we
 	// match on any string argument for the JSON body.
 	mockClient.On("UpdateTeam", mock.Anything, "tf_backend", mock.AnythingOfType("string")).Return(nil)
+	mockClient.On("GetTeam", mock.Anything, mock.Anything).Return("", nil)
 
 	stateID := "00000000-0000-0000-0000-000000000001"
 	req := teamUpdateRequest("tf_backend", &stateID, stateYaml, "tf_backend", &stateID, planYaml)
@@ -451,6 +443,7 @@ spec:
 			seenOrigin = args.String(1)
 		}).
 		Return(nil)
+	mockClient.On("GetTeam", mock.Anything, mock.Anything).Return("", nil)
 
 	stateID := "00000000-0000-0000-0000-000000000001"
 	rogueID := "99999999-9999-9999-9999-999999999999"
@@ -542,20 +535,16 @@ spec:
 // tftypes.Object whose attributes are all null. ImportState then fills them
 // in via SetAttribute.
 func teamImportStateResponse() *resource.ImportStateResponse {
-	nullRaw := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"origin":    tftypes.String,
-				"id":        tftypes.String,
-				"team_yaml": tftypes.String,
-			},
-		},
-		map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, nil),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"team_yaml": tftypes.NewValue(tftypes.String, nil),
-		},
-	)
+	nullRaw := teamTestValue(map[string]tftypes.Value{
+		"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+		"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+		"server_version":      tftypes.NewValue(tftypes.String, nil),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+		"origin":              tftypes.NewValue(tftypes.String, nil),
+		"id":                  tftypes.NewValue(tftypes.String, nil),
+		"team_yaml":           tftypes.NewValue(tftypes.String, nil),
+	})
 	return &resource.ImportStateResponse{
 		State: tfsdk.State{Raw: nullRaw, Schema: teamTestSchema()},
 	}
@@ -926,20 +915,16 @@ func TestTeamResource_ValidateConfig_UnknownOrNullSkips(t *testing.T) {
 	r := &TeamResource{}
 	req := resource.ValidateConfigRequest{
 		Config: tfsdk.Config{
-			Raw: tftypes.NewValue(
-				tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"team_yaml": tftypes.String,
-					},
-				},
-				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, nil),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"team_yaml": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-				},
-			),
+			Raw: teamTestValue(map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"origin":              tftypes.NewValue(tftypes.String, nil),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"team_yaml":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
 			Schema: teamTestSchema(),
 		},
 	}
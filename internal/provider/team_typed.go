@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+// teamColorType is the type of the `color` attribute: the two ends of the
+// gradient the team is displayed with.
+var teamColorType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"from": types.StringType,
+	"to":   types.StringType,
+}}
+
+// usesTypedAttributes reports whether the team is configured through the typed
+// attributes rather than team_yaml.
+func (m *teamModel) usesTypedAttributes() bool {
+	return !m.Name.IsNull() || !m.DisplayName.IsNull() || !m.Color.IsNull() || !m.Members.IsNull()
+}
+
+// validateTeamTyped checks that the team is configured either through team_yaml
+// or through the typed attributes, and that the typed attributes the Dash0Team
+// document requires are set.
+func validateTeamTyped(m *teamModel, diags *diag.Diagnostics) {
+	typed := map[string]bool{
+		"name":         !m.Name.IsNull(),
+		"display_name": !m.DisplayName.IsNull(),
+		"color":        !m.Color.IsNull(),
+		"members":      !m.Members.IsNull(),
+	}
+
+	if !m.TeamYaml.IsNull() {
+		for _, name := range []string{"name", "display_name", "color", "members"} {
+			if typed[name] {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Conflicting team configuration",
+					fmt.Sprintf("%s cannot be combined with team_yaml. Configure the team either in YAML or through the typed attributes.", name),
+				)
+			}
+		}
+		return
+	}
+
+	if !m.usesTypedAttributes() {
+		diags.AddError(
+			"Missing team configuration",
+			"Set team_yaml, or set name, display_name and color.",
+		)
+		return
+	}
+	for _, name := range []string{"name", "display_name", "color"} {
+		if !typed[name] {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing team attribute",
+				fmt.Sprintf("%s is required when the team is configured through the typed attributes.", name),
+			)
+		}
+	}
+}
+
+// teamTypedDocument renders the typed attributes as a Dash0Team document in
+// JSON, which is also valid YAML and is handled exactly like team_yaml from
+// then on. Members are sorted so the document does not depend on set order.
+func teamTypedDocument(ctx context.Context, m *teamModel, diags *diag.Diagnostics) string {
+	display := map[string]interface{}{"name": m.DisplayName.ValueString()}
+	if !m.Color.IsNull() && !m.Color.IsUnknown() {
+		attributes := m.Color.Attributes()
+		display["color"] = map[string]interface{}{
+			"from": attributes["from"].(types.String).ValueString(),
+			"to":   attributes["to"].(types.String).ValueString(),
+		}
+	}
+	spec := map[string]interface{}{"display": display}
+	if !m.Members.IsNull() && !m.Members.IsUnknown() {
+		var members []string
+		diags.Append(m.Members.ElementsAs(ctx, &members, false)...)
+		slices.Sort(members)
+		spec["members"] = members
+	}
+
+	document := map[string]interface{}{
+		"apiVersion": "dash0.com/v1alpha1",
+		"kind":       "Dash0Team",
+		"metadata":   map[string]interface{}{"name": m.Name.ValueString()},
+		"spec":       spec,
+	}
+	jsonBytes, err := json.Marshal(document)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unable to render team: %s", err))
+		return ""
+	}
+	return string(jsonBytes)
+}
+
+// teamDocument is the subset of a Dash0Team document managed by the typed
+// attributes.
+type teamDocument struct {
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Display struct {
+			Name  string `yaml:"name"`
+			Color struct {
+				From string `yaml:"from"`
+				To   string `yaml:"to"`
+			} `yaml:"color"`
+		} `yaml:"display"`
+		Members []string `yaml:"members"`
+	} `yaml:"spec"`
+}
+
+// applyTeamDocument sets the typed attributes of the model from a team
+// document returned by the API, whose members are email addresses. Members
+// are stored as those emails; the semantic equality of teamMembersValue keeps
+// members the configuration references by id from surfacing as changes.
+// members stays null when the model does not manage it, so that membership
+// maintained through dash0_team_membership is not reported as drift.
+func applyTeamDocument(m *teamModel, document string) error {
+	var parsed teamDocument
+	if err := yaml.Unmarshal([]byte(document), &parsed); err != nil {
+		return fmt.Errorf("error parsing team: %w", err)
+	}
+
+	m.Name = types.StringValue(parsed.Metadata.Name)
+	m.DisplayName = types.StringValue(parsed.Spec.Display.Name)
+	m.Color = types.ObjectValueMust(teamColorType.AttrTypes, map[string]attr.Value{
+		"from": types.StringValue(parsed.Spec.Display.Color.From),
+		"to":   types.StringValue(parsed.Spec.Display.Color.To),
+	})
+
+	if m.Members.IsNull() {
+		return nil
+	}
+	m.Members = teamMembersValueFrom(parsed.Spec.Members)
+	return nil
+}
+
+// sameTeamMembers reports whether two member lists refer to the same
+// organization members, regardless of order, letter case in emails, or
+// whether a member is referenced by id or by email. Ids are matched through
+// orgMembers and the members recorded by rememberTeamMembers.
+func sameTeamMembers(a, b []string, orgMembers []client.Member) bool {
+	normalize := func(members []string) []string {
+		normalized := make([]string, len(members))
+		for i, member := range members {
+			normalized[i] = strings.ToLower(member)
+			if email, ok := rememberedTeamMemberEmail(member); ok {
+				normalized[i] = email
+			}
+			for _, o := range orgMembers {
+				if o.ID != "" && o.ID == member && o.Email != "" {
+					normalized[i] = strings.ToLower(o.Email)
+					break
+				}
+			}
+		}
+		slices.Sort(normalized)
+		return slices.Compact(normalized)
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
+
+// unknownTeamMembers returns the member references that match neither the
+// email address (case-insensitively) nor the id of an organization member,
+// sorted.
+func unknownTeamMembers(members []string, orgMembers []client.Member) []string {
+	var unknown []string
+	for _, member := range members {
+		known := slices.ContainsFunc(orgMembers, func(o client.Member) bool {
+			return (o.Email != "" && strings.EqualFold(o.Email, member)) || (o.ID != "" && o.ID == member)
+		})
+		if !known {
+			unknown = append(unknown, member)
+		}
+	}
+	slices.Sort(unknown)
+	return unknown
+}
+
+// teamYAMLMembers returns spec.members of a team_yaml document, or nil when it
+// cannot be parsed.
+func teamYAMLMembers(teamYaml string) []string {
+	var parsed teamDocument
+	if err := yaml.Unmarshal([]byte(teamYaml), &parsed); err != nil {
+		return nil
+	}
+	return parsed.Spec.Members
+}
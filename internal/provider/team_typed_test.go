@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
+)
+
+var typedTestOrgMembers = []client.Member{
+	{ID: "user_alice", Email: "alice@example.com"},
+	{ID: "user_bob", Email: "Bob@example.com"},
+	{ID: "user_pending"},
+}
+
+// typedTestTeamModel returns a team model configured through the typed
+// attributes.
+func typedTestTeamModel(members ...string) teamModel {
+	return teamModel{
+		Origin:      types.StringNull(),
+		ID:          types.StringNull(),
+		TeamYaml:    types.StringNull(),
+		Name:        types.StringValue("backend-team"),
+		DisplayName: types.StringValue("Backend Team"),
+		Color: types.ObjectValueMust(teamColorType.AttrTypes, map[string]attr.Value{
+			"from": types.StringValue("#6366F1"),
+			"to":   types.StringValue("#8B5CF6"),
+		}),
+		Members: teamMembersValueFrom(members),
+	}
+}
+
+func typedTestTeamValues() map[string]tftypes.Value {
+	colorType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"from": tftypes.String, "to": tftypes.String}}
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	return map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "backend-team"),
+		"display_name": tftypes.NewValue(tftypes.String, "Backend Team"),
+		"color": tftypes.NewValue(colorType, map[string]tftypes.Value{
+			"from": tftypes.NewValue(tftypes.String, "#6366F1"),
+			"to":   tftypes.NewValue(tftypes.String, "#8B5CF6"),
+		}),
+		"members": tftypes.NewValue(stringSet, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "bob@example.com"),
+			tftypes.NewValue(tftypes.String, "user_alice"),
+		}),
+	}
+}
+
+const typedTestTeamDocument = `{
+  "apiVersion": "dash0.com/v1alpha1",
+  "kind": "Dash0Team",
+  "metadata": {"name": "backend-team"},
+  "spec": {
+    "display": {"name": "Backend Team", "color": {"from": "#6366F1", "to": "#8B5CF6"}},
+    "members": ["bob@example.com", "user_alice"]
+  }
+}`
+
+func TestTeamTypedDocument(t *testing.T) {
+	m := typedTestTeamModel("user_alice", "bob@example.com")
+
+	var diags diag.Diagnostics
+	document := teamTypedDocument(context.Background(), &m, &diags)
+
+	require.False(t, diags.HasError(), "diagnostics: %v", diags)
+	assert.JSONEq(t, typedTestTeamDocument, document)
+}
+
+func TestValidateTeamTyped(t *testing.T) {
+	cases := []struct {
+		name          string
+		modify        func(*teamModel)
+		expectSummary string
+		expectPath    path.Path
+	}{
+		{name: "typed only", modify: func(m *teamModel) {}},
+		{
+			name: "yaml only",
+			modify: func(m *teamModel) {
+				*m = teamModel{TeamYaml: types.StringValue("kind: Dash0Team"), Color: types.ObjectNull(teamColorType.AttrTypes), Members: teamMembersNull()}
+			},
+		},
+		{
+			name: "neither",
+			modify: func(m *teamModel) {
+				*m = teamModel{Color: types.ObjectNull(teamColorType.AttrTypes), Members: teamMembersNull()}
+			},
+			expectSummary: "Missing team configuration",
+		},
+		{
+			name: "yaml and members",
+			modify: func(m *teamModel) {
+				*m = teamModel{TeamYaml: types.StringValue("kind: Dash0Team"), Color: types.ObjectNull(teamColorType.AttrTypes), Members: m.Members}
+			},
+			expectSummary: "Conflicting team configuration",
+			expectPath:    path.Root("members"),
+		},
+		{
+			name:          "missing color",
+			modify:        func(m *teamModel) { m.Color = types.ObjectNull(teamColorType.AttrTypes) },
+			expectSummary: "Missing team attribute",
+			expectPath:    path.Root("color"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := typedTestTeamModel("alice@example.com")
+			tc.modify(&m)
+
+			var diags diag.Diagnostics
+			validateTeamTyped(&m, &diags)
+
+			if tc.expectSummary == "" {
+				assert.False(t, diags.HasError(), "diagnostics: %v", diags)
+				return
+			}
+			require.Equal(t, 1, diags.ErrorsCount(), "diagnostics: %v", diags)
+			assert.Equal(t, tc.expectSummary, diags.Errors()[0].Summary())
+			if len(tc.expectPath.Steps()) == 0 {
+				return
+			}
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectPath, withPath.Path())
+		})
+	}
+}
+
+func TestApplyTeamDocument(t *testing.T) {
+	apiResponse := `{"kind": "Dash0Team", "metadata": {"name": "backend-team"}, "spec": {"display": {"name": "Backend", "color": {"from": "#111111", "to": "#222222"}}, "members": ["alice@example.com", "bob@example.com"]}}`
+
+	t.Run("members are stored as emails", func(t *testing.T) {
+		m := typedTestTeamModel("user_alice", "BOB@example.com")
+
+		require.NoError(t, applyTeamDocument(&m, apiResponse))
+
+		assert.Equal(t, typedTestTeamModel("alice@example.com", "bob@example.com").Members, m.Members)
+		assert.Equal(t, "Backend", m.DisplayName.ValueString())
+		assert.Equal(t, types.StringValue("#111111"), m.Color.Attributes()["from"])
+	})
+
+	t.Run("unmanaged empty members stay null", func(t *testing.T) {
+		m := typedTestTeamModel()
+		m.Members = teamMembersNull()
+
+		require.NoError(t, applyTeamDocument(&m, `{"metadata": {"name": "backend-team"}, "spec": {"display": {"name": "Backend"}}}`))
+
+		assert.True(t, m.Members.IsNull())
+	})
+
+	t.Run("unmanaged members stay null", func(t *testing.T) {
+		m := typedTestTeamModel()
+		m.Members = teamMembersNull()
+
+		require.NoError(t, applyTeamDocument(&m, apiResponse))
+
+		assert.True(t, m.Members.IsNull(), "members maintained through dash0_team_membership must not surface as drift")
+	})
+}
+
+func TestUnknownTeamMembers(t *testing.T) {
+	unknown := unknownTeamMembers([]string{"ALICE@example.com", "user_bob", "carol@example.com", "user_nobody"}, typedTestOrgMembers)
+	assert.Equal(t, []string{"carol@example.com", "user_nobody"}, unknown)
+}
+
+func TestTeamResource_ModifyPlan_UnknownMembers(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListMembers", mock.Anything).Return(typedTestOrgMembers, nil)
+	r := &TeamResource{client: mockClient}
+
+	values := typedTestTeamValues()
+	values["members"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "alice@example.com"),
+		tftypes.NewValue(tftypes.String, "carol@example.com"),
+	})
+	plan := tfsdk.Plan{Raw: teamTestValue(values), Schema: teamTestSchema()}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan}, resp)
+
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, "Unknown team members", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), ": carol@example.com.")
+	withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.Equal(t, path.Root("members"), withPath.Path())
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamResource_ModifyPlan_ListMembersErrorWarns(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("ListMembers", mock.Anything).Return(nil, errors.New("upstream 500"))
+	r := &TeamResource{client: mockClient}
+
+	plan := tfsdk.Plan{Raw: teamTestValue(typedTestTeamValues()), Schema: teamTestSchema()}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	require.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Equal(t, "Unable to verify team members", resp.Diagnostics.Warnings()[0].Summary())
+}
+
+func TestTeamResource_Create_Typed(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("CreateTeam", mock.Anything, mock.AnythingOfType("string"), mock.MatchedBy(func(body string) bool {
+		return assert.JSONEq(t, typedTestTeamDocument, body)
+	})).Return(nil)
+	mockClient.On("ResolveTeam", mock.Anything, mock.AnythingOfType("string")).Return("00000000-0000-0000-0000-000000000001", nil)
+	mockClient.On("GetTeam", mock.Anything, mock.AnythingOfType("string")).Return("", nil)
+	r := &TeamResource{client: mockClient}
+
+	plan := tfsdk.Plan{Raw: teamTestValue(typedTestTeamValues()), Schema: teamTestSchema()}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: teamTestSchema()}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state teamModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.True(t, state.TeamYaml.IsNull())
+	assert.Len(t, state.Members.Elements(), 2)
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamResource_Read_TypedMembersByID(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("GetTeam", mock.Anything, "tf_backend").Return(`{"apiVersion": "dash0.com/v1alpha1", "kind": "Dash0Team", "metadata": {"name": "backend-team"}, "spec": {"display": {"name": "Backend Team", "color": {"from": "#6366F1", "to": "#8B5CF6"}}, "members": ["alice@example.com", "bob@example.com"]}}`, nil)
+	// Members may already be remembered from an earlier test.
+	mockClient.On("ListMembers", mock.Anything).Return(typedTestOrgMembers, nil).Maybe()
+	r := &TeamResource{client: mockClient}
+
+	values := typedTestTeamValues()
+	values["origin"] = tftypes.NewValue(tftypes.String, "tf_backend")
+	values["id"] = tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001")
+	state := tfsdk.State{Raw: teamTestValue(values), Schema: teamTestSchema()}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var prior, refreshed teamModel
+	require.False(t, state.Get(context.Background(), &prior).HasError())
+	require.False(t, resp.State.Get(context.Background(), &refreshed).HasError())
+	assert.Equal(t, typedTestTeamModel("alice@example.com", "bob@example.com").Members, refreshed.Members)
+	equal, diags := refreshed.Members.SetSemanticEquals(context.Background(), prior.Members)
+	require.False(t, diags.HasError())
+	assert.True(t, equal, "members referenced by id must not surface as drift")
+	mockClient.AssertExpectations(t)
+}
+
+func TestTeamMembersValue_SetSemanticEquals(t *testing.T) {
+	rememberTeamMembers(typedTestOrgMembers)
+	emails := teamMembersValueFrom([]string{"alice@example.com", "bob@example.com"})
+
+	for name, tc := range map[string]struct {
+		prior teamMembersValue
+		equal bool
+	}{
+		"same emails":             {teamMembersValueFrom([]string{"bob@example.com", "alice@example.com"}), true},
+		"ids and other case":      {teamMembersValueFrom([]string{"user_alice", "BOB@example.com"}), true},
+		"missing member":          {teamMembersValueFrom([]string{"user_alice"}), false},
+		"unknown id":              {teamMembersValueFrom([]string{"user_alice", "user_carol"}), false},
+		"null prior is not equal": {teamMembersNull(), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := emails.SetSemanticEquals(context.Background(), tc.prior)
+			require.False(t, diags.HasError())
+			assert.Equal(t, tc.equal, equal)
+		})
+	}
+}
+
+func TestTeamResource_Update_TypedKeepsUnmanagedMembers(t *testing.T) {
+	mockClient := &MockClient{}
+	mockClient.On("GetTeam", mock.Anything, "tf_backend").Return("", nil)
+	mockClient.On("UpdateTeamKeepingMembers", mock.Anything, "tf_backend", mock.MatchedBy(func(body string) bool {
+		return assert.NotContains(t, body, `"members"`)
+	})).Return(nil)
+	r := &TeamResource{client: mockClient}
+
+	values := typedTestTeamValues()
+	values["origin"] = tftypes.NewValue(tftypes.String, "tf_backend")
+	values["members"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+	state := tfsdk.State{Raw: teamTestValue(values), Schema: teamTestSchema()}
+	plan := tfsdk.Plan{Raw: state.Raw, Schema: teamTestSchema()}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{State: state, Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var updated teamModel
+	resp.State.Get(context.Background(), &updated)
+	assert.True(t, updated.Members.IsNull(), "carried-over members must not be written to state")
+	mockClient.AssertExpectations(t)
+}
@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"maps"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,7 +11,6 @@ import (
 
 	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
 	"github.com/dash0hq/terraform-provider-dash0/internal/provider/client"
-	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,9 +23,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &ViewResource{}
-	_ resource.ResourceWithConfigure   = &ViewResource{}
-	_ resource.ResourceWithImportState = &ViewResource{}
+	_ resource.Resource                   = &ViewResource{}
+	_ resource.ResourceWithConfigure      = &ViewResource{}
+	_ resource.ResourceWithImportState    = &ViewResource{}
+	_ resource.ResourceWithIdentity       = &ViewResource{}
+	_ resource.ResourceWithValidateConfig = &ViewResource{}
+	_ resource.ResourceWithModifyPlan     = &ViewResource{}
 )
 
 // NewViewResource is a helper function to simplify the provider implementation.
@@ -40,15 +42,37 @@ type ViewResource struct {
 	// defaultDataset is the provider-level default dataset, inherited by this
 	// resource's `dataset` attribute when it is omitted from configuration.
 	defaultDataset string
+	// policy is the provider-level policy, enforced in ModifyPlan.
+	policy policy
+	// conflictDetection is the provider's `conflict_detection` setting,
+	// applied in Update.
+	conflictDetection conflictDetection
+	// deletionProtection is the provider-level `deletion_protection` default,
+	// inherited by this resource's `deletion_protection` attribute in
+	// ModifyPlan when it is omitted from configuration.
+	deletionProtection bool
+	// adoptExisting is the provider-level `adopt_existing` default, applied in
+	// Create when this resource's `adopt_existing` attribute is omitted.
+	adoptExisting bool
 }
 
 // viewModel is the Terraform state model for a view resource.
 type viewModel struct {
-	Origin   types.String `tfsdk:"origin"`
-	ID       types.String `tfsdk:"id"`
-	Dataset  types.String `tfsdk:"dataset"`
-	ViewYaml types.String `tfsdk:"view_yaml"`
-	URL      types.String `tfsdk:"url"`
+	Origin             types.String `tfsdk:"origin"`
+	ID                 types.String `tfsdk:"id"`
+	Dataset            types.String `tfsdk:"dataset"`
+	ViewYaml           types.String `tfsdk:"view_yaml"`
+	URL                types.String `tfsdk:"url"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	AllowDatasetMove   types.Bool   `tfsdk:"allow_dataset_move"`
+	Datasets           types.Set    `tfsdk:"datasets"`
+	DatasetIDs         types.Map    `tfsdk:"dataset_ids"`
+	DatasetURLs        types.Map    `tfsdk:"dataset_urls"`
+
+	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	LastModifiedBy types.String `tfsdk:"last_modified_by"`
+	ServerVersion  types.String `tfsdk:"server_version"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -68,10 +92,28 @@ func (r *ViewResource) Configure(_ context.Context, req resource.ConfigureReques
 
 	r.client = data.client
 	r.defaultDataset = data.defaultDataset
+	r.policy = data.policy
+	r.conflictDetection = data.conflictDetection
+	r.deletionProtection = data.deletionProtection
+	r.adoptExisting = data.adoptExisting
 }
 
 func (r *ViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_view"
+	// Moving the resource to another dataset (allow_dataset_move) changes the
+	// dataset in its identity.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema defines the resource identity, which list results and
+// identity-based import blocks address the view by.
+func (r *ViewResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = datasetAssetIdentitySchema("view")
+}
+
+// ValidateConfig checks that at most one of dataset and datasets is set.
+func (r *ViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateDatasetReplicas(ctx, req.Config, &resp.Diagnostics)
 }
 
 func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -93,19 +135,19 @@ func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"dataset": schema.StringAttribute{
-				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the view belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated.",
+				Description: "The identifier of the [Dash0 dataset](https://dash0.com/docs/dash0/miscellaneous/glossary/datasets) that the view belongs to. Provide the dataset's identifier, which is immutable, not the 'name'. Datasets are used to separate observability data within a Dash0 organization. If omitted, the provider-level `dataset` default is used (see the provider's `dataset` attribute). Changing this value forces the resource to be recreated, unless `allow_dataset_move` is set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					datasetRequiresReplace(),
 				},
 			},
 			"view_yaml": schema.StringAttribute{
-				Description: "The view definition in YAML format, specifying the filters, queries, and display settings for the view. The following `metadata.annotations` are supported: `dash0.com/sharing` (sharing settings) and `dash0.com/folder-path` (folder location). Changes to these annotations trigger a resource update; all other metadata annotations are managed by the server and ignored during drift detection.",
+				Description: "The view definition in YAML format, specifying the filters, queries, and display settings for the view. The following `metadata.annotations` are supported: `dash0.com/sharing` (sharing settings) and `dash0.com/folder-path` (folder location). Changes to these annotations trigger a resource update; all other metadata annotations are managed by the server and ignored during drift detection. A `Dash0View` custom resource (`apiVersion: operator.dash0.com/v1alpha1`) as managed by the Dash0 Operator for Kubernetes is accepted as-is: the provider unwraps it the same way the Operator does, keeping `metadata.name` and the `dash0.com/` labels and annotations and defaulting `spec.display.name` to `metadata.name`.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					customplanmodifier.YAMLSemanticEqual(converter.AnnotationSharing, converter.AnnotationFolderPath),
+					yamlKinds["view"].planModifier(),
 				},
 			},
 			"url": schema.StringAttribute{
@@ -117,6 +159,23 @@ func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, lastModifiedAttributes("view"))
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("view")
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("view", "display name (`spec.display.name`)", "in its dataset")
+	resp.Schema.Attributes["allow_dataset_move"] = allowDatasetMoveAttribute("view")
+	resp.Schema.Attributes["datasets"] = datasetsAttribute("view")
+	resp.Schema.Attributes["dataset_ids"] = datasetIDsAttribute("view")
+	resp.Schema.Attributes["dataset_urls"] = datasetURLsAttribute("view")
+}
+
+// ModifyPlan plans the provider-level deletion protection default, dataset
+// moves and replicas, and enforces the provider-level policy on the planned
+// view.
+func (r *ViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.deletionProtection, req, resp)
+	planDatasetMove(ctx, req, resp, "id", "url")
+	planDatasetReplicas(ctx, req, resp, "id", "url")
+	r.policy.enforcePlan(ctx, req.Plan, "dash0_view", path.Root("view_yaml"), &resp.Diagnostics)
 }
 
 // resolveView populates the view's server-assigned id and web app URL on the
@@ -138,6 +197,28 @@ func (r *ViewResource) resolveView(ctx context.Context, model *viewModel, diags
 	model.URL = stringOrNull(viewURL)
 }
 
+// replicas returns the replicas of a view with `datasets`.
+func (r *ViewResource) replicas() datasetReplicas {
+	return datasetReplicas{
+		noun:    "view",
+		create:  r.client.CreateView,
+		update:  r.client.UpdateView,
+		remove:  r.client.DeleteView,
+		get:     r.client.GetView,
+		resolve: r.client.ResolveView,
+	}
+}
+
+// resolveLastModified populates the view's last-modified attributes after a
+// create or update (best-effort), and records the revision the write produced
+// for conflict detection.
+func (r *ViewResource) resolveLastModified(ctx context.Context, model *viewModel, private privateStateSetter, diags *diag.Diagnostics) {
+	document := readLastModified(ctx, "view", func(ctx context.Context) (string, error) {
+		return r.client.GetView(ctx, model.Origin.ValueString(), model.Dataset.ValueString())
+	}, &model.LastModifiedAt, &model.LastModifiedBy, &model.ServerVersion, diags)
+	r.conflictDetection.record(ctx, private, document, diags)
+}
+
 func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model viewModel
 	diags := req.Plan.Get(ctx, &model)
@@ -147,7 +228,7 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	model.Origin = types.StringValue("tf_" + uuid.New().String())
-	if model.Dataset.IsNull() || model.Dataset.IsUnknown() {
+	if model.Datasets.IsNull() && (model.Dataset.IsNull() || model.Dataset.IsUnknown()) {
 		model.Dataset = types.StringValue(r.defaultDataset)
 	}
 
@@ -162,14 +243,41 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(model.ViewYaml.ValueString())
+	// Unwrap an Operator custom resource envelope, if any, and convert YAML
+	// to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(converter.UnwrapOperatorView(model.ViewYaml.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert view YAML to JSON: %s", err))
 		return
 	}
 
-	err = r.client.CreateView(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
+	// Write a replica of the view to each of its datasets instead, if
+	// datasets is set.
+	if !model.Datasets.IsNull() {
+		model.Datasets, model.DatasetIDs, model.DatasetURLs = r.replicas().apply(ctx, model.Origin.ValueString(), jsonBody, types.SetNull(types.StringType), model.Datasets, model.DatasetIDs, model.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "created a replicated view resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Take over an existing view with the same name instead of creating a second
+	// one, if adopt_existing is set.
+	createView := r.client.CreateView
+	if adoptionEnabled(model.AdoptExisting, r.adoptExisting) {
+		origin, found := adoptionTarget(ctx, "view", fmt.Sprintf("in dataset %q", model.Dataset.ValueString()), jsonBody, func(ctx context.Context) ([]client.Asset, error) {
+			return r.client.ListViews(ctx, model.Dataset.ValueString())
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			model.Origin = types.StringValue(origin)
+			createView = r.client.UpdateView
+		}
+	}
+
+	err = createView(ctx, model.Origin.ValueString(), jsonBody, model.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create view, got error: %s", err))
 		return
@@ -177,12 +285,14 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Resolve the id and web app URL for the newly created view (best-effort).
 	r.resolveView(ctx, &model, &resp.Diagnostics)
+	r.resolveLastModified(ctx, &model, resp.Private, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created a view resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, model.Dataset, model.Origin, &resp.Diagnostics)
 }
 
 func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -194,6 +304,14 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Read each replica of a view with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.readReplicas(ctx, &state, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+		return
+	}
+
 	apiResponseJSON, err := r.client.GetView(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read view, got error: %s", err))
@@ -201,31 +319,59 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	tflog.Trace(ctx, "read a view resource")
+	r.conflictDetection.record(ctx, resp.Private, apiResponseJSON, &resp.Diagnostics)
+
+	r.refreshView(ctx, &state, apiResponseJSON, &resp.Diagnostics)
+
+	state.LastModifiedAt, state.LastModifiedBy, state.ServerVersion = lastModifiedValues(apiResponseJSON)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, state.Dataset, state.Origin, &resp.Diagnostics)
+}
 
+// refreshView compares the view in state with document, the
+// view as returned by the API, and replaces view_yaml with it only
+// if they differ beyond metadata the server manages.
+func (r *ViewResource) refreshView(ctx context.Context, state *viewModel, document string, diags *diag.Diagnostics) {
 	// Compare the current state with the retrieved view
 	if state.ViewYaml.ValueString() != "" {
-		stateYAML := state.ViewYaml.ValueString()
+		stateYAML := converter.UnwrapOperatorView(state.ViewYaml.ValueString())
 		additionalIgnored := converter.FieldsAbsentFromYAML(stateYAML, converter.ConditionallyIgnoredFields)
-		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, apiResponseJSON, additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
+		equivalent, err := converter.ResourceYAMLEquivalent(stateYAML, document, additionalIgnored, []string{converter.AnnotationSharing, converter.AnnotationFolderPath})
 		if err != nil {
-			resp.Diagnostics.AddWarning(
+			diags.AddWarning(
 				"View Comparison Error",
 				fmt.Sprintf("Error comparing views: %s. Using API response as source of truth.", err),
 			)
-			state.ViewYaml = types.StringValue(apiResponseJSON)
+			state.ViewYaml = types.StringValue(document)
 		} else if !equivalent {
 			tflog.Debug(ctx, "View has changed, updating state")
-			state.ViewYaml = types.StringValue(apiResponseJSON)
+			warnChangedOutsideTerraform("view", document, diags)
+			state.ViewYaml = types.StringValue(document)
 		} else {
 			tflog.Debug(ctx, "View is equivalent, ignoring changes in metadata fields")
 		}
 	} else {
-		state.ViewYaml = types.StringValue(apiResponseJSON)
+		state.ViewYaml = types.StringValue(document)
 	}
+}
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+// readReplicas refreshes a view with datasets from its replicas. The
+// first replica that differs from the configured view replaces
+// view_yaml, so that the next apply rewrites all of them.
+func (r *ViewResource) readReplicas(ctx context.Context, state *viewModel, diags *diag.Diagnostics) {
+	configured := *state
+	drifted := false
+	state.Datasets, state.DatasetIDs, state.DatasetURLs = r.replicas().read(ctx, state.Origin.ValueString(), state.Datasets, state.DatasetIDs, state.DatasetURLs, func(document string, diags *diag.Diagnostics) {
+		replica := configured
+		r.refreshView(ctx, &replica, document, diags)
+		if !drifted && !replica.ViewYaml.Equal(configured.ViewYaml) {
+			state.ViewYaml, drifted = replica.ViewYaml, true
+		}
+	}, diags)
+	tflog.Trace(ctx, "read a replicated view resource")
 }
 
 func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -256,30 +402,64 @@ func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Convert YAML to JSON for the API
-	jsonBody, err := converter.ConvertYAMLToJSON(plan.ViewYaml.ValueString())
+	// Unwrap an Operator custom resource envelope, if any, and convert YAML
+	// to JSON for the API
+	jsonBody, err := converter.ConvertYAMLToJSON(converter.UnwrapOperatorView(plan.ViewYaml.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert view YAML to JSON: %s", err))
 		return
 	}
 
-	// Update the existing view (dataset changes force recreation via RequiresReplace)
+	// Update the replicas of a view with datasets instead, creating and
+	// deleting those of added and removed datasets.
+	if !plan.Datasets.IsNull() {
+		plan.Origin = state.Origin
+		plan.Datasets, plan.DatasetIDs, plan.DatasetURLs = r.replicas().apply(ctx, plan.Origin.ValueString(), jsonBody, state.Datasets, plan.Datasets, state.DatasetIDs, state.DatasetURLs, &resp.Diagnostics)
+		tflog.Trace(ctx, "updated a replicated view resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
+		return
+	}
+
+	// Update the existing view. A dataset change forces recreation via
+	// RequiresReplace, unless allow_dataset_move is set; then it is moved below.
 	plan.Origin = state.Origin
 	// The view's identifier is immutable, so neither the id nor the URL change
 	// on update; carry them from state instead of re-resolving them via the API.
 	plan.ID = state.ID
 	plan.URL = state.URL
-	err = r.client.UpdateView(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update view, got error: %s", err))
+
+	// Refuse to overwrite a change made since Terraform last read the asset.
+	r.conflictDetection.check(ctx, "view", req.Private, func(ctx context.Context) (string, error) {
+		return r.client.GetView(ctx, plan.Origin.ValueString(), state.Dataset.ValueString())
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.Dataset.Equal(state.Dataset) {
+		err = r.client.UpdateView(ctx, plan.Origin.ValueString(), jsonBody, plan.Dataset.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update view, got error: %s", err))
+			return
+		}
+	} else {
+		if !moveToDataset(ctx, "view", plan.Origin.ValueString(), jsonBody, state.Dataset.ValueString(), plan.Dataset.ValueString(), r.client.CreateView, r.client.DeleteView, &resp.Diagnostics) {
+			return
+		}
+
+		// The moved view has a new id and URL.
+		r.resolveView(ctx, &plan, &resp.Diagnostics)
+	}
+
+	r.resolveLastModified(ctx, &plan, resp.Private, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated a view resource")
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setDatasetAssetIdentity(ctx, resp.Identity, plan.Dataset, plan.Origin, &resp.Diagnostics)
 }
 
 func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -289,6 +469,15 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseProtectedDeletion("view", state.DeletionProtection, &resp.Diagnostics) {
+		return
+	}
+
+	// Delete every replica of a view with datasets instead.
+	if !state.Datasets.IsNull() {
+		r.replicas().deleteAll(ctx, state.Origin.ValueString(), state.Datasets, &resp.Diagnostics)
+		return
+	}
 
 	err := r.client.DeleteView(ctx, state.Origin.ValueString(), state.Dataset.ValueString())
 	if err != nil {
@@ -301,18 +490,11 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // ImportState function is required for resources that support import
 func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'dataset,origin'. Got: %s", req.ID),
-		)
+	dataset, origin, ok := datasetImportTarget(ctx, req, "view", r.client.ListViews, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	dataset := idParts[0]
-	origin := idParts[1]
-
 	apiResponseJSON, err := r.client.GetView(ctx, origin, dataset)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -331,4 +513,5 @@ func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportState
 	r.resolveView(ctx, &model, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), model.URL)...)
+	setDatasetAssetIdentity(ctx, resp.Identity, types.StringValue(dataset), types.StringValue(origin), &resp.Diagnostics)
 }
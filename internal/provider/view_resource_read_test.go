@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 
@@ -80,7 +81,7 @@ spec:
   description: Updated description
 `,
 			expectYamlUpdated: true,
-			expectWarning:     false,
+			expectWarning:     true,
 		},
 		{
 			name:              "invalid YAML response - should update and warn",
@@ -95,6 +96,15 @@ spec:
 			// Create the test schema
 			testSchema := schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -127,19 +137,37 @@ spec:
 			raw := tftypes.NewValue(
 				tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
-						"origin":    tftypes.String,
-						"id":        tftypes.String,
-						"dataset":   tftypes.String,
-						"view_yaml": tftypes.String,
-						"url":       tftypes.String,
+						"last_modified_at":    tftypes.String,
+						"last_modified_by":    tftypes.String,
+						"server_version":      tftypes.String,
+						"deletion_protection": tftypes.Bool,
+						"adopt_existing":      tftypes.Bool,
+						"allow_dataset_move":  tftypes.Bool,
+						"datasets":            tftypes.Set{ElementType: tftypes.String},
+						"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+						"dataset_urls":        tftypes.Map{ElementType: tftypes.String},
+						"origin":              tftypes.String,
+						"id":                  tftypes.String,
+						"dataset":             tftypes.String,
+						"view_yaml":           tftypes.String,
+						"url":                 tftypes.String,
 					},
 				},
 				map[string]tftypes.Value{
-					"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-					"id":        tftypes.NewValue(tftypes.String, nil),
-					"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-					"view_yaml": tftypes.NewValue(tftypes.String, originalYaml),
-					"url":       tftypes.NewValue(tftypes.String, testURL),
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+					"id":                  tftypes.NewValue(tftypes.String, nil),
+					"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+					"view_yaml":           tftypes.NewValue(tftypes.String, originalYaml),
+					"url":                 tftypes.NewValue(tftypes.String, testURL),
 				},
 			)
 
@@ -183,3 +211,134 @@ spec:
 		})
 	}
 }
+
+// TestViewResource_ReadOperatorEnvelope checks that a view_yaml holding a
+// Dash0View custom resource, as managed by the Dash0 Operator, is compared
+// against the API response in its unwrapped form, so the Kubernetes envelope
+// itself is not reported as drift.
+func TestViewResource_ReadOperatorEnvelope(t *testing.T) {
+	envelopeYaml := `
+apiVersion: operator.dash0.com/v1alpha1
+kind: Dash0View
+metadata:
+  name: sync-jobs
+  namespace: observability
+  labels:
+    app.kubernetes.io/part-of: checkout
+  annotations:
+    dash0.com/folder-path: /jobs
+    kubectl.kubernetes.io/last-applied-configuration: "{}"
+spec:
+  type: spans
+  filter:
+  - key: dash0.span.name
+    operator: is
+    value: sync
+`
+
+	tests := []struct {
+		name              string
+		apiResponseYaml   string
+		expectYamlUpdated bool
+	}{
+		{
+			name: "unwrapped envelope matches the API response",
+			apiResponseYaml: `
+kind: Dash0View
+metadata:
+  name: sync-jobs
+  annotations:
+    dash0.com/folder-path: /jobs
+  labels:
+    dash0.com/dataset: default
+spec:
+  display:
+    name: sync-jobs
+  type: spans
+  filter:
+  - key: dash0.span.name
+    operator: is
+    value: sync
+`,
+			expectYamlUpdated: false,
+		},
+		{
+			name: "changed filter is drift",
+			apiResponseYaml: `
+kind: Dash0View
+metadata:
+  name: sync-jobs
+  annotations:
+    dash0.com/folder-path: /jobs
+spec:
+  display:
+    name: sync-jobs
+  type: spans
+  filter:
+  - key: dash0.span.name
+    operator: is
+    value: async
+`,
+			expectYamlUpdated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &ViewResource{client: &testViewClient{getResponse: tc.apiResponseYaml}}
+
+			var testSchema resource.SchemaResponse
+			r.Schema(context.Background(), resource.SchemaRequest{}, &testSchema)
+
+			raw := tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"last_modified_at":    tftypes.String,
+						"last_modified_by":    tftypes.String,
+						"server_version":      tftypes.String,
+						"deletion_protection": tftypes.Bool,
+						"adopt_existing":      tftypes.Bool,
+						"allow_dataset_move":  tftypes.Bool,
+						"datasets":            tftypes.Set{ElementType: tftypes.String},
+						"dataset_ids":         tftypes.Map{ElementType: tftypes.String},
+						"dataset_urls":        tftypes.Map{ElementType: tftypes.String},
+						"origin":              tftypes.String,
+						"id":                  tftypes.String,
+						"dataset":             tftypes.String,
+						"view_yaml":           tftypes.String,
+						"url":                 tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+					"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+					"server_version":      tftypes.NewValue(tftypes.String, nil),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+					"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+					"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+					"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"origin":              tftypes.NewValue(tftypes.String, "tf_view"),
+					"id":                  tftypes.NewValue(tftypes.String, nil),
+					"dataset":             tftypes.NewValue(tftypes.String, "default"),
+					"view_yaml":           tftypes.NewValue(tftypes.String, envelopeYaml),
+					"url":                 tftypes.NewValue(tftypes.String, nil),
+				},
+			)
+			state := tfsdk.State{Raw: raw, Schema: testSchema.Schema}
+			resp := resource.ReadResponse{State: state}
+
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var resultState viewModel
+			resp.State.Get(context.Background(), &resultState)
+			if tc.expectYamlUpdated {
+				assert.Equal(t, tc.apiResponseYaml, resultState.ViewYaml.ValueString())
+			} else {
+				assert.Equal(t, envelopeYaml, resultState.ViewYaml.ValueString())
+			}
+		})
+	}
+}
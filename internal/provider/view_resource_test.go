@@ -72,6 +72,56 @@ func TestViewResource_Configure(t *testing.T) {
 	assert.True(t, resp.Diagnostics.HasError())
 }
 
+func TestViewResource_ModifyPlan_Policy(t *testing.T) {
+	r := &ViewResource{policy: policy{rules: []policyRule{{
+		resourceType:   "dash0_view",
+		requiredLabels: []string{"team"},
+		enforcement:    policyEnforcementError,
+	}}}}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+	planFor := func(viewYaml string) tfsdk.Plan {
+		return tfsdk.Plan{
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"dataset":             tftypes.NewValue(tftypes.String, "default"),
+				"view_yaml":           tftypes.NewValue(tftypes.String, viewYaml),
+				"url":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			Schema: schemaResp.Schema,
+		}
+	}
+
+	resp := &resource.ModifyPlanResponse{}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: planFor("kind: Dash0View\nmetadata:\n  name: errors\n  labels:\n    team: web\nspec: {}\n")}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	resp = &resource.ModifyPlanResponse{}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: planFor("kind: Dash0View\nmetadata:\n  name: errors\nspec: {}\n")}, resp)
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+	assert.Equal(t, "Policy violation", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `"errors": missing required label "team"`)
+
+	// Destroy plans are never blocked by policy.
+	resp = &resource.ModifyPlanResponse{}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: tfsdk.Plan{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+		Schema: schemaResp.Schema,
+	}}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
 func TestViewResource_Create(t *testing.T) {
 	mockClient := new(MockClient)
 	r := &ViewResource{client: mockClient}
@@ -84,14 +134,32 @@ func TestViewResource_Create(t *testing.T) {
 	// Setup plan
 	plan := tfsdk.Plan{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, ""),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-			"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":       tftypes.NewValue(tftypes.String, nil),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, ""),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"view_yaml":           tftypes.NewValue(tftypes.String, testYaml),
+			"url":                 tftypes.NewValue(tftypes.String, nil),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+				"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
@@ -126,6 +194,7 @@ func TestViewResource_Create(t *testing.T) {
 
 	// Setup mock expectations - CreateView(ctx, origin, jsonBody, dataset)
 	mockClient.On("CreateView", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetView", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 	// After create, the URL is resolved by origin (generated tf_-prefixed value).
 	mockClient.On("ResolveView", mock.Anything, mock.Anything, testDataset).Return("test-id", testURL, nil)
 
@@ -157,6 +226,15 @@ func TestViewResource_Read(t *testing.T) {
 	// Create state schema
 	stateSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"last_modified_at":    schema.StringAttribute{Computed: true},
+			"last_modified_by":    schema.StringAttribute{Computed: true},
+			"server_version":      schema.StringAttribute{Computed: true},
+			"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+			"adopt_existing":      schema.BoolAttribute{Optional: true},
+			"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+			"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+			"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 			"origin": schema.StringAttribute{
 				Computed: true,
 			},
@@ -178,11 +256,20 @@ func TestViewResource_Read(t *testing.T) {
 	// Setup state
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-			"view_yaml": tftypes.NewValue(tftypes.String, "old yaml"),
-			"url":       tftypes.NewValue(tftypes.String, testURL),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"view_yaml":           tftypes.NewValue(tftypes.String, "old yaml"),
+			"url":                 tftypes.NewValue(tftypes.String, testURL),
 		}),
 		Schema: stateSchema,
 	}
@@ -439,14 +526,32 @@ func TestViewResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":       tftypes.NewValue(tftypes.String, testURL),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":           tftypes.NewValue(tftypes.String, testYaml),
+				"url":                 tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -471,11 +576,20 @@ func TestViewResource_Update(t *testing.T) {
 		// Create plan with updated YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, updatedYaml),
-				"url":       tftypes.NewValue(tftypes.String, testURL),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":           tftypes.NewValue(tftypes.String, updatedYaml),
+				"url":                 tftypes.NewValue(tftypes.String, testURL),
 			}),
 			Schema: state.Schema,
 		}
@@ -491,6 +605,7 @@ func TestViewResource_Update(t *testing.T) {
 
 		// Setup mock expectations - UpdateView(ctx, origin, jsonBody, dataset)
 		mockClient.On("UpdateView", mock.Anything, testOrigin, mock.Anything, testDataset).Return(nil)
+		mockClient.On("GetView", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 
 		// Execute the update operation
 		r.Update(context.Background(), req, &resp)
@@ -516,14 +631,32 @@ func TestViewResource_Update(t *testing.T) {
 		// Create state
 		state := tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-				"url":       tftypes.NewValue(tftypes.String, nil),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":           tftypes.NewValue(tftypes.String, testYaml),
+				"url":                 tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
+					"last_modified_at":    schema.StringAttribute{Computed: true},
+					"last_modified_by":    schema.StringAttribute{Computed: true},
+					"server_version":      schema.StringAttribute{Computed: true},
+					"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+					"adopt_existing":      schema.BoolAttribute{Optional: true},
+					"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+					"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 					"origin": schema.StringAttribute{
 						Computed: true,
 					},
@@ -546,11 +679,20 @@ func TestViewResource_Update(t *testing.T) {
 		// Create plan with invalid YAML
 		plan := tfsdk.Plan{
 			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-				"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-				"id":        tftypes.NewValue(tftypes.String, nil),
-				"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-				"view_yaml": tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
-				"url":       tftypes.NewValue(tftypes.String, nil),
+				"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+				"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+				"server_version":      tftypes.NewValue(tftypes.String, nil),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+				"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+				"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+				"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+				"id":                  tftypes.NewValue(tftypes.String, nil),
+				"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+				"view_yaml":           tftypes.NewValue(tftypes.String, "invalid: yaml: : :"),
+				"url":                 tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: state.Schema,
 		}
@@ -584,14 +726,32 @@ func TestViewResource_Delete(t *testing.T) {
 	// Create a state with test data
 	state := tfsdk.State{
 		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
-			"origin":    tftypes.NewValue(tftypes.String, testOrigin),
-			"id":        tftypes.NewValue(tftypes.String, nil),
-			"dataset":   tftypes.NewValue(tftypes.String, testDataset),
-			"view_yaml": tftypes.NewValue(tftypes.String, testYaml),
-			"url":       tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/traces/explorer?view_id=internal-uuid"),
+			"last_modified_at":    tftypes.NewValue(tftypes.String, nil),
+			"last_modified_by":    tftypes.NewValue(tftypes.String, nil),
+			"server_version":      tftypes.NewValue(tftypes.String, nil),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, nil),
+			"adopt_existing":      tftypes.NewValue(tftypes.Bool, nil),
+			"allow_dataset_move":  tftypes.NewValue(tftypes.Bool, nil),
+			"datasets":            tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"dataset_ids":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"dataset_urls":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"origin":              tftypes.NewValue(tftypes.String, testOrigin),
+			"id":                  tftypes.NewValue(tftypes.String, nil),
+			"dataset":             tftypes.NewValue(tftypes.String, testDataset),
+			"view_yaml":           tftypes.NewValue(tftypes.String, testYaml),
+			"url":                 tftypes.NewValue(tftypes.String, "https://app.dash0.com/goto/traces/explorer?view_id=internal-uuid"),
 		}),
 		Schema: schema.Schema{
 			Attributes: map[string]schema.Attribute{
+				"last_modified_at":    schema.StringAttribute{Computed: true},
+				"last_modified_by":    schema.StringAttribute{Computed: true},
+				"server_version":      schema.StringAttribute{Computed: true},
+				"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+				"adopt_existing":      schema.BoolAttribute{Optional: true},
+				"allow_dataset_move":  schema.BoolAttribute{Optional: true},
+				"datasets":            schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"dataset_ids":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+				"dataset_urls":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
 				"origin": schema.StringAttribute{
 					Computed: true,
 				},
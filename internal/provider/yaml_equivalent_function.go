@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &YAMLEquivalentFunction{}
+
+// NewYAMLEquivalentFunction is a helper function to simplify the provider
+// implementation.
+func NewYAMLEquivalentFunction() function.Function {
+	return &YAMLEquivalentFunction{}
+}
+
+// YAMLEquivalentFunction reports whether two resource documents are the same
+// as far as drift detection is concerned.
+type YAMLEquivalentFunction struct{}
+
+func (f *YAMLEquivalentFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "yaml_equivalent"
+}
+
+func (f *YAMLEquivalentFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compare two Dash0 resource YAMLs the way drift detection does",
+		MarkdownDescription: "Returns `true` when the provider would treat two Dash0 resource YAML (or JSON) documents as " +
+			"the same, i.e. when replacing `a` with `b` would not show a diff. Formatting, key order, list order, " +
+			"server-managed fields and annotations that do not participate in drift detection are ignored, and equal " +
+			"durations such as `2m` and `2m0s` compare equal.\n\n" +
+			"The comparison is not symmetric: `a` is the reference, typically the configuration. Fields that the API " +
+			"fills in, such as `spec.permissions`, and zero values are only compared when `a` sets them.\n\n" +
+			"Provider-defined functions require Terraform 1.8 or later.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "kind",
+				MarkdownDescription: "The resource type without the `dash0_` prefix, one of " + yamlKindList() + ".",
+			},
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: "The reference document, in YAML or JSON.",
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: "The document to compare against the reference, in YAML or JSON.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *YAMLEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kindName, a, b string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &kindName, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	kind, funcErr := lookupYAMLKind(kindName, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	equivalent, err := kind.equivalent(a, b)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to compare the documents: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, equivalent))
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAMLEquivalentFunction_Metadata(t *testing.T) {
+	resp := &function.MetadataResponse{}
+	(&YAMLEquivalentFunction{}).Metadata(context.Background(), function.MetadataRequest{}, resp)
+	assert.Equal(t, "yaml_equivalent", resp.Name)
+}
+
+func TestYAMLEquivalentFunction_Definition(t *testing.T) {
+	resp := &function.DefinitionResponse{}
+	(&YAMLEquivalentFunction{}).Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	require.Len(t, resp.Definition.Parameters, 3)
+	assert.IsType(t, function.BoolReturn{}, resp.Definition.Return)
+}
+
+func TestYAMLEquivalentFunction_Run(t *testing.T) {
+	cases := []struct {
+		name     string
+		kind     string
+		a, b     string
+		expected bool
+	}{
+		{
+			name:     "formatting and server-managed fields",
+			kind:     "spam_filter",
+			a:        "metadata:\n  name: noisy\nspec:\n  contexts: [log]",
+			b:        `{"kind": "Dash0SpamFilter", "metadata": {"name": "noisy", "version": 4}, "spec": {"contexts": ["log"]}}`,
+			expected: true,
+		},
+		{
+			name:     "durations",
+			kind:     "check_rule",
+			a:        "metadata:\n  name: high\nspec:\n  interval: 2m",
+			b:        "metadata:\n  name: high\nspec:\n  interval: 2m0s",
+			expected: true,
+		},
+		{
+			name:     "changed spec",
+			kind:     "check_rule",
+			a:        "metadata:\n  name: high\nspec:\n  interval: 2m",
+			b:        "metadata:\n  name: high\nspec:\n  interval: 5m",
+			expected: false,
+		},
+		{
+			name:     "permissions enriched by the API are ignored when unset",
+			kind:     "dashboard",
+			a:        "metadata:\n  name: overview\nspec:\n  display:\n    name: Overview",
+			b:        "metadata:\n  name: overview\nspec:\n  display:\n    name: Overview\n  permissions:\n    - role: admin",
+			expected: true,
+		},
+		{
+			name:     "preserved annotations participate",
+			kind:     "dashboard",
+			a:        "metadata:\n  name: overview\n  annotations:\n    dash0.com/sharing: private\nspec: {}",
+			b:        "metadata:\n  name: overview\n  annotations:\n    dash0.com/sharing: public\nspec: {}",
+			expected: false,
+		},
+		{
+			name:     "annotations that are not preserved are ignored",
+			kind:     "team",
+			a:        "metadata:\n  name: backend\n  annotations:\n    dash0.com/sharing: private\nspec: {}",
+			b:        "metadata:\n  name: backend\nspec: {}",
+			expected: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := runFunction(&YAMLEquivalentFunction{}, types.BoolUnknown(), tc.kind, tc.a, tc.b)
+
+			require.Nil(t, resp.Error)
+			assert.Equal(t, types.BoolValue(tc.expected), resp.Result.Value())
+		})
+	}
+}
+
+func TestYAMLEquivalentFunction_Run_Errors(t *testing.T) {
+	t.Run("unsupported kind", func(t *testing.T) {
+		resp := runFunction(&YAMLEquivalentFunction{}, types.BoolUnknown(), "alert", "spec: {}", "spec: {}")
+
+		require.NotNil(t, resp.Error)
+		assert.Contains(t, resp.Error.Text, "expected one of `check_rule`, `dashboard`")
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		resp := runFunction(&YAMLEquivalentFunction{}, types.BoolUnknown(), "view", "spec: {}", "spec: [")
+
+		require.NotNil(t, resp.Error)
+		assert.Contains(t, resp.Error.Text, "Unable to compare the documents")
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/dash0hq/terraform-provider-dash0/internal/converter"
+	customplanmodifier "github.com/dash0hq/terraform-provider-dash0/internal/provider/planmodifier"
+)
+
+// yamlKind describes how drift detection compares the YAML documents of one
+// resource type. The same description backs the resource's plan modifier and
+// the normalize_yaml and yaml_equivalent provider functions, so module authors
+// get exactly the comparison the provider applies.
+type yamlKind struct {
+	// normalize, when set, reconciles a document into the shape the resource
+	// stores before it is compared.
+	normalize func(string) string
+	// alwaysIgnoredFields are stripped from both sides regardless of whether
+	// the user sets them.
+	alwaysIgnoredFields []string
+	// preservedAnnotationKeys are the metadata annotations that participate in
+	// drift detection; all others are stripped.
+	preservedAnnotationKeys []string
+}
+
+// yamlKinds maps the kind argument of the YAML provider functions, the
+// resource type name without the dash0_ prefix, to its drift rules.
+var yamlKinds = map[string]yamlKind{
+	"check_rule": {
+		normalize:               converter.MoveTopLevelAnnotationsIntoRules,
+		preservedAnnotationKeys: []string{converter.AnnotationSharing},
+	},
+	"dashboard": {
+		normalize:               converter.UnwrapOperatorDashboard,
+		preservedAnnotationKeys: []string{converter.AnnotationSharing, converter.AnnotationFolderPath},
+	},
+	"notification_channel": {
+		alwaysIgnoredFields: notificationChannelAlwaysIgnoredFields,
+	},
+	"prometheus_rule": {
+		normalize:               converter.MoveTopLevelAnnotationsIntoRules,
+		preservedAnnotationKeys: []string{converter.AnnotationSharing},
+	},
+	"recording_rule": {},
+	"spam_filter":    {},
+	"synthetic_check": {
+		normalize:               converter.UnwrapOperatorSyntheticCheck,
+		preservedAnnotationKeys: []string{converter.AnnotationSharing},
+	},
+	"team": {},
+	"view": {
+		normalize:               converter.UnwrapOperatorView,
+		preservedAnnotationKeys: []string{converter.AnnotationSharing, converter.AnnotationFolderPath},
+	},
+}
+
+// yamlKindNames returns the supported kinds in alphabetical order, for error
+// messages and documentation.
+func yamlKindNames() []string {
+	names := make([]string, 0, len(yamlKinds))
+	for name := range yamlKinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lookupYAMLKind resolves the kind argument at position argument of a YAML
+// provider function.
+func lookupYAMLKind(name string, argument int64) (yamlKind, *function.FuncError) {
+	kind, ok := yamlKinds[name]
+	if !ok {
+		return yamlKind{}, function.NewArgumentFuncError(argument, fmt.Sprintf("Unsupported kind %q, expected one of %s.", name, yamlKindList()))
+	}
+	return kind, nil
+}
+
+// yamlKindList renders the supported kinds for documentation and error
+// messages.
+func yamlKindList() string {
+	names := yamlKindNames()
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// planModifier returns the YAML semantic equality plan modifier for the kind.
+func (k yamlKind) planModifier() planmodifier.String {
+	switch {
+	case k.normalize != nil:
+		return customplanmodifier.YAMLSemanticEqualNormalizing(k.normalize, k.preservedAnnotationKeys...)
+	case len(k.alwaysIgnoredFields) > 0:
+		return customplanmodifier.YAMLSemanticEqualWith(k.alwaysIgnoredFields, k.preservedAnnotationKeys...)
+	default:
+		return customplanmodifier.YAMLSemanticEqual(k.preservedAnnotationKeys...)
+	}
+}
+
+// ignoredFields returns the fields stripped when reference is the reference
+// side of a comparison: the kind's always-ignored fields plus the
+// conditionally ignored fields reference does not set.
+func (k yamlKind) ignoredFields(reference string) []string {
+	ignored := converter.FieldsAbsentFromYAML(reference, converter.ConditionallyIgnoredFields)
+	return append(ignored, k.alwaysIgnoredFields...)
+}
+
+// normalizeYAML returns the canonical form of document that drift detection
+// compares.
+func (k yamlKind) normalizeYAML(document string) (string, error) {
+	if k.normalize != nil {
+		document = k.normalize(document)
+	}
+	return converter.NormalizeYAML(document, k.ignoredFields(document), k.preservedAnnotationKeys)
+}
+
+// equivalent reports whether the plan modifier treats reference, typically
+// the user's configuration, and other as the same document.
+func (k yamlKind) equivalent(reference, other string) (bool, error) {
+	if k.normalize != nil {
+		reference = k.normalize(reference)
+		other = k.normalize(other)
+	}
+	return converter.ResourceYAMLEquivalent(reference, other, k.ignoredFields(reference), k.preservedAnnotationKeys)
+}
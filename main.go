@@ -2,8 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -20,6 +24,14 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -36,3 +48,40 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export runs the `export` subcommand, which writes the Dash0 assets of the
+// selected datasets as Terraform configuration with `import` blocks.
+func export(args []string) error {
+	var opts provider.ExportOptions
+	var datasets string
+
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [flags]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes the Dash0 assets of the selected datasets as Terraform configuration with import blocks.")
+		fmt.Fprintln(flags.Output(), "Credentials are read from DASH0_API_URL and DASH0_AUTH_TOKEN, or else from the dash0 CLI profile.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&opts.Profile, "profile", "", "the dash0 CLI profile to read credentials from (default: the active profile)")
+	flags.StringVar(&datasets, "datasets", "", "comma-separated datasets to export (default: the DASH0_DATASET or profile dataset, else \"default\")")
+	flags.StringVar(&opts.OutputDir, "out", "dash0-export", "the directory to write the configuration to")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	for _, dataset := range strings.Split(datasets, ",") {
+		if dataset = strings.TrimSpace(dataset); dataset != "" {
+			opts.Datasets = append(opts.Datasets, dataset)
+		}
+	}
+	opts.Version = version
+
+	return provider.Export(context.Background(), opts, os.Stdout)
+}